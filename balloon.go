@@ -0,0 +1,87 @@
+package kml
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// ErrUnknownEntity is returned when a balloon template refers to an unknown
+// entity.
+var ErrUnknownEntity = errors.New("kml: unknown entity")
+
+// entityRegexp matches entity references in balloon text.
+var entityRegexp = regexp.MustCompile(`\$\[([^\]]*)\]`)
+
+// standardEntities are the entities that refer to a feature's elements.
+var standardEntities = map[string]bool{
+	"address":      true,
+	"description":  true,
+	"geDirections": true,
+	"id":           true,
+	"name":         true,
+	"Snippet":      true,
+}
+
+// BalloonText returns a new text element containing the HTML template tmpl
+// as a CDATA section. Every entity reference $[schema/field] and
+// $[schema/field/displayName] in tmpl must refer to a SimpleField in one of
+// schemas. Entity references without a slash must be standard entities or
+// refer to untyped Data, which cannot be validated.
+func BalloonText(tmpl string, schemas ...*SharedElement) (*SimpleElement, error) {
+	fields := schemaFields(schemas)
+	for _, match := range entityRegexp.FindAllStringSubmatch(tmpl, -1) {
+		entity := match[1]
+		parts := strings.Split(entity, "/")
+		switch {
+		case len(parts) == 1 && parts[0] != "":
+		case len(parts) == 2 && parts[1] == "displayName" && !fields[parts[0]+"/"]:
+		case len(parts) == 2 && fields[parts[0]+"/"+parts[1]]:
+		case len(parts) == 3 && parts[2] == "displayName" && fields[parts[0]+"/"+parts[1]]:
+		default:
+			return nil, fmt.Errorf("%w: $[%s]", ErrUnknownEntity, entity)
+		}
+	}
+	return TextCDATA(tmpl), nil
+}
+
+// BalloonTable returns an HTML balloon template that shows a feature's name,
+// a table of the display names and values of schema's fields, and its
+// description.
+func BalloonTable(schema *SharedElement) string {
+	schemaName := attrValue(schema.StartElement, "name")
+	sb := &strings.Builder{}
+	sb.WriteString("<h3>$[name]</h3>\n<table>\n")
+	for _, field := range schemaFieldNames(schema) {
+		entity := schemaName + "/" + field
+		sb.WriteString("<tr><th>$[" + entity + "/displayName]</th><td>$[" + entity + "]</td></tr>\n")
+	}
+	sb.WriteString("</table>\n$[description]")
+	return sb.String()
+}
+
+// schemaFields returns a set of schema/field names in schemas. Each schema
+// name also appears with a trailing slash.
+func schemaFields(schemas []*SharedElement) map[string]bool {
+	fields := make(map[string]bool)
+	for _, schema := range schemas {
+		schemaName := attrValue(schema.StartElement, "name")
+		fields[schemaName+"/"] = true
+		for _, field := range schemaFieldNames(schema) {
+			fields[schemaName+"/"+field] = true
+		}
+	}
+	return fields
+}
+
+// schemaFieldNames returns the names of schema's SimpleFields.
+func schemaFieldNames(schema *SharedElement) []string {
+	var names []string
+	for _, child := range schema.children {
+		if ce := compoundElement(child); ce != nil && ce.Name.Local == "SimpleField" {
+			names = append(names, attrValue(ce.StartElement, "name"))
+		}
+	}
+	return names
+}
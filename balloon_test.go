@@ -0,0 +1,80 @@
+package kml
+
+import (
+	"encoding/xml"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCDATA(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		element  Element
+		expected string
+	}{
+		{
+			name:     "DescriptionCDATA",
+			element:  DescriptionCDATA(`<b>bold</b> & "quoted"`),
+			expected: `<description><![CDATA[<b>bold</b> & "quoted"]]></description>`,
+		},
+		{
+			name:     "TextCDATA",
+			element:  TextCDATA(`a]]>b`),
+			expected: `<text><![CDATA[a]]]]><![CDATA[>b]]></text>`,
+		},
+		{
+			name:     "CDATA",
+			element:  CDATA(Name("<i>name</i>")),
+			expected: `<name><![CDATA[<i>name</i>]]></name>`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			sb := &strings.Builder{}
+			require.NoError(t, xml.NewEncoder(sb).Encode(tc.element))
+			assert.Equal(t, tc.expected, sb.String())
+		})
+	}
+}
+
+func TestBalloonText(t *testing.T) {
+	schema := Schema("TrailHeadTypeId", "TrailHeadType",
+		SimpleField("TrailHeadName", "string"),
+		SimpleField("TrailLength", "double"),
+	)
+
+	tmpl := BalloonTable(schema)
+	assert.Equal(t, "<h3>$[name]</h3>\n"+
+		"<table>\n"+
+		"<tr><th>$[TrailHeadType/TrailHeadName/displayName]</th><td>$[TrailHeadType/TrailHeadName]</td></tr>\n"+
+		"<tr><th>$[TrailHeadType/TrailLength/displayName]</th><td>$[TrailHeadType/TrailLength]</td></tr>\n"+
+		"</table>\n"+
+		"$[description]", tmpl)
+
+	text, err := BalloonText(tmpl, schema)
+	require.NoError(t, err)
+	sb := &strings.Builder{}
+	require.NoError(t, xml.NewEncoder(sb).Encode(BalloonStyle(text)))
+	assert.Equal(t, "<BalloonStyle><text><![CDATA["+tmpl+"]]></text></BalloonStyle>", sb.String())
+
+	for _, valid := range []string{
+		"$[name] $[geDirections]",
+		"$[holeNumber] $[holeNumber/displayName]",
+	} {
+		_, err := BalloonText(valid, schema)
+		assert.NoError(t, err, valid)
+	}
+
+	for _, invalid := range []string{
+		"$[]",
+		"$[TrailHeadType/Elevation]",
+		"$[OtherType/TrailLength]",
+		"$[TrailHeadType/TrailLength/value]",
+	} {
+		_, err := BalloonText(invalid, schema)
+		assert.True(t, errors.Is(err, ErrUnknownEntity), invalid)
+	}
+}
@@ -0,0 +1,142 @@
+package kml
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// A Bounds represents a geographical bounding box. West, South, East, and
+// North are in degrees, MinAlt and MaxAlt are in meters. Bounds that cross
+// the antimeridian are not supported.
+type Bounds struct {
+	West, South, East, North float64
+	MinAlt, MaxAlt           float64
+}
+
+// NewBounds returns a new empty Bounds.
+func NewBounds() Bounds {
+	return Bounds{
+		West:   math.Inf(1),
+		South:  math.Inf(1),
+		East:   math.Inf(-1),
+		North:  math.Inf(-1),
+		MinAlt: math.Inf(1),
+		MaxAlt: math.Inf(-1),
+	}
+}
+
+// BoundsOf returns the Bounds of all coordinates in e and its descendants.
+func BoundsOf(e Element) Bounds {
+	b := NewBounds()
+	forEachCoordinate(e, func(c Coordinate) {
+		b = b.Extend(c)
+	})
+	return b
+}
+
+// IsEmpty returns true if b contains no coordinates.
+func (b Bounds) IsEmpty() bool {
+	return b.West > b.East || b.South > b.North
+}
+
+// Extend returns b extended to include c.
+func (b Bounds) Extend(c Coordinate) Bounds {
+	return Bounds{
+		West:   math.Min(b.West, c.Lon),
+		South:  math.Min(b.South, c.Lat),
+		East:   math.Max(b.East, c.Lon),
+		North:  math.Max(b.North, c.Lat),
+		MinAlt: math.Min(b.MinAlt, c.Alt),
+		MaxAlt: math.Max(b.MaxAlt, c.Alt),
+	}
+}
+
+// Union returns the smallest Bounds that contains both b and other.
+func (b Bounds) Union(other Bounds) Bounds {
+	return Bounds{
+		West:   math.Min(b.West, other.West),
+		South:  math.Min(b.South, other.South),
+		East:   math.Max(b.East, other.East),
+		North:  math.Max(b.North, other.North),
+		MinAlt: math.Min(b.MinAlt, other.MinAlt),
+		MaxAlt: math.Max(b.MaxAlt, other.MaxAlt),
+	}
+}
+
+// Contains returns true if c is inside b. Altitude is ignored.
+func (b Bounds) Contains(c Coordinate) bool {
+	return b.West <= c.Lon && c.Lon <= b.East && b.South <= c.Lat && c.Lat <= b.North
+}
+
+// Center returns the center of b.
+func (b Bounds) Center() Coordinate {
+	return Coordinate{
+		Lon: (b.West + b.East) / 2,
+		Lat: (b.South + b.North) / 2,
+		Alt: (b.MinAlt + b.MaxAlt) / 2,
+	}
+}
+
+// LatLonBox returns a new LatLonBox element containing b.
+func (b Bounds) LatLonBox(children ...Element) *CompoundElement {
+	return LatLonBox(append([]Element{
+		North(b.North),
+		South(b.South),
+		East(b.East),
+		West(b.West),
+	}, children...)...)
+}
+
+// LatLonAltBox returns a new LatLonAltBox element containing b.
+func (b Bounds) LatLonAltBox(children ...Element) *CompoundElement {
+	return LatLonAltBox(append([]Element{
+		North(b.North),
+		South(b.South),
+		East(b.East),
+		West(b.West),
+		MinAltitude(b.MinAlt),
+		MaxAltitude(b.MaxAlt),
+	}, children...)...)
+}
+
+// forEachCoordinate calls f for every coordinate in e and its descendants.
+func forEachCoordinate(e Element, f func(Coordinate)) {
+	if coordinates, ok := coordinatesOf(e); ok {
+		for _, c := range coordinates {
+			f(c)
+		}
+		return
+	}
+	switch e := e.(type) {
+	case *SimpleElement:
+		if e.Name.Local == "gx:coord" {
+			if c, ok := parseGxCoord(e.value); ok {
+				f(c)
+			}
+		}
+	case *CompoundElement:
+		for _, child := range e.children {
+			forEachCoordinate(child, f)
+		}
+	case *SharedElement:
+		forEachCoordinate(&e.CompoundElement, f)
+	}
+}
+
+// parseGxCoord parses the value of a gx:coord element.
+func parseGxCoord(s string) (Coordinate, bool) {
+	fields := strings.Fields(s)
+	if len(fields) < 2 {
+		return Coordinate{}, false
+	}
+	var values [3]float64
+	for i := 0; i < len(fields) && i < 3; i++ {
+		value, err := strconv.ParseFloat(fields[i], 64)
+		if err != nil {
+			return Coordinate{}, false
+		}
+		values[i] = value
+	}
+	return Coordinate{Lon: values[0], Lat: values[1], Alt: values[2]}, true
+}
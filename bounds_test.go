@@ -0,0 +1,100 @@
+package kml
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBoundsOf(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		element  Element
+		expected Bounds
+	}{
+		{
+			name:     "empty",
+			element:  Placemark(),
+			expected: NewBounds(),
+		},
+		{
+			name: "point",
+			element: Placemark(
+				Point(
+					Coordinates(Coordinate{Lon: 1, Lat: 2, Alt: 3}),
+				),
+			),
+			expected: Bounds{West: 1, South: 2, East: 1, North: 2, MinAlt: 3, MaxAlt: 3},
+		},
+		{
+			name: "folder",
+			element: Folder(
+				SharedStyle("0"),
+				Placemark(
+					LineString(
+						CoordinatesArray([]float64{-1, 2}, []float64{3, -4, 5}),
+					),
+				),
+				Placemark(
+					LineString(
+						CoordinatesFlat([]float64{6, 7, 8, 9}, 0, 4, 2, 2),
+					),
+				),
+			),
+			expected: Bounds{West: -1, South: -4, East: 8, North: 9, MinAlt: 0, MaxAlt: 5},
+		},
+		{
+			name: "gx_track",
+			element: Placemark(
+				GxTrack(
+					GxCoord(Coordinate{-122.207881, 37.371915, 156}),
+					GxCoord(Coordinate{-122.205712, 37.373288, 152}),
+				),
+			),
+			expected: Bounds{West: -122.207881, South: 37.371915, East: -122.205712, North: 37.373288, MinAlt: 152, MaxAlt: 156},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, BoundsOf(tc.element))
+		})
+	}
+}
+
+func TestBounds(t *testing.T) {
+	b := NewBounds()
+	assert.True(t, b.IsEmpty())
+	b = b.Extend(Coordinate{Lon: 1, Lat: 2, Alt: 3})
+	assert.False(t, b.IsEmpty())
+	b = b.Union(Bounds{West: -1, South: -2, East: 0, North: 0, MinAlt: 0, MaxAlt: 1})
+	assert.Equal(t, Bounds{West: -1, South: -2, East: 1, North: 2, MinAlt: 0, MaxAlt: 3}, b)
+	assert.Equal(t, Coordinate{Lon: 0, Lat: 0, Alt: 1.5}, b.Center())
+	assert.True(t, b.Contains(Coordinate{Lon: 0.5, Lat: -1}))
+	assert.False(t, b.Contains(Coordinate{Lon: 2, Lat: 0}))
+
+	for _, tc := range []struct {
+		name     string
+		element  Element
+		expected string
+	}{
+		{
+			name:     "LatLonBox",
+			element:  b.LatLonBox(Rotation(0)),
+			expected: `<LatLonBox><north>2</north><south>-2</south><east>1</east><west>-1</west><rotation>0</rotation></LatLonBox>`,
+		},
+		{
+			name:     "LatLonAltBox",
+			element:  b.LatLonAltBox(AltitudeMode(AltitudeModeAbsolute)),
+			expected: `<LatLonAltBox><north>2</north><south>-2</south><east>1</east><west>-1</west><minAltitude>0</minAltitude><maxAltitude>3</maxAltitude><altitudeMode>absolute</altitudeMode></LatLonAltBox>`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			sb := &strings.Builder{}
+			e := xml.NewEncoder(sb)
+			require.NoError(t, e.Encode(tc.element))
+			assert.Equal(t, tc.expected, sb.String())
+		})
+	}
+}
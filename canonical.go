@@ -0,0 +1,281 @@
+package kml
+
+import (
+	"encoding/xml"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// A simpleKind is the kind of value of a simple element.
+type simpleKind int
+
+// simpleKinds.
+const (
+	simpleKindString simpleKind = iota
+	simpleKindBool
+	simpleKindInt
+	simpleKindFloat
+	simpleKindColor
+)
+
+// A simpleElementType is the type of a simple element.
+type simpleElementType struct {
+	kind simpleKind
+}
+
+// CanonicalOptions control the canonical form of an Element.
+type CanonicalOptions struct {
+	// Prefix and Indent are passed to the XML encoder.
+	Prefix, Indent string
+	// StripDefaults removes simple elements whose values are equal to their
+	// default values in their parents, except inside Update elements where
+	// they are significant. Required elements and elements whose schema
+	// defaults are invalid, such as north, are never removed.
+	StripDefaults bool
+	// CoordinatesPerLine writes each coordinate tuple on its own line.
+	CoordinatesPerLine bool
+}
+
+// A canonicalCoordinatesElement is a coordinates element in canonical form.
+type canonicalCoordinatesElement struct {
+	coordinates []Coordinate
+	separator   string
+	suffix      string
+}
+
+// Canonical returns a copy of e in canonical form. Attributes are sorted by
+// name, simple values with numeric, boolean, or color types are normalized,
+// and shared Style and StyleMap elements are sorted by id.
+func Canonical(e Element, options CanonicalOptions) Element {
+	c := &canonicalizer{options: options}
+	return c.canonical(e, 0, false)
+}
+
+// WriteCanonical writes an XML header and the canonical form of e to w,
+// followed by a newline.
+func WriteCanonical(w io.Writer, e Element, options CanonicalOptions) error {
+	if err := write(w, options.Prefix, options.Indent, Canonical(e, options)); err != nil {
+		return err
+	}
+	_, err := w.Write([]byte("\n"))
+	return err
+}
+
+// MarshalXML marshals cce to e. start is ignored.
+func (cce *canonicalCoordinatesElement) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if err := e.EncodeToken(coordinatesStartElement); err != nil {
+		return err
+	}
+	tuples := make([]string, 0, len(cce.coordinates))
+	for _, c := range cce.coordinates {
+		tuples = append(tuples, formatCoordinate(c))
+	}
+	if len(tuples) > 0 {
+		s := strings.Join(tuples, cce.separator)
+		if cce.suffix != "" {
+			s = cce.separator + s + cce.suffix
+		}
+		if err := e.EncodeToken(xml.CharData(s)); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(coordinatesEndElement)
+}
+
+// Write writes an XML header and cce to w.
+func (cce *canonicalCoordinatesElement) Write(w io.Writer) error {
+	return write(w, "", "", cce)
+}
+
+// WriteIndent writes an XML header and cce to w.
+func (cce *canonicalCoordinatesElement) WriteIndent(w io.Writer, prefix, indent string) error {
+	return write(w, prefix, indent, cce)
+}
+
+type canonicalizer struct {
+	options CanonicalOptions
+}
+
+// canonical returns the canonical form of e at depth. inUpdate is true if e
+// is a descendant of an Update element.
+func (c *canonicalizer) canonical(e Element, depth int, inUpdate bool) Element {
+	if coordinates, ok := coordinatesOf(e); ok {
+		cce := &canonicalCoordinatesElement{
+			coordinates: coordinates,
+			separator:   " ",
+		}
+		if c.options.CoordinatesPerLine {
+			cce.separator = "\n" + c.options.Prefix + strings.Repeat(c.options.Indent, depth+1)
+			cce.suffix = "\n" + c.options.Prefix + strings.Repeat(c.options.Indent, depth)
+		}
+		return cce
+	}
+	switch e := e.(type) {
+	case *SimpleElement:
+		return &SimpleElement{
+			StartElement: canonicalStartElement(e.StartElement),
+			value:        canonicalValue(e.Name.Local, e.value),
+			cdata:        e.cdata,
+		}
+	case *CompoundElement:
+		return c.canonicalCompound(e, depth, inUpdate)
+	case *SharedElement:
+		return &SharedElement{
+			CompoundElement: *c.canonicalCompound(&e.CompoundElement, depth, inUpdate),
+			id:              e.id,
+		}
+	default:
+		return e
+	}
+}
+
+func (c *canonicalizer) canonicalCompound(ce *CompoundElement, depth int, inUpdate bool) *CompoundElement {
+	inUpdate = inUpdate || ce.Name.Local == "Update"
+	children := make([]Element, 0, len(ce.children))
+	var styleIndexes []int
+	for _, child := range ce.children {
+		if c.options.StripDefaults && !inUpdate && isDefault(ce.Name.Local, child) {
+			continue
+		}
+		if isSharedStyle(child) {
+			styleIndexes = append(styleIndexes, len(children))
+		}
+		children = append(children, c.canonical(child, depth+1, inUpdate))
+	}
+	styles := make([]Element, 0, len(styleIndexes))
+	for _, i := range styleIndexes {
+		styles = append(styles, children[i])
+	}
+	sort.SliceStable(styles, func(i, j int) bool {
+		return elementID(compoundElement(styles[i])) < elementID(compoundElement(styles[j]))
+	})
+	for i, style := range styles {
+		children[styleIndexes[i]] = style
+	}
+	return &CompoundElement{
+		StartElement: canonicalStartElement(ce.StartElement),
+		children:     children,
+	}
+}
+
+// canonicalStartElement returns a copy of se with its attributes sorted.
+func canonicalStartElement(se xml.StartElement) xml.StartElement {
+	attrs := append([]xml.Attr(nil), se.Attr...)
+	sort.SliceStable(attrs, func(i, j int) bool {
+		if attrs[i].Name.Space != attrs[j].Name.Space {
+			return attrs[i].Name.Space < attrs[j].Name.Space
+		}
+		return attrs[i].Name.Local < attrs[j].Name.Local
+	})
+	return xml.StartElement{Name: se.Name, Attr: attrs}
+}
+
+// canonicalValue returns the canonical form of value for the simple element
+// name.
+func canonicalValue(name, value string) string {
+	t, ok := lookupSimpleElementType(name)
+	if !ok {
+		return value
+	}
+	trimmed := strings.TrimSpace(value)
+	switch t.kind {
+	case simpleKindBool:
+		switch trimmed {
+		case "1", "true":
+			return "1"
+		case "0", "false":
+			return "0"
+		}
+	case simpleKindInt:
+		if i, err := strconv.Atoi(trimmed); err == nil {
+			return strconv.Itoa(i)
+		}
+	case simpleKindFloat:
+		if f, err := strconv.ParseFloat(trimmed, 64); err == nil {
+			return strconv.FormatFloat(f, 'f', -1, 64)
+		}
+	case simpleKindColor:
+		return strings.ToLower(trimmed)
+	}
+	return value
+}
+
+// isDefault returns true if e is a simple element whose value is its default
+// as a child of the element named parent.
+func isDefault(parent string, e Element) bool {
+	se, ok := e.(*SimpleElement)
+	if !ok || len(se.Attr) != 0 {
+		return false
+	}
+	defaultValue, ok := schemaElements[parent].defaults[se.Name.Local]
+	if !ok {
+		return false
+	}
+	return canonicalValue(se.Name.Local, se.value) == canonicalValue(se.Name.Local, defaultValue)
+}
+
+// isSharedStyle returns true if e is a Style or StyleMap with an id.
+func isSharedStyle(e Element) bool {
+	ce := compoundElement(e)
+	if ce == nil || ce.Name.Space != "" {
+		return false
+	}
+	return (ce.Name.Local == "Style" || ce.Name.Local == "StyleMap") && elementID(ce) != ""
+}
+
+// lookupSimpleElementType returns the type of the simple element name.
+func lookupSimpleElementType(name string) (simpleElementType, bool) {
+	if strings.HasPrefix(name, "gx:") {
+		t, ok := gxSimpleElementTypes[name]
+		return t, ok
+	}
+	t, ok := simpleElementTypes[name]
+	return t, ok
+}
+
+// coordinatesOf returns the coordinates of e if e is a coordinates element.
+func coordinatesOf(e Element) ([]Coordinate, bool) {
+	switch e := e.(type) {
+	case *CoordinatesElement:
+		return e.coordinates, true
+	case *CoordinatesArrayElement:
+		coordinates := make([]Coordinate, 0, len(e.coordinates))
+		for _, c := range e.coordinates {
+			if len(c) < 2 {
+				continue
+			}
+			coordinate := Coordinate{Lon: c[0], Lat: c[1]}
+			if len(c) > 2 {
+				coordinate.Alt = c[2]
+			}
+			coordinates = append(coordinates, coordinate)
+		}
+		return coordinates, true
+	case *CoordinatesFlatElement:
+		coordinates := make([]Coordinate, 0, (e.end-e.offset)/e.stride)
+		for i := e.offset; i < e.end; i += e.stride {
+			coordinate := Coordinate{Lon: e.flatCoords[i], Lat: e.flatCoords[i+1]}
+			if e.dim > 2 {
+				coordinate.Alt = e.flatCoords[i+2]
+			}
+			coordinates = append(coordinates, coordinate)
+		}
+		return coordinates, true
+	case *canonicalCoordinatesElement:
+		return e.coordinates, true
+	default:
+		return nil, false
+	}
+}
+
+// formatCoordinate returns c formatted as a coordinate tuple. The altitude is
+// omitted if it is zero.
+func formatCoordinate(c Coordinate) string {
+	s := strconv.FormatFloat(c.Lon, 'f', -1, 64) + "," + strconv.FormatFloat(c.Lat, 'f', -1, 64)
+	if c.Alt != 0 {
+		s += "," + strconv.FormatFloat(c.Alt, 'f', -1, 64)
+	}
+	return s
+}
@@ -0,0 +1,136 @@
+package kml
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanonical(t *testing.T) {
+	placemark := Placemark(
+		Name("a"),
+		Visibility(true),
+		LineString(
+			Tessellate(false),
+			CoordinatesArray([]float64{1, 2}, []float64{3, 4, 5}),
+		),
+	)
+	placemark.Attr = []xml.Attr{
+		{Name: xml.Name{Local: "targetId"}, Value: "t"},
+		{Name: xml.Name{Local: "id"}, Value: "pm"},
+	}
+	document := Document(
+		SharedStyle("b"),
+		Name("doc"),
+		SharedStyle("a"),
+		placemark,
+		Update(
+			TargetHref(""),
+			Change(TargetPlacemark("pm", Visibility(true))),
+		),
+	)
+	for _, tc := range []struct {
+		name     string
+		options  CanonicalOptions
+		expected string
+	}{
+		{
+			name: "default",
+			expected: `<?xml version="1.0" encoding="UTF-8"?>` + "\n" +
+				`<Document>` +
+				`<Style id="a"></Style>` +
+				`<name>doc</name>` +
+				`<Style id="b"></Style>` +
+				`<Placemark id="pm" targetId="t">` +
+				`<name>a</name>` +
+				`<visibility>1</visibility>` +
+				`<LineString><tessellate>0</tessellate><coordinates>1,2 3,4,5</coordinates></LineString>` +
+				`</Placemark>` +
+				`<Update><targetHref></targetHref><Change><Placemark targetId="pm"><visibility>1</visibility></Placemark></Change></Update>` +
+				`</Document>` + "\n",
+		},
+		{
+			name: "strip_defaults_indent",
+			options: CanonicalOptions{
+				Indent:             "  ",
+				StripDefaults:      true,
+				CoordinatesPerLine: true,
+			},
+			expected: `<?xml version="1.0" encoding="UTF-8"?>` + "\n" +
+				`<Document>` + "\n" +
+				`  <Style id="a"></Style>` + "\n" +
+				`  <name>doc</name>` + "\n" +
+				`  <Style id="b"></Style>` + "\n" +
+				`  <Placemark id="pm" targetId="t">` + "\n" +
+				`    <name>a</name>` + "\n" +
+				`    <LineString>` + "\n" +
+				`      <coordinates>` + "\n" +
+				`        1,2` + "\n" +
+				`        3,4,5` + "\n" +
+				`      </coordinates>` + "\n" +
+				`    </LineString>` + "\n" +
+				`  </Placemark>` + "\n" +
+				`  <Update>` + "\n" +
+				`    <targetHref></targetHref>` + "\n" +
+				`    <Change>` + "\n" +
+				`      <Placemark targetId="pm">` + "\n" +
+				`        <visibility>1</visibility>` + "\n" +
+				`      </Placemark>` + "\n" +
+				`    </Change>` + "\n" +
+				`  </Update>` + "\n" +
+				`</Document>` + "\n",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			sb := &strings.Builder{}
+			require.NoError(t, WriteCanonical(sb, document, tc.options))
+			assert.Equal(t, tc.expected, sb.String())
+		})
+	}
+}
+
+func TestCanonicalValue(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		value    string
+		expected string
+	}{
+		{name: "altitude", value: "1.50", expected: "1.5"},
+		{name: "extrude", value: "true", expected: "1"},
+		{name: "drawOrder", value: "007", expected: "7"},
+		{name: "color", value: "FF00FF00", expected: "ff00ff00"},
+		{name: "name", value: "007", expected: "007"},
+		{name: "gx:duration", value: "2.0", expected: "2"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, canonicalValue(tc.name, tc.value))
+		})
+	}
+}
+
+func TestIsDefault(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		parent   string
+		element  Element
+		expected bool
+	}{
+		{name: "visibility", parent: "Placemark", element: Visibility(true), expected: true},
+		{name: "visibility_not_default", parent: "Placemark", element: Visibility(false)},
+		{name: "tessellate", parent: "LineString", element: Tessellate(false), expected: true},
+		{name: "tessellate_other_parent", parent: "Document", element: Tessellate(false)},
+		{name: "scale", parent: "IconStyle", element: Scale(1), expected: true},
+		{name: "east", parent: "LatLonBox", element: East(180), expected: true},
+		{name: "north", parent: "LatLonBox", element: North(180)},
+		{name: "south", parent: "LatLonAltBox", element: South(-180)},
+		{name: "key", parent: "Pair", element: Key(StyleStateNormal)},
+		{name: "compound", parent: "Document", element: Placemark()},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, isDefault(tc.parent, tc.element))
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/twpayne/go-kml/kmz"
+)
+
+// errUnknownFormat is returned when converting from or to an unknown format.
+var errUnknownFormat = errors.New("unknown format")
+
+// formatExtensions maps file extensions to formats.
+var formatExtensions = map[string]string{
+	".cup":     "waypoint",
+	".csv":     "csv",
+	".geojson": "geojson",
+	".gpx":     "gpx",
+	".igc":     "igc",
+	".json":    "geojson",
+	".kml":     "kml",
+	".kmz":     "kmz",
+	".wpt":     "waypoint",
+}
+
+// runConvert runs the convert command.
+func runConvert(fs *flag.FlagSet, args []string, stdin io.Reader, stdout io.Writer) error {
+	from := fs.String("from", "", "input format: kml, kmz, geojson, gpx, igc, csv, or waypoint (default from file extension, or kml)")
+	to := fs.String("to", "kml", "output format: kml, kmz, geojson, gpx, csv, or waypoint")
+	waypointFormat := fs.String("waypoint-format", "seeyou", "waypoint output format: compegps, formatgeo, geojson, oziexplorer, or seeyou")
+	name := fs.String("name", "", "document name")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *from == "" {
+		*from = "kml"
+		if fs.NArg() == 1 {
+			if format, ok := formatExtensions[strings.ToLower(path.Ext(fs.Arg(0)))]; ok {
+				*from = format
+			}
+		}
+	}
+
+	data, err := readInput(fs.Args(), stdin)
+	if err != nil {
+		return err
+	}
+	c, err := readCollection(*from, data)
+	if err != nil {
+		return err
+	}
+	if *name != "" {
+		c.name = *name
+	}
+
+	switch *to {
+	case "kml":
+		return writeKML(stdout, c.element())
+	case "kmz":
+		return kmz.Write(stdout, c.element(), nil)
+	case "geojson":
+		return writeGeoJSON(stdout, c)
+	case "gpx":
+		return writeGPX(stdout, c)
+	case "csv":
+		return writeCSV(stdout, c)
+	case "waypoint":
+		return writeWaypoints(stdout, c, *waypointFormat)
+	default:
+		return fmt.Errorf("%w: %s", errUnknownFormat, *to)
+	}
+}
+
+// readCollection reads a collection from data in format.
+func readCollection(format string, data []byte) (*collection, error) {
+	switch format {
+	case "kml", "kmz":
+		e, err := parse(data)
+		if err != nil {
+			return nil, err
+		}
+		return readKMLCollection(e), nil
+	case "geojson":
+		return readGeoJSON(data)
+	case "gpx":
+		return readGPX(data)
+	case "igc":
+		return readIGC(data)
+	case "csv":
+		return readCSV(bytes.NewReader(data))
+	case "waypoint":
+		return readWaypoints(data)
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnknownFormat, format)
+	}
+}
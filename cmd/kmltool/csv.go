@@ -0,0 +1,134 @@
+package main
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/twpayne/go-kml"
+)
+
+// errInvalidCSV is returned when reading invalid CSV data.
+var errInvalidCSV = errors.New("invalid CSV")
+
+// csvColumns maps lower case CSV column names to their meanings.
+var csvColumns = map[string]string{
+	"alt":         "alt",
+	"altitude":    "alt",
+	"desc":        "description",
+	"description": "description",
+	"ele":         "alt",
+	"elevation":   "alt",
+	"lat":         "lat",
+	"latitude":    "lat",
+	"lng":         "lon",
+	"lon":         "lon",
+	"long":        "lon",
+	"longitude":   "lon",
+	"name":        "name",
+}
+
+// readCSV reads a collection of points from CSV data with a header row. The
+// latitude and longitude columns are required. Columns other than the name,
+// description, and altitude columns are read as properties.
+func readCSV(r io.Reader) (*collection, error) {
+	cr := csv.NewReader(r)
+	cr.TrimLeadingSpace = true
+	header, err := cr.Read()
+	if err != nil {
+		return nil, err
+	}
+	columns := make(map[string]int)
+	for i, name := range header {
+		if column, ok := csvColumns[strings.ToLower(strings.TrimSpace(name))]; ok {
+			columns[column] = i
+		}
+	}
+	latIndex, ok := columns["lat"]
+	if !ok {
+		return nil, fmt.Errorf("%w: no latitude column", errInvalidCSV)
+	}
+	lonIndex, ok := columns["lon"]
+	if !ok {
+		return nil, fmt.Errorf("%w: no longitude column", errInvalidCSV)
+	}
+	c := &collection{}
+	for lineNo := 2; ; lineNo++ {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return c, nil
+		} else if err != nil {
+			return nil, err
+		}
+		var coordinate kml.Coordinate
+		if coordinate.Lat, err = strconv.ParseFloat(strings.TrimSpace(record[latIndex]), 64); err != nil {
+			return nil, fmt.Errorf("%w: line %d: %v", errInvalidCSV, lineNo, err)
+		}
+		if coordinate.Lon, err = strconv.ParseFloat(strings.TrimSpace(record[lonIndex]), 64); err != nil {
+			return nil, fmt.Errorf("%w: line %d: %v", errInvalidCSV, lineNo, err)
+		}
+		if altIndex, ok := columns["alt"]; ok && strings.TrimSpace(record[altIndex]) != "" {
+			if coordinate.Alt, err = strconv.ParseFloat(strings.TrimSpace(record[altIndex]), 64); err != nil {
+				return nil, fmt.Errorf("%w: line %d: %v", errInvalidCSV, lineNo, err)
+			}
+		}
+		f := &feature{
+			geometry: &geometry{kind: geometryPoint, coordinates: []kml.Coordinate{coordinate}},
+		}
+		for i, value := range record {
+			switch csvColumns[strings.ToLower(strings.TrimSpace(header[i]))] {
+			case "name":
+				f.name = value
+			case "description":
+				f.description = value
+			case "lat", "lon", "alt":
+			default:
+				f.properties = append(f.properties, property{name: header[i], value: value})
+			}
+		}
+		c.features = append(c.features, f)
+	}
+}
+
+// writeCSV writes the point features in c to w as CSV with a header row.
+func writeCSV(w io.Writer, c *collection) error {
+	header := []string{"name", "description", "lat", "lon", "alt"}
+	propertyIndexes := make(map[string]int)
+	for _, f := range c.features {
+		for _, p := range f.properties {
+			if _, ok := propertyIndexes[p.name]; !ok {
+				propertyIndexes[p.name] = len(header)
+				header = append(header, p.name)
+			}
+		}
+	}
+	cw := csv.NewWriter(w)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	for _, f := range c.features {
+		for _, g := range f.geometry.flatten() {
+			if g.kind != geometryPoint || len(g.coordinates) == 0 {
+				continue
+			}
+			coordinate := g.coordinates[0]
+			record := make([]string, len(header))
+			record[0] = f.name
+			record[1] = f.description
+			record[2] = strconv.FormatFloat(coordinate.Lat, 'f', -1, 64)
+			record[3] = strconv.FormatFloat(coordinate.Lon, 'f', -1, 64)
+			record[4] = strconv.FormatFloat(coordinate.Alt, 'f', -1, 64)
+			for _, p := range f.properties {
+				record[propertyIndexes[p.name]] = p.value
+			}
+			if err := cw.Write(record); err != nil {
+				return err
+			}
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,284 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/twpayne/go-kml"
+)
+
+// A geometryKind is the kind of a geometry.
+type geometryKind int
+
+// geometryKinds.
+const (
+	geometryPoint geometryKind = iota
+	geometryLineString
+	geometryPolygon
+	geometryTrack
+	geometryMulti
+)
+
+// A geometry is a format-independent geometry.
+type geometry struct {
+	kind        geometryKind
+	coordinates []kml.Coordinate   // point, line string, and track
+	times       []time.Time        // track
+	rings       [][]kml.Coordinate // polygon, outer ring first
+	geometries  []*geometry        // multi
+}
+
+// A property is a named value of a feature.
+type property struct {
+	name  string
+	value string
+}
+
+// A feature is a format-independent feature with an optional geometry.
+type feature struct {
+	name        string
+	description string
+	properties  []property
+	geometry    *geometry
+}
+
+// A collection is a format-independent collection of features.
+type collection struct {
+	name     string
+	features []*feature
+}
+
+// flatten returns the non-multi geometries in g.
+func (g *geometry) flatten() []*geometry {
+	if g == nil {
+		return nil
+	}
+	if g.kind != geometryMulti {
+		return []*geometry{g}
+	}
+	var geometries []*geometry
+	for _, child := range g.geometries {
+		geometries = append(geometries, child.flatten()...)
+	}
+	return geometries
+}
+
+// element returns g as a KML geometry element.
+func (g *geometry) element() kml.Element {
+	switch g.kind {
+	case geometryPoint:
+		return kml.Point(kml.Coordinates(g.coordinates...))
+	case geometryLineString:
+		return kml.LineString(kml.Tessellate(true), kml.Coordinates(g.coordinates...))
+	case geometryPolygon:
+		children := make([]kml.Element, 0, len(g.rings))
+		for i, ring := range g.rings {
+			linearRing := kml.LinearRing(kml.Coordinates(ring...))
+			if i == 0 {
+				children = append(children, kml.OuterBoundaryIs(linearRing))
+			} else {
+				children = append(children, kml.InnerBoundaryIs(linearRing))
+			}
+		}
+		return kml.Polygon(children...)
+	case geometryTrack:
+		children := make([]kml.Element, 0, 2*len(g.coordinates)+1)
+		children = append(children, kml.AltitudeMode(kml.AltitudeModeAbsolute))
+		for _, t := range g.times {
+			children = append(children, kml.When(t))
+		}
+		for _, c := range g.coordinates {
+			children = append(children, kml.GxCoord(c))
+		}
+		return kml.GxTrack(children...)
+	default:
+		children := make([]kml.Element, 0, len(g.geometries))
+		for _, child := range g.geometries {
+			children = append(children, child.element())
+		}
+		return kml.MultiGeometry(children...)
+	}
+}
+
+// hasTrack returns true if g contains a track.
+func (g *geometry) hasTrack() bool {
+	for _, child := range g.flatten() {
+		if child.kind == geometryTrack {
+			return true
+		}
+	}
+	return false
+}
+
+// element returns f as a KML Placemark.
+func (f *feature) element() kml.Element {
+	var children []kml.Element
+	if f.name != "" {
+		children = append(children, kml.Name(f.name))
+	}
+	if f.description != "" {
+		children = append(children, kml.Description(f.description))
+	}
+	if len(f.properties) != 0 {
+		data := make([]kml.Element, 0, len(f.properties))
+		for _, p := range f.properties {
+			data = append(data, kml.DataWithName(p.name, kml.Value(p.value)))
+		}
+		children = append(children, kml.ExtendedData(data...))
+	}
+	if f.geometry != nil {
+		children = append(children, f.geometry.element())
+	}
+	return kml.Placemark(children...)
+}
+
+// element returns c as a KML document.
+func (c *collection) element() kml.Element {
+	children := make([]kml.Element, 0, len(c.features)+1)
+	if c.name != "" {
+		children = append(children, kml.Name(c.name))
+	}
+	gx := false
+	for _, f := range c.features {
+		children = append(children, f.element())
+		gx = gx || f.geometry.hasTrack()
+	}
+	if gx {
+		return kml.GxKML(kml.Document(children...))
+	}
+	return kml.KML(kml.Document(children...))
+}
+
+// readKMLCollection returns the Placemarks in e as a collection.
+func readKMLCollection(e kml.Element) *collection {
+	c := &collection{}
+	_ = kml.Walk(e, func(_ []kml.Element, e kml.Element) error {
+		switch elementName(e) {
+		case "Document", "Folder":
+			if c.name == "" {
+				c.name = childValue(e, "name")
+			}
+		case "Placemark":
+			c.features = append(c.features, kmlFeature(e))
+			return kml.SkipChildren
+		}
+		return nil
+	})
+	return c
+}
+
+// kmlFeature returns the feature of the Placemark e.
+func kmlFeature(e kml.Element) *feature {
+	f := &feature{
+		name:        strings.TrimSpace(childValue(e, "name")),
+		description: strings.TrimSpace(childValue(e, "description")),
+	}
+	_ = kml.Walk(child(e, "ExtendedData"), func(_ []kml.Element, e kml.Element) error {
+		switch elementName(e) {
+		case "Data":
+			f.properties = append(f.properties, property{name: kml.AttrValue(e, "name"), value: childValue(e, "value")})
+		case "SimpleData":
+			if se, ok := e.(*kml.SimpleElement); ok {
+				f.properties = append(f.properties, property{name: kml.AttrValue(e, "name"), value: se.Value()})
+			}
+		}
+		return nil
+	})
+	for _, c := range kml.ChildrenOf(e) {
+		if g := kmlGeometry(c); g != nil {
+			f.geometry = g
+			break
+		}
+	}
+	return f
+}
+
+// kmlGeometry returns the geometry of the KML geometry element e, or nil if e
+// is not a geometry.
+func kmlGeometry(e kml.Element) *geometry {
+	switch elementName(e) {
+	case "Point":
+		return &geometry{kind: geometryPoint, coordinates: kmlCoordinates(e)}
+	case "LineString", "LinearRing":
+		return &geometry{kind: geometryLineString, coordinates: kmlCoordinates(e)}
+	case "Polygon":
+		g := &geometry{kind: geometryPolygon}
+		for _, boundaryName := range []string{"outerBoundaryIs", "innerBoundaryIs"} {
+			for _, boundary := range kml.ChildrenOf(e) {
+				if elementName(boundary) == boundaryName {
+					g.rings = append(g.rings, kmlCoordinates(child(boundary, "LinearRing")))
+				}
+			}
+		}
+		return g
+	case "gx:Track":
+		var whens, coords []string
+		for _, c := range kml.ChildrenOf(e) {
+			var value string
+			if se, ok := c.(*kml.SimpleElement); ok {
+				value = se.Value()
+			}
+			switch elementName(c) {
+			case "when":
+				whens = append(whens, value)
+			case "gx:coord":
+				coords = append(coords, value)
+			}
+		}
+		g := &geometry{kind: geometryTrack}
+		for i, coord := range coords {
+			c, ok := parseGxCoord(coord)
+			if !ok {
+				continue
+			}
+			if len(whens) == len(coords) {
+				t, err := kml.ParseKMLTime(whens[i])
+				if err != nil {
+					continue
+				}
+				g.times = append(g.times, t.Time)
+			}
+			g.coordinates = append(g.coordinates, c)
+		}
+		if len(whens) != len(coords) || len(g.coordinates) < 2 {
+			return &geometry{kind: geometryLineString, coordinates: g.coordinates}
+		}
+		return g
+	case "MultiGeometry", "gx:MultiTrack":
+		g := &geometry{kind: geometryMulti}
+		for _, c := range kml.ChildrenOf(e) {
+			if child := kmlGeometry(c); child != nil {
+				g.geometries = append(g.geometries, child)
+			}
+		}
+		return g
+	default:
+		return nil
+	}
+}
+
+// parseGxCoord parses the value of a gx:coord element.
+func parseGxCoord(s string) (kml.Coordinate, bool) {
+	fields := strings.Fields(s)
+	if len(fields) < 2 || len(fields) > 3 {
+		return kml.Coordinate{}, false
+	}
+	var values [3]float64
+	for i, field := range fields {
+		value, err := strconv.ParseFloat(field, 64)
+		if err != nil {
+			return kml.Coordinate{}, false
+		}
+		values[i] = value
+	}
+	return kml.Coordinate{Lon: values[0], Lat: values[1], Alt: values[2]}, true
+}
+
+// kmlCoordinates returns the coordinates of e's coordinates child.
+func kmlCoordinates(e kml.Element) []kml.Coordinate {
+	if ce, ok := child(e, "coordinates").(*kml.CoordinatesElement); ok {
+		return ce.Coordinates()
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+package main
+
+import (
+	"flag"
+	"io"
+
+	"github.com/twpayne/go-kml"
+)
+
+// runFmt runs the fmt command.
+func runFmt(fs *flag.FlagSet, args []string, stdin io.Reader, stdout io.Writer) error {
+	indent := fs.String("indent", "  ", "indent")
+	stripDefaults := fs.Bool("strip-defaults", false, "remove values that are equal to their defaults")
+	coordinatesPerLine := fs.Bool("coordinates-per-line", false, "write each coordinate on its own line")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	e, err := readKML(fs.Args(), stdin)
+	if err != nil {
+		return err
+	}
+	return kml.WriteCanonical(stdout, e, kml.CanonicalOptions{
+		Indent:             *indent,
+		StripDefaults:      *stripDefaults,
+		CoordinatesPerLine: *coordinatesPerLine,
+	})
+}
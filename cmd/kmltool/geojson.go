@@ -0,0 +1,294 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/twpayne/go-kml"
+)
+
+// errInvalidGeoJSON is returned when reading invalid GeoJSON.
+var errInvalidGeoJSON = errors.New("invalid GeoJSON")
+
+// A geoJSONObject is a GeoJSON object.
+type geoJSONObject struct {
+	Type        string                 `json:"type"`
+	Features    []*geoJSONObject       `json:"features,omitempty"`
+	Geometry    *geoJSONObject         `json:"geometry,omitempty"`
+	Geometries  []*geoJSONObject       `json:"geometries,omitempty"`
+	Properties  map[string]interface{} `json:"properties,omitempty"`
+	Coordinates json.RawMessage        `json:"coordinates,omitempty"`
+}
+
+// readGeoJSON reads a collection from GeoJSON data.
+func readGeoJSON(data []byte) (*collection, error) {
+	var o geoJSONObject
+	if err := json.Unmarshal(data, &o); err != nil {
+		return nil, err
+	}
+	c := &collection{}
+	switch o.Type {
+	case "FeatureCollection":
+		for _, fo := range o.Features {
+			f, err := geoJSONFeature(fo)
+			if err != nil {
+				return nil, err
+			}
+			c.features = append(c.features, f)
+		}
+	case "Feature":
+		f, err := geoJSONFeature(&o)
+		if err != nil {
+			return nil, err
+		}
+		c.features = append(c.features, f)
+	default:
+		g, err := geoJSONGeometry(&o)
+		if err != nil {
+			return nil, err
+		}
+		c.features = append(c.features, &feature{geometry: g})
+	}
+	return c, nil
+}
+
+// geoJSONFeature returns the feature of the GeoJSON Feature o.
+func geoJSONFeature(o *geoJSONObject) (*feature, error) {
+	if o.Type != "Feature" {
+		return nil, fmt.Errorf("%w: %s is not a Feature", errInvalidGeoJSON, o.Type)
+	}
+	f := &feature{}
+	names := make([]string, 0, len(o.Properties))
+	for name := range o.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		var value string
+		switch v := o.Properties[name].(type) {
+		case nil:
+			continue
+		case string:
+			value = v
+		case float64, bool:
+			value = fmt.Sprint(v)
+		default:
+			data, err := json.Marshal(v)
+			if err != nil {
+				return nil, err
+			}
+			value = string(data)
+		}
+		switch name {
+		case "name":
+			f.name = value
+		case "description":
+			f.description = value
+		default:
+			f.properties = append(f.properties, property{name: name, value: value})
+		}
+	}
+	if o.Geometry != nil {
+		g, err := geoJSONGeometry(o.Geometry)
+		if err != nil {
+			return nil, err
+		}
+		f.geometry = g
+	}
+	return f, nil
+}
+
+// geoJSONGeometry returns the geometry of the GeoJSON geometry o.
+func geoJSONGeometry(o *geoJSONObject) (*geometry, error) {
+	switch o.Type {
+	case "Point":
+		var position []float64
+		if err := json.Unmarshal(o.Coordinates, &position); err != nil {
+			return nil, err
+		}
+		c, err := geoJSONCoordinate(position)
+		if err != nil {
+			return nil, err
+		}
+		return &geometry{kind: geometryPoint, coordinates: []kml.Coordinate{c}}, nil
+	case "LineString", "MultiPoint":
+		var positions [][]float64
+		if err := json.Unmarshal(o.Coordinates, &positions); err != nil {
+			return nil, err
+		}
+		coordinates, err := geoJSONCoordinates(positions)
+		if err != nil {
+			return nil, err
+		}
+		if o.Type == "MultiPoint" {
+			g := &geometry{kind: geometryMulti}
+			for _, c := range coordinates {
+				g.geometries = append(g.geometries, &geometry{kind: geometryPoint, coordinates: []kml.Coordinate{c}})
+			}
+			return g, nil
+		}
+		return &geometry{kind: geometryLineString, coordinates: coordinates}, nil
+	case "Polygon", "MultiLineString":
+		var rings [][][]float64
+		if err := json.Unmarshal(o.Coordinates, &rings); err != nil {
+			return nil, err
+		}
+		g := &geometry{kind: geometryPolygon}
+		if o.Type == "MultiLineString" {
+			g.kind = geometryMulti
+		}
+		for _, ring := range rings {
+			coordinates, err := geoJSONCoordinates(ring)
+			if err != nil {
+				return nil, err
+			}
+			if o.Type == "MultiLineString" {
+				g.geometries = append(g.geometries, &geometry{kind: geometryLineString, coordinates: coordinates})
+			} else {
+				g.rings = append(g.rings, coordinates)
+			}
+		}
+		return g, nil
+	case "MultiPolygon":
+		var polygons [][][][]float64
+		if err := json.Unmarshal(o.Coordinates, &polygons); err != nil {
+			return nil, err
+		}
+		g := &geometry{kind: geometryMulti}
+		for _, polygon := range polygons {
+			child := &geometry{kind: geometryPolygon}
+			for _, ring := range polygon {
+				coordinates, err := geoJSONCoordinates(ring)
+				if err != nil {
+					return nil, err
+				}
+				child.rings = append(child.rings, coordinates)
+			}
+			g.geometries = append(g.geometries, child)
+		}
+		return g, nil
+	case "GeometryCollection":
+		g := &geometry{kind: geometryMulti}
+		for _, o := range o.Geometries {
+			child, err := geoJSONGeometry(o)
+			if err != nil {
+				return nil, err
+			}
+			g.geometries = append(g.geometries, child)
+		}
+		return g, nil
+	default:
+		return nil, fmt.Errorf("%w: unknown geometry type %q", errInvalidGeoJSON, o.Type)
+	}
+}
+
+// geoJSONCoordinates returns the coordinates of positions.
+func geoJSONCoordinates(positions [][]float64) ([]kml.Coordinate, error) {
+	coordinates := make([]kml.Coordinate, 0, len(positions))
+	for _, position := range positions {
+		c, err := geoJSONCoordinate(position)
+		if err != nil {
+			return nil, err
+		}
+		coordinates = append(coordinates, c)
+	}
+	return coordinates, nil
+}
+
+// geoJSONCoordinate returns the coordinate of position.
+func geoJSONCoordinate(position []float64) (kml.Coordinate, error) {
+	switch len(position) {
+	case 2:
+		return kml.Coordinate{Lon: position[0], Lat: position[1]}, nil
+	case 3:
+		return kml.Coordinate{Lon: position[0], Lat: position[1], Alt: position[2]}, nil
+	default:
+		return kml.Coordinate{}, fmt.Errorf("%w: position with %d values", errInvalidGeoJSON, len(position))
+	}
+}
+
+// A geoJSONOutput is a GeoJSON object for output.
+type geoJSONOutput struct {
+	Type        string            `json:"type"`
+	Features    []*geoJSONOutput  `json:"features,omitempty"`
+	Properties  map[string]string `json:"properties,omitempty"`
+	Geometry    *geoJSONOutput    `json:"geometry,omitempty"`
+	Geometries  []*geoJSONOutput  `json:"geometries,omitempty"`
+	Coordinates interface{}       `json:"coordinates,omitempty"`
+}
+
+// writeGeoJSON writes c to w as a GeoJSON FeatureCollection.
+func writeGeoJSON(w io.Writer, c *collection) error {
+	fc := &geoJSONOutput{
+		Type:     "FeatureCollection",
+		Features: []*geoJSONOutput{},
+	}
+	for _, f := range c.features {
+		properties := make(map[string]string)
+		for _, p := range f.properties {
+			properties[p.name] = p.value
+		}
+		if f.name != "" {
+			properties["name"] = f.name
+		}
+		if f.description != "" {
+			properties["description"] = f.description
+		}
+		fc.Features = append(fc.Features, &geoJSONOutput{
+			Type:       "Feature",
+			Properties: properties,
+			Geometry:   geoJSONOutputGeometry(f.geometry),
+		})
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(fc)
+}
+
+// geoJSONOutputGeometry returns g as a GeoJSON geometry.
+func geoJSONOutputGeometry(g *geometry) *geoJSONOutput {
+	switch {
+	case g == nil:
+		return nil
+	case g.kind == geometryPoint && len(g.coordinates) > 0:
+		return &geoJSONOutput{Type: "Point", Coordinates: geoJSONPosition(g.coordinates[0])}
+	case g.kind == geometryLineString || g.kind == geometryTrack:
+		return &geoJSONOutput{Type: "LineString", Coordinates: geoJSONPositions(g.coordinates)}
+	case g.kind == geometryPolygon:
+		rings := make([][][]float64, 0, len(g.rings))
+		for _, ring := range g.rings {
+			rings = append(rings, geoJSONPositions(ring))
+		}
+		return &geoJSONOutput{Type: "Polygon", Coordinates: rings}
+	case g.kind == geometryMulti:
+		gc := &geoJSONOutput{Type: "GeometryCollection", Geometries: []*geoJSONOutput{}}
+		for _, child := range g.geometries {
+			if o := geoJSONOutputGeometry(child); o != nil {
+				gc.Geometries = append(gc.Geometries, o)
+			}
+		}
+		return gc
+	default:
+		return nil
+	}
+}
+
+// geoJSONPositions returns coordinates as GeoJSON positions.
+func geoJSONPositions(coordinates []kml.Coordinate) [][]float64 {
+	positions := make([][]float64, 0, len(coordinates))
+	for _, c := range coordinates {
+		positions = append(positions, geoJSONPosition(c))
+	}
+	return positions
+}
+
+// geoJSONPosition returns c as a GeoJSON position.
+func geoJSONPosition(c kml.Coordinate) []float64 {
+	if c.Alt == 0 {
+		return []float64{c.Lon, c.Lat}
+	}
+	return []float64{c.Lon, c.Lat, c.Alt}
+}
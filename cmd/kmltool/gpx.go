@@ -0,0 +1,196 @@
+package main
+
+import (
+	"encoding/xml"
+	"io"
+	"time"
+
+	"github.com/twpayne/go-kml"
+)
+
+// gpxNamespace is the GPX 1.1 namespace.
+const gpxNamespace = "http://www.topografix.com/GPX/1/1"
+
+// A gpxPoint is a GPX waypoint, route point, or track point.
+type gpxPoint struct {
+	Lat  float64    `xml:"lat,attr"`
+	Lon  float64    `xml:"lon,attr"`
+	Ele  float64    `xml:"ele,omitempty"`
+	Time *time.Time `xml:"time,omitempty"`
+	Name string     `xml:"name,omitempty"`
+	Desc string     `xml:"desc,omitempty"`
+}
+
+// A gpxRoute is a GPX route.
+type gpxRoute struct {
+	Name   string     `xml:"name,omitempty"`
+	Desc   string     `xml:"desc,omitempty"`
+	Points []gpxPoint `xml:"rtept"`
+}
+
+// A gpxSegment is a GPX track segment.
+type gpxSegment struct {
+	Points []gpxPoint `xml:"trkpt"`
+}
+
+// A gpxTrack is a GPX track.
+type gpxTrack struct {
+	Name     string       `xml:"name,omitempty"`
+	Desc     string       `xml:"desc,omitempty"`
+	Segments []gpxSegment `xml:"trkseg"`
+}
+
+// A gpx is a GPX document.
+type gpx struct {
+	XMLName   xml.Name   `xml:"gpx"`
+	Version   string     `xml:"version,attr"`
+	Creator   string     `xml:"creator,attr"`
+	Namespace string     `xml:"xmlns,attr"`
+	Name      string     `xml:"metadata>name,omitempty"`
+	Waypoints []gpxPoint `xml:"wpt"`
+	Routes    []gpxRoute `xml:"rte"`
+	Tracks    []gpxTrack `xml:"trk"`
+}
+
+// coordinate returns p's coordinate.
+func (p *gpxPoint) coordinate() kml.Coordinate {
+	return kml.Coordinate{Lon: p.Lon, Lat: p.Lat, Alt: p.Ele}
+}
+
+// readGPX reads a collection from GPX data.
+func readGPX(data []byte) (*collection, error) {
+	var g gpx
+	if err := xml.Unmarshal(data, &g); err != nil {
+		return nil, err
+	}
+	c := &collection{
+		name: g.Name,
+	}
+	for i := range g.Waypoints {
+		w := &g.Waypoints[i]
+		c.features = append(c.features, &feature{
+			name:        w.Name,
+			description: w.Desc,
+			geometry:    &geometry{kind: geometryPoint, coordinates: []kml.Coordinate{w.coordinate()}},
+		})
+	}
+	for _, r := range g.Routes {
+		coordinates := make([]kml.Coordinate, 0, len(r.Points))
+		for i := range r.Points {
+			coordinates = append(coordinates, r.Points[i].coordinate())
+		}
+		c.features = append(c.features, &feature{
+			name:        r.Name,
+			description: r.Desc,
+			geometry:    &geometry{kind: geometryLineString, coordinates: coordinates},
+		})
+	}
+	for _, t := range g.Tracks {
+		f := &feature{
+			name:        t.Name,
+			description: t.Desc,
+		}
+		var geometries []*geometry
+		for _, s := range t.Segments {
+			geometries = append(geometries, gpxSegmentGeometry(s))
+		}
+		switch len(geometries) {
+		case 0:
+		case 1:
+			f.geometry = geometries[0]
+		default:
+			f.geometry = &geometry{kind: geometryMulti, geometries: geometries}
+		}
+		c.features = append(c.features, f)
+	}
+	return c, nil
+}
+
+// gpxSegmentGeometry returns the geometry of s, which is a track if all of
+// its points have times and a line string otherwise.
+func gpxSegmentGeometry(s gpxSegment) *geometry {
+	g := &geometry{kind: geometryTrack}
+	for i := range s.Points {
+		p := &s.Points[i]
+		g.coordinates = append(g.coordinates, p.coordinate())
+		if p.Time == nil {
+			g.kind = geometryLineString
+		} else {
+			g.times = append(g.times, p.Time.UTC())
+		}
+	}
+	if g.kind == geometryLineString {
+		g.times = nil
+	}
+	return g
+}
+
+// writeGPX writes c to w as GPX. Points are written as waypoints and line
+// strings, tracks, and polygon rings are written as track segments.
+func writeGPX(w io.Writer, c *collection) error {
+	g := &gpx{
+		Version:   "1.1",
+		Creator:   "kmltool",
+		Namespace: gpxNamespace,
+		Name:      c.name,
+	}
+	for _, f := range c.features {
+		track := gpxTrack{
+			Name: f.name,
+			Desc: f.description,
+		}
+		for _, child := range f.geometry.flatten() {
+			switch child.kind {
+			case geometryPoint:
+				for _, coordinate := range child.coordinates {
+					g.Waypoints = append(g.Waypoints, gpxPoint{
+						Lat:  coordinate.Lat,
+						Lon:  coordinate.Lon,
+						Ele:  coordinate.Alt,
+						Name: f.name,
+						Desc: f.description,
+					})
+				}
+			case geometryLineString, geometryTrack:
+				track.Segments = append(track.Segments, gpxSegmentOf(child.coordinates, child.times))
+			case geometryPolygon:
+				for _, ring := range child.rings {
+					track.Segments = append(track.Segments, gpxSegmentOf(ring, nil))
+				}
+			}
+		}
+		if len(track.Segments) != 0 {
+			g.Tracks = append(g.Tracks, track)
+		}
+	}
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(g); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// gpxSegmentOf returns a track segment with coordinates and times.
+func gpxSegmentOf(coordinates []kml.Coordinate, times []time.Time) gpxSegment {
+	s := gpxSegment{
+		Points: make([]gpxPoint, 0, len(coordinates)),
+	}
+	for i, coordinate := range coordinates {
+		p := gpxPoint{
+			Lat: coordinate.Lat,
+			Lon: coordinate.Lon,
+			Ele: coordinate.Alt,
+		}
+		if i < len(times) {
+			t := times[i]
+			p.Time = &t
+		}
+		s.Points = append(s.Points, p)
+	}
+	return s
+}
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/twpayne/go-kml"
+)
+
+// errInvalidIGC is returned when reading invalid IGC data.
+var errInvalidIGC = errors.New("invalid IGC")
+
+// readIGC reads a collection containing the track of an IGC file. The track
+// uses GPS altitudes.
+func readIGC(data []byte) (*collection, error) {
+	var date time.Time
+	var pilot, gliderType, gliderID string
+	g := &geometry{kind: geometryTrack}
+	var prev time.Time
+	s := bufio.NewScanner(bytes.NewReader(data))
+	for lineNo := 1; s.Scan(); lineNo++ {
+		line := strings.TrimRight(s.Text(), "\r")
+		switch {
+		case strings.HasPrefix(line, "HFDTE"):
+			value := strings.TrimPrefix(strings.TrimPrefix(line, "HFDTE"), "DATE:")
+			if len(value) < 6 {
+				return nil, fmt.Errorf("%w: line %d: invalid date", errInvalidIGC, lineNo)
+			}
+			var err error
+			date, err = time.Parse("020106", value[:6])
+			if err != nil {
+				return nil, fmt.Errorf("%w: line %d: %v", errInvalidIGC, lineNo, err)
+			}
+		case strings.HasPrefix(line, "HFPLT"):
+			pilot = igcHeaderValue(line)
+		case strings.HasPrefix(line, "HFGTY"):
+			gliderType = igcHeaderValue(line)
+		case strings.HasPrefix(line, "HFGID"):
+			gliderID = igcHeaderValue(line)
+		case strings.HasPrefix(line, "B"):
+			t, c, err := parseIGCB(line, date)
+			if err != nil {
+				return nil, fmt.Errorf("%w: line %d: %v", errInvalidIGC, lineNo, err)
+			}
+			for !prev.IsZero() && t.Before(prev) {
+				t = t.AddDate(0, 0, 1)
+			}
+			prev = t
+			g.times = append(g.times, t)
+			g.coordinates = append(g.coordinates, c)
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	if date.IsZero() {
+		return nil, fmt.Errorf("%w: no HFDTE record", errInvalidIGC)
+	}
+	f := &feature{
+		name:     pilot,
+		geometry: g,
+	}
+	if f.name == "" {
+		f.name = "Track"
+	}
+	if gliderType != "" {
+		f.properties = append(f.properties, property{name: "gliderType", value: gliderType})
+	}
+	if gliderID != "" {
+		f.properties = append(f.properties, property{name: "gliderID", value: gliderID})
+	}
+	return &collection{
+		name:     date.Format("2006-01-02"),
+		features: []*feature{f},
+	}, nil
+}
+
+// igcHeaderValue returns the value of the IGC header line.
+func igcHeaderValue(line string) string {
+	if i := strings.IndexByte(line, ':'); i >= 0 {
+		return strings.TrimSpace(line[i+1:])
+	}
+	return ""
+}
+
+// parseIGCB parses the B record line on date.
+func parseIGCB(line string, date time.Time) (time.Time, kml.Coordinate, error) {
+	if len(line) < 35 {
+		return time.Time{}, kml.Coordinate{}, errors.New("B record too short")
+	}
+	clock, err := time.Parse("150405", line[1:7])
+	if err != nil {
+		return time.Time{}, kml.Coordinate{}, err
+	}
+	lat, err := parseIGCAngle(line[7:15], 2, 'S')
+	if err != nil {
+		return time.Time{}, kml.Coordinate{}, err
+	}
+	lon, err := parseIGCAngle(line[15:24], 3, 'W')
+	if err != nil {
+		return time.Time{}, kml.Coordinate{}, err
+	}
+	alt, err := strconv.Atoi(line[30:35])
+	if err != nil {
+		return time.Time{}, kml.Coordinate{}, err
+	}
+	t := date.Add(time.Duration(clock.Hour())*time.Hour + time.Duration(clock.Minute())*time.Minute + time.Duration(clock.Second())*time.Second)
+	return t, kml.Coordinate{Lon: lon, Lat: lat, Alt: float64(alt)}, nil
+}
+
+// parseIGCAngle parses an IGC angle with degreeDigits digits of degrees,
+// five digits of thousandths of minutes, and a hemisphere, which is negative
+// if it is negative.
+func parseIGCAngle(s string, degreeDigits int, negative byte) (float64, error) {
+	degrees, err := strconv.Atoi(s[:degreeDigits])
+	if err != nil {
+		return 0, err
+	}
+	milliMinutes, err := strconv.Atoi(s[degreeDigits : degreeDigits+5])
+	if err != nil {
+		return 0, err
+	}
+	angle := float64(degrees) + float64(milliMinutes)/60000
+	if s[degreeDigits+5] == negative {
+		angle = -angle
+	}
+	return angle, nil
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/twpayne/go-kml/lint"
+)
+
+// runLint runs the lint command.
+func runLint(fs *flag.FlagSet, args []string, stdin io.Reader, stdout io.Writer) error {
+	profileNames := make([]string, 0, len(lint.Profiles))
+	for _, profile := range lint.Profiles {
+		profileNames = append(profileNames, string(profile))
+	}
+	profileName := fs.String("profile", string(lint.GoogleEarthPro), "consumer profile ("+strings.Join(profileNames, ", ")+")")
+	fix := fs.Bool("fix", false, "write the document with fixable problems fixed")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	profile := lint.Profile(*profileName)
+	known := false
+	for _, p := range lint.Profiles {
+		known = known || p == profile
+	}
+	if !known {
+		return fmt.Errorf("%w: unknown profile %q", errUsage, *profileName)
+	}
+	e, err := readKML(fs.Args(), stdin)
+	if err != nil {
+		return err
+	}
+
+	if *fix {
+		return writeKML(stdout, lint.Fix(e, profile))
+	}
+	problems := lint.Lint(e, profile)
+	for _, problem := range problems {
+		fmt.Fprintln(stdout, problem)
+	}
+	if len(problems) != 0 {
+		return fmt.Errorf("%d problem(s) found", len(problems))
+	}
+	return nil
+}
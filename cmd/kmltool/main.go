@@ -0,0 +1,154 @@
+// kmltool validates, lints, formats, converts, inspects, merges, splits, and
+// packs KML documents.
+//
+// Usage:
+//
+//	kmltool command [flags] [file...]
+//
+// Commands read from the named file, or from stdin if no file is given, and
+// write to stdout.
+package main
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/twpayne/go-kml"
+	"github.com/twpayne/go-kml/kmz"
+)
+
+// A command is a kmltool subcommand.
+type command struct {
+	name        string
+	description string
+	run         func(fs *flag.FlagSet, args []string, stdin io.Reader, stdout io.Writer) error
+}
+
+// commands are the kmltool subcommands, in the order they are listed in the
+// usage message.
+var commands = []command{
+	{name: "validate", description: "check a document against the KML schema, except for the order and number of children", run: runValidate},
+	{name: "lint", description: "check a document for problems in a consumer and fix them", run: runLint},
+	{name: "fmt", description: "write a document in canonical form", run: runFmt},
+	{name: "convert", description: "convert between KML, KMZ, GeoJSON, GPX, IGC, CSV, and waypoint files", run: runConvert},
+	{name: "stats", description: "print feature counts, bounds, and a size breakdown", run: runStats},
+	{name: "merge", description: "merge documents into a single document", run: runMerge},
+	{name: "split", description: "split a document into several smaller documents", run: runSplit},
+	{name: "pack", description: "pack a document and its local resources into a KMZ archive", run: runPack},
+}
+
+// errUsage is returned when kmltool is invoked incorrectly.
+var errUsage = errors.New("usage")
+
+// usage writes kmltool's usage message to w.
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "usage: kmltool command [flags] [file...]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "commands:")
+	for _, c := range commands {
+		fmt.Fprintf(w, "  %-10s %s\n", c.name, c.description)
+	}
+}
+
+// run runs kmltool with args.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	if len(args) == 0 {
+		usage(stderr)
+		return errUsage
+	}
+	for _, c := range commands {
+		if c.name != args[0] {
+			continue
+		}
+		fs := flag.NewFlagSet("kmltool "+c.name, flag.ContinueOnError)
+		fs.SetOutput(stderr)
+		return c.run(fs, args[1:], stdin, stdout)
+	}
+	usage(stderr)
+	return fmt.Errorf("%w: unknown command %q", errUsage, args[0])
+}
+
+// readInput returns the contents of the file named by the first of args, or
+// of stdin if args is empty.
+func readInput(args []string, stdin io.Reader) ([]byte, error) {
+	switch len(args) {
+	case 0:
+		return ioutil.ReadAll(stdin)
+	case 1:
+		return ioutil.ReadFile(args[0])
+	default:
+		return nil, fmt.Errorf("%w: too many arguments", errUsage)
+	}
+}
+
+// parse parses the KML document in data, which may also be a KMZ archive.
+func parse(data []byte) (kml.Element, error) {
+	if !bytes.HasPrefix(data, []byte("PK")) {
+		return kml.Parse(bytes.NewReader(data))
+	}
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+	files := make([]*zip.File, 0, len(zr.File))
+	for _, f := range zr.File {
+		if path.Ext(f.Name) == ".kml" {
+			files = append(files, f)
+		}
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("%w: no KML file in KMZ archive", kml.ErrInvalidKML)
+	}
+	sort.SliceStable(files, func(i, j int) bool {
+		return files[i].Name == kmz.DefaultFilename && files[j].Name != kmz.DefaultFilename
+	})
+	r, err := files[0].Open()
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return kml.Parse(r)
+}
+
+// readKML parses the KML document named by the first of args, or read from
+// stdin if args is empty.
+func readKML(args []string, stdin io.Reader) (kml.Element, error) {
+	data, err := readInput(args, stdin)
+	if err != nil {
+		return nil, err
+	}
+	return parse(data)
+}
+
+// writeKML writes e to w, indented.
+func writeKML(w io.Writer, e kml.Element) error {
+	if err := e.WriteIndent(w, "", "  "); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// baseName returns the name of filename without its directory or extension.
+func baseName(filename string) string {
+	base := path.Base(strings.ReplaceAll(filename, "\\", "/"))
+	return strings.TrimSuffix(base, path.Ext(base))
+}
+
+func main() {
+	if err := run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr); err != nil {
+		if !errors.Is(err, flag.ErrHelp) && err != errUsage {
+			fmt.Fprintln(os.Stderr, err)
+		}
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,233 @@
+package main
+
+import (
+	"archive/zip"
+	"bytes"
+	"flag"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+func TestGolden(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		args        []string
+		stdin       string
+		outputDir   bool
+		zip         bool
+		expectedErr bool
+	}{
+		{
+			name:  "validate",
+			args:  []string{"validate"},
+			stdin: "placemarks.kml",
+		},
+		{
+			name:        "validate_invalid",
+			args:        []string{"validate", "testdata/invalid.kml"},
+			expectedErr: true,
+		},
+		{
+			name:  "fmt",
+			args:  []string{"fmt"},
+			stdin: "placemarks.kml",
+		},
+		{
+			name:  "fmt_strip_defaults",
+			args:  []string{"fmt", "-strip-defaults", "-coordinates-per-line", "testdata/placemarks.kml"},
+			stdin: "placemarks.kml",
+		},
+		{
+			name: "convert_kml_to_geojson",
+			args: []string{"convert", "-to", "geojson", "testdata/placemarks.kml"},
+		},
+		{
+			name: "convert_track_to_gpx",
+			args: []string{"convert", "-to", "gpx", "testdata/track.kml"},
+		},
+		{
+			name: "convert_kml_to_gpx",
+			args: []string{"convert", "-to", "gpx", "testdata/placemarks.kml"},
+		},
+		{
+			name: "convert_kml_to_csv",
+			args: []string{"convert", "-to", "csv", "testdata/placemarks.kml"},
+		},
+		{
+			name: "convert_kml_to_waypoint",
+			args: []string{"convert", "-to", "waypoint", "-waypoint-format", "seeyou", "testdata/placemarks.kml"},
+		},
+		{
+			name: "convert_kml_to_kmz",
+			args: []string{"convert", "-to", "kmz", "testdata/placemarks.kml"},
+			zip:  true,
+		},
+		{
+			name:  "convert_geojson",
+			args:  []string{"convert", "-from", "geojson"},
+			stdin: "route.geojson",
+		},
+		{
+			name: "convert_gpx",
+			args: []string{"convert", "testdata/track.gpx"},
+		},
+		{
+			name: "convert_igc",
+			args: []string{"convert", "testdata/flight.igc"},
+		},
+		{
+			name: "convert_igc_to_gpx",
+			args: []string{"convert", "-to", "gpx", "testdata/flight.igc"},
+		},
+		{
+			name: "convert_csv",
+			args: []string{"convert", "-name", "Points", "testdata/points.csv"},
+		},
+		{
+			name: "convert_waypoint",
+			args: []string{"convert", "testdata/waypoints.cup"},
+		},
+		{
+			name: "stats",
+			args: []string{"stats", "testdata/placemarks.kml"},
+		},
+		{
+			name: "merge",
+			args: []string{"merge", "-name", "Merged", "testdata/placemarks.kml", "testdata/pack/doc.kml"},
+		},
+		{
+			name: "merge-duplicates",
+			args: []string{"merge", "testdata/placemarks.kml", "testdata/placemarks.kml"},
+		},
+		{
+			name:      "split",
+			args:      []string{"split", "-max-features", "2", "-master", "testdata/placemarks.kml"},
+			outputDir: true,
+		},
+		{
+			name:      "split_kmz",
+			args:      []string{"split", "-max-bytes", "700", "-max-depth", "1", "-kmz", "testdata/placemarks.kml"},
+			outputDir: true,
+		},
+		{
+			name:        "lint",
+			args:        []string{"lint", "-profile", "google-my-maps", "testdata/lint.kml"},
+			expectedErr: true,
+		},
+		{
+			name: "lint_fix",
+			args: []string{"lint", "-profile", "google-earth-web", "-fix", "testdata/lint.kml"},
+		},
+		{
+			name: "pack",
+			args: []string{"pack", "testdata/pack/doc.kml"},
+			zip:  true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			stdin := &bytes.Buffer{}
+			if tc.stdin != "" {
+				data, err := ioutil.ReadFile(filepath.Join("testdata", tc.stdin))
+				require.NoError(t, err)
+				stdin.Write(data)
+			}
+
+			args := tc.args
+			var dir string
+			if tc.outputDir {
+				var err error
+				dir, err = ioutil.TempDir("", "kmltool")
+				require.NoError(t, err)
+				defer os.RemoveAll(dir)
+				args = append([]string{args[0], "-o", dir}, args[1:]...)
+			}
+
+			stdout := &bytes.Buffer{}
+			err := run(args, stdin, stdout, ioutil.Discard)
+			if tc.expectedErr {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			actual := stdout.String()
+			if tc.zip {
+				actual = zipString(t, stdout.Bytes())
+			}
+			if tc.outputDir {
+				actual += dirString(t, dir)
+			}
+
+			goldenFilename := filepath.Join("testdata", tc.name+".golden")
+			if *update {
+				require.NoError(t, ioutil.WriteFile(goldenFilename, []byte(actual), 0o666))
+			}
+			expected, err := ioutil.ReadFile(goldenFilename)
+			require.NoError(t, err)
+			assert.Equal(t, string(expected), actual)
+		})
+	}
+}
+
+func TestUsage(t *testing.T) {
+	stderr := &strings.Builder{}
+	assert.Equal(t, errUsage, run(nil, nil, ioutil.Discard, stderr))
+	assert.True(t, strings.HasPrefix(stderr.String(), "usage: kmltool command"))
+	assert.Error(t, run([]string{"unknown"}, nil, ioutil.Discard, ioutil.Discard))
+}
+
+// zipString returns a text representation of the zip archive in data.
+func zipString(t *testing.T, data []byte) string {
+	t.Helper()
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+	sb := &strings.Builder{}
+	for _, f := range zr.File {
+		r, err := f.Open()
+		require.NoError(t, err)
+		contents, err := ioutil.ReadAll(r)
+		require.NoError(t, err)
+		require.NoError(t, r.Close())
+		writeFileString(sb, f.Name, contents)
+	}
+	return sb.String()
+}
+
+// dirString returns a text representation of the files in dir.
+func dirString(t *testing.T, dir string) string {
+	t.Helper()
+	infos, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	sb := &strings.Builder{}
+	for _, info := range infos {
+		contents, err := ioutil.ReadFile(filepath.Join(dir, info.Name()))
+		require.NoError(t, err)
+		writeFileString(sb, info.Name(), contents)
+	}
+	return sb.String()
+}
+
+// writeFileString writes a text representation of the file name with
+// contents to w. The contents of files that are not KML are summarized.
+func writeFileString(w io.Writer, name string, contents []byte) {
+	io.WriteString(w, "-- "+name+" --\n")
+	if path.Ext(name) == ".kml" {
+		w.Write(contents)
+		if !bytes.HasSuffix(contents, []byte("\n")) {
+			io.WriteString(w, "\n")
+		}
+	} else {
+		io.WriteString(w, strconv.Itoa(len(contents))+" bytes\n")
+	}
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/twpayne/go-kml"
+)
+
+// runMerge runs the merge command.
+func runMerge(fs *flag.FlagSet, args []string, stdin io.Reader, stdout io.Writer) error {
+	name := fs.String("name", "", "document name")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() == 0 {
+		return fmt.Errorf("%w: no files to merge", errUsage)
+	}
+
+	docs := make([]kml.Element, 0, fs.NArg())
+	for _, filename := range fs.Args() {
+		data, err := ioutil.ReadFile(filename)
+		if err != nil {
+			return err
+		}
+		e, err := parse(data)
+		if err != nil {
+			return fmt.Errorf("%s: %w", filename, err)
+		}
+		docs = append(docs, namedDocument(e, filename))
+	}
+
+	merged, err := kml.Merge(docs...)
+	if err != nil {
+		return err
+	}
+	if *name != "" {
+		docChildren := append([]kml.Element{kml.Name(*name)}, kml.ChildrenOf(child(merged, "Document"))...)
+		if kml.AttrValue(merged, "xmlns:gx") != "" {
+			merged = kml.GxKML(kml.Document(docChildren...))
+		} else {
+			merged = kml.KML(kml.Document(docChildren...))
+		}
+	}
+	return writeKML(stdout, merged)
+}
+
+// namedDocument returns the Document of the document root read from
+// filename, named after filename if it has no name.
+func namedDocument(root kml.Element, filename string) kml.Element {
+	var docChildren []kml.Element
+	if doc := child(root, "Document"); doc != nil {
+		if child(doc, "name") != nil {
+			return doc
+		}
+		docChildren = kml.ChildrenOf(doc)
+	} else {
+		for _, c := range kml.ChildrenOf(root) {
+			if elementName(c) != "NetworkLinkControl" {
+				docChildren = append(docChildren, c)
+			}
+		}
+	}
+	return kml.Document(append([]kml.Element{kml.Name(baseName(filename))}, docChildren...)...)
+}
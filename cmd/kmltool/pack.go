@@ -0,0 +1,77 @@
+package main
+
+import (
+	"flag"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/twpayne/go-kml"
+	"github.com/twpayne/go-kml/kmz"
+)
+
+// resourceNames are the names of the elements whose values refer to
+// resources that are packed.
+var resourceNames = map[string]bool{
+	"href":       true,
+	"sourceHref": true,
+}
+
+// runPack runs the pack command.
+func runPack(fs *flag.FlagSet, args []string, stdin io.Reader, stdout io.Writer) error {
+	dir := fs.String("C", "", "directory containing resources (default the directory of the input file, or the current directory)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	e, err := readKML(fs.Args(), stdin)
+	if err != nil {
+		return err
+	}
+	if *dir == "" {
+		*dir = "."
+		if fs.NArg() == 1 {
+			*dir = filepath.Dir(fs.Arg(0))
+		}
+	}
+
+	files := make(map[string][]byte)
+	if err := kml.Walk(e, func(_ []kml.Element, e kml.Element) error {
+		se, ok := e.(*kml.SimpleElement)
+		if !ok || !resourceNames[elementName(e)] {
+			return nil
+		}
+		name, ok := localResource(strings.TrimSpace(se.Value()))
+		if !ok {
+			return nil
+		}
+		if _, ok := files[name]; ok {
+			return nil
+		}
+		data, err := ioutil.ReadFile(filepath.Join(*dir, filepath.FromSlash(name)))
+		if err != nil {
+			return err
+		}
+		files[name] = data
+		return nil
+	}); err != nil {
+		return err
+	}
+	return kmz.Write(stdout, e, files)
+}
+
+// localResource returns the cleaned path of href and true if href is a
+// relative reference to a file inside the document's directory.
+func localResource(href string) (string, bool) {
+	u, err := url.Parse(href)
+	if err != nil || u.Scheme != "" || u.Host != "" || u.Path == "" || strings.HasPrefix(u.Path, "/") {
+		return "", false
+	}
+	name := path.Clean(u.Path)
+	if name == ".." || strings.HasPrefix(name, "../") {
+		return "", false
+	}
+	return name, name != kmz.DefaultFilename
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/twpayne/go-kml/split"
+)
+
+// runSplit runs the split command.
+func runSplit(fs *flag.FlagSet, args []string, stdin io.Reader, stdout io.Writer) error {
+	maxFeatures := fs.Int("max-features", 1000, "maximum number of features per part, 0 for unlimited")
+	maxBytes := fs.Int("max-bytes", 0, "maximum size of each part in bytes, 0 for unlimited")
+	maxDepth := fs.Int("max-depth", 0, "maximum folder depth of each part, 0 for unlimited")
+	dir := fs.String("o", ".", "output directory")
+	prefix := fs.String("prefix", split.DefaultPrefix, "part filename prefix")
+	kmz := fs.Bool("kmz", false, "write parts as KMZ archives")
+	master := fs.Bool("master", false, "also write a master document that links to the parts")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *maxFeatures < 0 || *maxBytes < 0 || *maxDepth < 0 {
+		return fmt.Errorf("%w: limits must not be negative", errUsage)
+	}
+	root, err := readKML(fs.Args(), stdin)
+	if err != nil {
+		return err
+	}
+
+	s, err := split.New(root, split.Options{
+		MaxFeatures: *maxFeatures,
+		MaxBytes:    *maxBytes,
+		MaxDepth:    *maxDepth,
+		Prefix:      *prefix,
+		KMZ:         *kmz,
+		Master:      *master,
+	})
+	if err != nil {
+		return err
+	}
+	if err := s.WriteDir(*dir); err != nil {
+		return err
+	}
+	for _, filename := range s.Filenames() {
+		fmt.Fprintln(stdout, filename)
+	}
+	return nil
+}
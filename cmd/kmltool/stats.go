@@ -0,0 +1,144 @@
+package main
+
+import (
+	"bytes"
+	"encoding/xml"
+	"flag"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+
+	"github.com/twpayne/go-kml"
+)
+
+// featureNames and geometryNames are the names of the elements counted by
+// stats.
+var (
+	featureNames = map[string]bool{
+		"Document":      true,
+		"Folder":        true,
+		"GroundOverlay": true,
+		"NetworkLink":   true,
+		"PhotoOverlay":  true,
+		"Placemark":     true,
+		"ScreenOverlay": true,
+		"gx:Tour":       true,
+	}
+	geometryNames = map[string]bool{
+		"LineString":    true,
+		"LinearRing":    true,
+		"Model":         true,
+		"MultiGeometry": true,
+		"Point":         true,
+		"Polygon":       true,
+		"gx:MultiTrack": true,
+		"gx:Track":      true,
+	}
+)
+
+// sizeCategories maps element names to the categories that their sizes are
+// attributed to.
+var sizeCategories = map[string]string{
+	"ExtendedData": "extended data",
+	"Schema":       "extended data",
+	"Snippet":      "descriptions",
+	"Style":        "styles",
+	"StyleMap":     "styles",
+	"coordinates":  "coordinates",
+	"description":  "descriptions",
+	"gx:coord":     "coordinates",
+	"gx:angles":    "coordinates",
+	"when":         "coordinates",
+}
+
+// runStats runs the stats command.
+func runStats(fs *flag.FlagSet, args []string, stdin io.Reader, stdout io.Writer) error {
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	e, err := readKML(fs.Args(), stdin)
+	if err != nil {
+		return err
+	}
+
+	features := make(map[string]int)
+	geometries := make(map[string]int)
+	sizes := make(map[string]int)
+	if err := kml.Walk(e, func(_ []kml.Element, e kml.Element) error {
+		name := elementName(e)
+		switch {
+		case featureNames[name]:
+			features[name]++
+		case geometryNames[name]:
+			geometries[name]++
+		}
+		if category, ok := sizeCategories[name]; ok {
+			size, err := compactSize(e)
+			if err != nil {
+				return err
+			}
+			sizes[category] += size
+			return kml.SkipChildren
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	total, err := compactSize(e)
+	if err != nil {
+		return err
+	}
+
+	printCounts(stdout, "features", features)
+	printCounts(stdout, "geometries", geometries)
+	fmt.Fprintln(stdout, "bounds:")
+	if b := kml.BoundsOf(e); b.IsEmpty() {
+		fmt.Fprintln(stdout, "  empty")
+	} else {
+		for _, row := range []struct {
+			name  string
+			value float64
+		}{
+			{"west", b.West},
+			{"south", b.South},
+			{"east", b.East},
+			{"north", b.North},
+			{"min alt", b.MinAlt},
+			{"max alt", b.MaxAlt},
+		} {
+			fmt.Fprintf(stdout, "  %-14s %s\n", row.name, strconv.FormatFloat(row.value, 'f', -1, 64))
+		}
+	}
+	fmt.Fprintln(stdout, "size:")
+	other := total
+	for _, category := range []string{"coordinates", "descriptions", "styles", "extended data"} {
+		fmt.Fprintf(stdout, "  %-14s %d\n", category, sizes[category])
+		other -= sizes[category]
+	}
+	fmt.Fprintf(stdout, "  %-14s %d\n", "other", other)
+	fmt.Fprintf(stdout, "  %-14s %d\n", "total", total)
+	return nil
+}
+
+// printCounts writes the counts in counts, sorted by name, under heading.
+func printCounts(w io.Writer, heading string, counts map[string]int) {
+	fmt.Fprintln(w, heading+":")
+	names := make([]string, 0, len(counts))
+	for name := range counts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(w, "  %-14s %d\n", name, counts[name])
+	}
+}
+
+// compactSize returns the size of e when written without indentation.
+func compactSize(e kml.Element) (int, error) {
+	b := &bytes.Buffer{}
+	if err := xml.NewEncoder(b).Encode(e); err != nil {
+		return 0, err
+	}
+	return b.Len(), nil
+}
@@ -0,0 +1,29 @@
+package main
+
+import (
+	"github.com/twpayne/go-kml"
+)
+
+// elementName returns the name of e.
+func elementName(e kml.Element) string {
+	start, _ := kml.StartElementOf(e)
+	return start.Name.Local
+}
+
+// child returns e's first child with name.
+func child(e kml.Element, name string) kml.Element {
+	for _, c := range kml.ChildrenOf(e) {
+		if elementName(c) == name {
+			return c
+		}
+	}
+	return nil
+}
+
+// childValue returns the value of e's first simple child with name.
+func childValue(e kml.Element, name string) string {
+	if se, ok := child(e, name).(*kml.SimpleElement); ok {
+		return se.Value()
+	}
+	return ""
+}
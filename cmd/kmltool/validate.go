@@ -0,0 +1,33 @@
+package main
+
+import (
+	"encoding/xml"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/twpayne/go-kml"
+)
+
+// runValidate runs the validate command.
+func runValidate(fs *flag.FlagSet, args []string, stdin io.Reader, stdout io.Writer) error {
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	e, err := readKML(fs.Args(), stdin)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	if start, _ := kml.StartElementOf(e); start.Name != (xml.Name{Space: kml.Namespace, Local: "kml"}) {
+		errs = append(errs, fmt.Errorf("%w: root element %s is not kml", kml.ErrInvalidKML, start.Name.Local))
+	}
+	errs = append(errs, kml.Validate(e)...)
+	for _, err := range errs {
+		fmt.Fprintln(stdout, err)
+	}
+	if len(errs) != 0 {
+		return fmt.Errorf("%d problem(s) found", len(errs))
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/twpayne/go-kml"
+	"github.com/twpayne/go-waypoint"
+)
+
+// readWaypoints reads a collection of points from waypoint data in any format
+// supported by github.com/twpayne/go-waypoint.
+func readWaypoints(data []byte) (*collection, error) {
+	wc, _, err := waypoint.Read(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	c := &collection{}
+	for _, w := range wc {
+		c.features = append(c.features, &feature{
+			name:        w.ID,
+			description: w.Description,
+			geometry: &geometry{
+				kind:        geometryPoint,
+				coordinates: []kml.Coordinate{{Lon: w.Longitude, Lat: w.Latitude, Alt: w.Altitude}},
+			},
+		})
+	}
+	return c, nil
+}
+
+// writeWaypoints writes the point features in c to w in format.
+func writeWaypoints(w io.Writer, c *collection, format string) error {
+	var wc waypoint.Collection
+	for _, f := range c.features {
+		for _, g := range f.geometry.flatten() {
+			if g.kind != geometryPoint || len(g.coordinates) == 0 {
+				continue
+			}
+			wc = append(wc, &waypoint.T{
+				ID:          f.name,
+				Description: f.description,
+				Latitude:    g.coordinates[0].Lat,
+				Longitude:   g.coordinates[0].Lon,
+				Altitude:    g.coordinates[0].Alt,
+			})
+		}
+	}
+	return waypoint.Write(w, wc, format)
+}
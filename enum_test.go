@@ -0,0 +1,64 @@
+package kml
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseEnum(t *testing.T) {
+	altitudeMode, err := ParseAltitudeModeEnum("relativeToGround")
+	require.NoError(t, err)
+	assert.Equal(t, AltitudeModeRelativeToGround, altitudeMode)
+
+	_, err = ParseAltitudeModeEnum("clampToSeaFloor")
+	assert.True(t, errors.Is(err, ErrInvalidEnumValue))
+	assert.EqualError(t, err, `kml: invalid enum value: AltitudeModeEnum "clampToSeaFloor"`)
+
+	gxAltitudeMode, err := ParseGxAltitudeModeEnum("clampToSeaFloor")
+	require.NoError(t, err)
+	assert.Equal(t, GxAltitudeModeClampToSeaFloor, gxAltitudeMode)
+}
+
+func TestEnumIsValid(t *testing.T) {
+	assert.True(t, RefreshModeOnInterval.IsValid())
+	assert.False(t, RefreshModeEnum("").IsValid())
+	assert.False(t, RefreshModeEnum("OnInterval").IsValid())
+	assert.True(t, GxFlyToModeSmooth.IsValid())
+	assert.False(t, GxFlyToModeEnum("fast").IsValid())
+}
+
+func TestEnumValues(t *testing.T) {
+	assert.Equal(t, []AltitudeModeEnum{
+		AltitudeModeClampToGround,
+		AltitudeModeRelativeToGround,
+		AltitudeModeAbsolute,
+	}, AltitudeModeEnumValues())
+	assert.Equal(t, []GxAltitudeModeEnum{
+		GxAltitudeModeClampToGround,
+		GxAltitudeModeRelativeToGround,
+		GxAltitudeModeAbsolute,
+		GxAltitudeModeClampToSeaFloor,
+		GxAltitudeModeRelativeToSeaFloor,
+	}, GxAltitudeModeEnumValues())
+	for _, value := range UnitsEnumValues() {
+		assert.True(t, value.IsValid())
+	}
+}
+
+func TestEnumUnmarshalText(t *testing.T) {
+	var config struct {
+		AltitudeMode AltitudeModeEnum `json:"altitudeMode"`
+		FlyToMode    GxFlyToModeEnum  `json:"flyToMode"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(`{"altitudeMode":"absolute","flyToMode":"bounce"}`), &config))
+	assert.Equal(t, AltitudeModeAbsolute, config.AltitudeMode)
+	assert.Equal(t, GxFlyToModeBounce, config.FlyToMode)
+	assert.Equal(t, "absolute", config.AltitudeMode.String())
+
+	err := json.Unmarshal([]byte(`{"altitudeMode":"above"}`), &config)
+	assert.True(t, errors.Is(err, ErrInvalidEnumValue))
+}
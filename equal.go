@@ -0,0 +1,159 @@
+package kml
+
+import (
+	"encoding/xml"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DefaultTolerance is the tolerance used by Equal and Diff when comparing
+// coordinates.
+const DefaultTolerance = 1e-9
+
+// Equal returns true if a and b are semantically equal. Attribute order is
+// ignored, simple values are compared in their canonical form, and
+// coordinates are compared with DefaultTolerance.
+func Equal(a, b Element) bool {
+	return len(Diff(a, b)) == 0
+}
+
+// Diff returns a description of each difference between a and b, using
+// DefaultTolerance when comparing coordinates.
+func Diff(a, b Element) []string {
+	return DiffTolerance(a, b, DefaultTolerance)
+}
+
+// DiffTolerance returns a description of each difference between a and b,
+// using tolerance when comparing coordinates.
+func DiffTolerance(a, b Element, tolerance float64) []string {
+	d := &differ{tolerance: tolerance}
+	d.diff("/"+elementName(a), a, b)
+	return d.diffs
+}
+
+type differ struct {
+	tolerance float64
+	diffs     []string
+}
+
+func (d *differ) addf(path, format string, args ...interface{}) {
+	d.diffs = append(d.diffs, path+": "+fmt.Sprintf(format, args...))
+}
+
+func (d *differ) diff(path string, a, b Element) {
+	if aName, bName := elementName(a), elementName(b); aName != bName {
+		d.addf(path, "element %s != %s", aName, bName)
+		return
+	}
+
+	if aCoordinates, ok := coordinatesOf(a); ok {
+		if bCoordinates, ok := coordinatesOf(b); ok {
+			d.diffCoordinates(path, aCoordinates, bCoordinates)
+			return
+		}
+	}
+
+	switch a := a.(type) {
+	case *SimpleElement:
+		b, ok := b.(*SimpleElement)
+		if !ok {
+			d.addf(path, "simple element != %T", b)
+			return
+		}
+		d.diffAttrs(path, a.StartElement, b.StartElement)
+		if a.Name.Local == "gx:coord" {
+			aCoord, aOK := parseGxCoord(a.value)
+			bCoord, bOK := parseGxCoord(b.value)
+			if aOK && bOK {
+				d.diffCoordinates(path, []Coordinate{aCoord}, []Coordinate{bCoord})
+				return
+			}
+		}
+		if aValue, bValue := canonicalValue(a.Name.Local, a.value), canonicalValue(b.Name.Local, b.value); aValue != bValue {
+			d.addf(path, "%q != %q", aValue, bValue)
+		}
+	case *CompoundElement, *SharedElement:
+		aCE, bCE := compoundElement(a), compoundElement(b)
+		if bCE == nil {
+			d.addf(path, "compound element != %T", b)
+			return
+		}
+		d.diffAttrs(path, aCE.StartElement, bCE.StartElement)
+		d.diffChildren(path, aCE.children, bCE.children)
+	default:
+		if aString, bString := marshalString(a), marshalString(b); aString != bString {
+			d.addf(path, "%s != %s", aString, bString)
+		}
+	}
+}
+
+func (d *differ) diffAttrs(path string, a, b xml.StartElement) {
+	aAttrs, bAttrs := attrStrings(a.Attr), attrStrings(b.Attr)
+	if strings.Join(aAttrs, " ") != strings.Join(bAttrs, " ") {
+		d.addf(path, "attributes %s != %s", strings.Join(aAttrs, " "), strings.Join(bAttrs, " "))
+	}
+}
+
+func (d *differ) diffChildren(path string, a, b []Element) {
+	counts := make(map[string]int)
+	for i := 0; i < len(a) || i < len(b); i++ {
+		var name string
+		if i < len(a) {
+			name = elementName(a[i])
+		} else {
+			name = elementName(b[i])
+		}
+		counts[name]++
+		childPath := path + "/" + name + "[" + strconv.Itoa(counts[name]) + "]"
+		switch {
+		case i >= len(b):
+			d.addf(childPath, "missing in second element")
+		case i >= len(a):
+			d.addf(childPath, "missing in first element")
+		default:
+			d.diff(childPath, a[i], b[i])
+		}
+	}
+}
+
+func (d *differ) diffCoordinates(path string, a, b []Coordinate) {
+	if len(a) != len(b) {
+		d.addf(path, "%d coordinates != %d coordinates", len(a), len(b))
+		return
+	}
+	for i := range a {
+		if math.Abs(a[i].Lon-b[i].Lon) > d.tolerance ||
+			math.Abs(a[i].Lat-b[i].Lat) > d.tolerance ||
+			math.Abs(a[i].Alt-b[i].Alt) > d.tolerance {
+			d.addf(path, "coordinate %d: %s != %s", i, formatCoordinate(a[i]), formatCoordinate(b[i]))
+		}
+	}
+}
+
+// elementName returns the name of e.
+func elementName(e Element) string {
+	if _, ok := coordinatesOf(e); ok {
+		return "coordinates"
+	}
+	if start, ok := StartElementOf(e); ok {
+		return start.Name.Local
+	}
+	return fmt.Sprintf("%T", e)
+}
+
+// attrStrings returns attrs formatted as strings, sorted.
+func attrStrings(attrs []xml.Attr) []string {
+	ss := make([]string, 0, len(attrs))
+	for _, attr := range attrs {
+		name := attr.Name.Local
+		if attr.Name.Space != "" {
+			name = attr.Name.Space + ":" + name
+		}
+		ss = append(ss, name+"="+strconv.Quote(attr.Value))
+	}
+	sort.Strings(ss)
+	return ss
+}
@@ -0,0 +1,76 @@
+package kml
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff(t *testing.T) {
+	attrs := Schema("id", "name")
+	attrs.Attr[0], attrs.Attr[1] = attrs.Attr[1], attrs.Attr[0]
+	for _, tc := range []struct {
+		name     string
+		a        Element
+		b        Element
+		expected []string
+	}{
+		{
+			name: "equal",
+			a:    Placemark(Name("a"), Point(Coordinates(Coordinate{Lon: 1, Lat: 2}))),
+			b:    Placemark(Name("a"), Point(CoordinatesArray([]float64{1, 2 + 1e-12}))),
+		},
+		{
+			name: "canonical_values",
+			a:    Placemark(Visibility(true), Extrude(false)),
+			b:    Placemark(&SimpleElement{StartElement: xml.StartElement{Name: xml.Name{Local: "visibility"}}, value: "true"}, Extrude(false)),
+		},
+		{
+			name: "attribute_order",
+			a:    Schema("id", "name"),
+			b:    attrs,
+		},
+		{
+			name:     "element_name",
+			a:        Placemark(),
+			b:        Folder(),
+			expected: []string{"/Placemark: element Placemark != Folder"},
+		},
+		{
+			name: "values",
+			a:    Document(Placemark(Name("a")), Placemark(Name("b"))),
+			b:    Document(Placemark(Name("a")), Placemark(Name("c"))),
+			expected: []string{
+				`/Document/Placemark[2]/name[1]: "b" != "c"`,
+			},
+		},
+		{
+			name: "coordinates",
+			a:    Point(Coordinates(Coordinate{Lon: 1, Lat: 2})),
+			b:    Point(Coordinates(Coordinate{Lon: 1, Lat: 2.1})),
+			expected: []string{
+				`/Point/coordinates[1]: coordinate 0: 1,2 != 1,2.1`,
+			},
+		},
+		{
+			name: "gx_coord",
+			a:    GxTrack(GxCoord(Coordinate{Lon: 1, Lat: 2})),
+			b:    GxTrack(GxCoord(Coordinate{Lon: 1, Lat: 2 + 1e-12})),
+		},
+		{
+			name: "missing",
+			a:    Placemark(Name("a")),
+			b:    SharedPlacemark("pm", Name("a"), Description("b")),
+			expected: []string{
+				`/Placemark: attributes  != id="pm"`,
+				`/Placemark/description[1]: missing in first element`,
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, Diff(tc.a, tc.b))
+			assert.Equal(t, tc.expected == nil, Equal(tc.a, tc.b))
+		})
+	}
+}
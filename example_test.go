@@ -64,6 +64,36 @@ func ExampleDescription() {
 	// </kml>
 }
 
+func ExampleDescriptionCDATA() {
+	k := kml.KML(
+		kml.Document(
+			kml.Placemark(
+				kml.Name("CDATA example"),
+				kml.DescriptionCDATA(`<h1>CDATA Tags are useful!</h1> <p><font color="red">Text is <i>more readable</i> and <b>easier to write</b> when you can avoid using entity references.</font></p>`),
+				kml.Point(
+					kml.Coordinates(kml.Coordinate{Lon: 102.595626, Lat: 14.996729}),
+				),
+			),
+		),
+	)
+	if err := k.WriteIndent(os.Stdout, "", "  "); err != nil {
+		log.Fatal(err)
+	}
+	// Output:
+	// <?xml version="1.0" encoding="UTF-8"?>
+	// <kml xmlns="http://www.opengis.net/kml/2.2">
+	//   <Document>
+	//     <Placemark>
+	//       <name>CDATA example</name>
+	//       <description><![CDATA[<h1>CDATA Tags are useful!</h1> <p><font color="red">Text is <i>more readable</i> and <b>easier to write</b> when you can avoid using entity references.</font></p>]]></description>
+	//       <Point>
+	//         <coordinates>102.595626,14.996729</coordinates>
+	//       </Point>
+	//     </Placemark>
+	//   </Document>
+	// </kml>
+}
+
 func ExampleGroundOverlay() {
 	k := kml.KML(
 		kml.Folder(
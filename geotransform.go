@@ -0,0 +1,61 @@
+package kml
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// A GeoTransform is an affine transformation from pixel coordinates to
+// geographical coordinates, with elements in GDAL order:
+//
+//	Lon = gt[0] + x*gt[1] + y*gt[2]
+//	Lat = gt[3] + x*gt[4] + y*gt[5]
+//
+// where x and y are measured from the top left corner of the top left pixel.
+type GeoTransform [6]float64
+
+// errWorldFile is returned when a world file cannot be parsed.
+var errWorldFile = errors.New("kml: world file must contain six numbers")
+
+// WorldFileGeoTransform returns the GeoTransform equivalent to the world file
+// parameters a, d, b, e, c, and f, in the order that they appear in a world
+// file. World files reference the center of the top left pixel.
+func WorldFileGeoTransform(a, d, b, e, c, f float64) GeoTransform {
+	return GeoTransform{c - a/2 - b/2, a, b, f - d/2 - e/2, d, e}
+}
+
+// ParseWorldFile parses a world file from r.
+func ParseWorldFile(r io.Reader) (GeoTransform, error) {
+	var values []float64
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line == "" {
+			continue
+		}
+		value, err := strconv.ParseFloat(line, 64)
+		if err != nil {
+			return GeoTransform{}, fmt.Errorf("kml: world file: %w", err)
+		}
+		values = append(values, value)
+	}
+	if err := s.Err(); err != nil {
+		return GeoTransform{}, err
+	}
+	if len(values) != 6 {
+		return GeoTransform{}, errWorldFile
+	}
+	return WorldFileGeoTransform(values[0], values[1], values[2], values[3], values[4], values[5]), nil
+}
+
+// Apply returns the coordinate of pixel position x, y.
+func (gt GeoTransform) Apply(x, y float64) Coordinate {
+	return Coordinate{
+		Lon: gt[0] + x*gt[1] + y*gt[2],
+		Lat: gt[3] + x*gt[4] + y*gt[5],
+	}
+}
@@ -0,0 +1,21 @@
+package kml
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseWorldFile(t *testing.T) {
+	gt, err := ParseWorldFile(strings.NewReader("0.5\n0\n0\n-0.5\n10.25\n49.75\n"))
+	require.NoError(t, err)
+	assert.Equal(t, GeoTransform{10, 0.5, 0, 50, 0, -0.5}, gt)
+	assert.Equal(t, Coordinate{Lon: 11, Lat: 49}, gt.Apply(2, 2))
+
+	_, err = ParseWorldFile(strings.NewReader("1\n2\n"))
+	assert.Error(t, err)
+	_, err = ParseWorldFile(strings.NewReader("1\n2\nx\n"))
+	assert.Error(t, err)
+}
@@ -7,6 +7,7 @@ import (
 	"go/format"
 	"io/ioutil"
 	"os"
+	"sort"
 	"strings"
 	"text/template"
 
@@ -17,6 +18,10 @@ var (
 	output    = flag.String("o", "/dev/stdout", "output")
 	gofmt     = flag.Bool("f", false, "format")
 	namespace = flag.String("n", "", "namespace")
+	reference = flag.String("r", "", "referenced kml: XSD")
+	strict    = flag.Bool("s", false, "generate package strict from all XSDs")
+	schema    = flag.Bool("m", false, "generate schema tables from all XSDs")
+	profile   = flag.Bool("p", false, "generate KML 2.3 profile tables from all XSDs")
 )
 
 type stringValue struct {
@@ -38,26 +43,126 @@ type attribute struct {
 	Type string `xml:"type,attr"`
 }
 
-type complexType struct {
-	Name       string      `xml:"name,attr"`
+type particles struct {
+	Elements  []element   `xml:"element"`
+	Choices   []particles `xml:"choice"`
+	Sequences []particles `xml:"sequence"`
+}
+
+type extension struct {
+	Base       string      `xml:"base,attr"`
 	Attributes []attribute `xml:"attribute"`
+	Sequence   particles   `xml:"sequence"`
+}
+
+type content struct {
+	Extension extension `xml:"extension"`
+}
+
+type complexType struct {
+	Name           string      `xml:"name,attr"`
+	Attributes     []attribute `xml:"attribute"`
+	ComplexContent content     `xml:"complexContent"`
+	SimpleContent  content     `xml:"simpleContent"`
+	Sequence       particles   `xml:"sequence"`
 }
 
 type element struct {
-	Name     string `xml:"name,attr"`
-	Type     string `xml:"type,attr"`
-	Abstract bool   `xml:"abstract,attr"`
+	Name              string `xml:"name,attr"`
+	Ref               string `xml:"ref,attr"`
+	Type              string `xml:"type,attr"`
+	Abstract          bool   `xml:"abstract,attr"`
+	Default           string `xml:"default,attr"`
+	SubstitutionGroup string `xml:"substitutionGroup,attr"`
 }
 
 type xsd struct {
-	SimpleTypes  []simpleType  `xml:"simpleType"`
-	ComplexTypes []complexType `xml:"complexType"`
-	Elements     []element     `xml:"element"`
+	TargetNamespace string        `xml:"targetNamespace,attr"`
+	SimpleTypes     []simpleType  `xml:"simpleType"`
+	ComplexTypes    []complexType `xml:"complexType"`
+	Elements        []element     `xml:"element"`
+}
+
+type simpleElementType struct {
+	Name string
+	Kind string
+}
+
+// An attributeParam is a constructor parameter that sets an attribute.
+type attributeParam struct {
+	Name    string
+	Type    string
+	XMLName string
+	Value   string
+}
+
+// An attributeElement is an element with attributes.
+type attributeElement struct {
+	Kind   string
+	ID     bool
+	Params []attributeParam
+	// Suffix is appended to the names of the element's attribute
+	// constructors, if it also has constructors without attributes.
+	Suffix string
+}
+
+// Signature returns the parameters of e's constructor, except for children,
+// preceded by string parameters named leading, with types qualified by
+// qualifier. Consecutive parameters of the same type are grouped.
+func (e *attributeElement) Signature(qualifier string, leading ...string) string {
+	var names, types []string
+	for _, name := range leading {
+		names = append(names, name)
+		types = append(types, "string")
+	}
+	if e.ID {
+		names = append(names, "id")
+		types = append(types, "string")
+	}
+	for _, p := range e.Params {
+		t := p.Type
+		if strings.ToUpper(t[:1]) == t[:1] {
+			t = qualifier + t
+		}
+		names = append(names, p.Name)
+		types = append(types, t)
+	}
+	if e.Kind == "simple" {
+		names = append(names, "value")
+		types = append(types, "string")
+	}
+	var params []string
+	for i, name := range names {
+		if i+1 < len(names) && types[i+1] == types[i] {
+			params = append(params, name)
+		} else {
+			params = append(params, name+" "+types[i])
+		}
+	}
+	return strings.Join(params, ", ")
+}
+
+// Args returns the arguments to e's constructor, except for children.
+func (e *attributeElement) Args() string {
+	var args []string
+	if e.ID {
+		args = append(args, "id")
+	}
+	for _, p := range e.Params {
+		args = append(args, p.Name)
+	}
+	if e.Kind == "simple" {
+		args = append(args, "value")
+	}
+	return strings.Join(args, ", ")
 }
 
 type data struct {
-	Namespace string
-	XSD       *xsd
+	Namespace          string
+	XSD                *xsd
+	Objects            map[string]bool
+	SimpleElementTypes []simpleElementType
+	Attributes         map[string]*attributeElement
 }
 
 var outputTemplate = template.Must(template.New("output").Funcs(sprig.HermeticTxtFuncMap()).Parse(`
@@ -71,7 +176,14 @@ var outputTemplate = template.Must(template.New("output").Funcs(sprig.HermeticTx
 package kml
 
 import (
+{{ if .Attributes -}}
+	"encoding/xml"
+{{ end -}}
+	"fmt"
 	"image/color"
+{{ if .Attributes -}}
+	"strconv"
+{{ end -}}
 {{ if ne $namespace "gx:" -}}
 	"time"
 {{ end -}}
@@ -94,16 +206,104 @@ const (
 	{{ $typeName | trimSuffix "Enum" }}{{ .Value | title }} {{ $typeName }} = "{{ .Value }}"
 {{- end }}
 )
+
+// {{ $typeName }}Values returns all {{ $typeName }}s.
+func {{ $typeName }}Values() []{{ $typeName }} {
+	return []{{ $typeName }}{
+		{{- if and (eq $namespace "gx:") (eq .Name "altitudeModeEnumType") }}
+		GxAltitudeModeClampToGround,
+		GxAltitudeModeRelativeToGround,
+		GxAltitudeModeAbsolute,
+		{{- end -}}
+		{{- range .Restriction.Enumerations }}
+		{{ $typeName | trimSuffix "Enum" }}{{ .Value | title }},
+		{{- end }}
+	}
+}
+
+// Parse{{ $typeName }} parses s as a{{ if regexMatch "^[AEIOU]" $typeName }}n{{ end }} {{ $typeName }}.
+func Parse{{ $typeName }}(s string) ({{ $typeName }}, error) {
+	e := {{ $typeName }}(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("%w: {{ $typeName }} %q", ErrInvalidEnumValue, s)
+	}
+	return e, nil
+}
+
+// IsValid returns true if e is a valid {{ $typeName }}.
+func (e {{ $typeName }}) IsValid() bool {
+	for _, value := range {{ $typeName }}Values() {
+		if e == value {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns e as a string.
+func (e {{ $typeName }}) String() string {
+	return string(e)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (e *{{ $typeName }}) UnmarshalText(text []byte) error {
+	value, err := Parse{{ $typeName }}(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
 {{ end -}}
 {{ end -}}
 
 {{ range .XSD.Elements -}}
-{{ if and (not .Abstract) (not (regexMatch "^(angles|coord|coordinates|kml|linkSnippet|option|AbstractTourPrimitive|Scale|Schema|SchemaData|SimpleArrayField|SimpleData|SimpleField|Snippet)$" .Name)) -}}
+{{ $element := . -}}
+{{ if and (not .Abstract) (not (regexMatch "^(angles|coord|coordinates|kml|AbstractTourPrimitive|Snippet)$" .Name)) -}}
 {{ $functionNamePrefix := "" -}}
 {{ if eq $namespace "gx:" -}}
 	{{ $functionNamePrefix = "Gx" -}}
 {{ end -}}
 {{ $functionName := printf "%s%s" $functionNamePrefix (.Name | title | replace "Fov" "FOV" | replace "Http" "HTTP" | replace "Kml" "KML" | replace "Lod" "LOD" | replace "Url" "URL") -}}
+{{ if eq .Name "Scale" -}}
+	{{ $functionName = "ModelScale" -}}
+{{ end -}}
+{{ $attrs := index $.Attributes .Name -}}
+{{ $suffix := "" -}}
+{{ if $attrs -}}
+{{ $suffix = $attrs.Suffix -}}
+{{ $returnType := "*CompoundElement" -}}
+{{ if $attrs.ID }}{{ $returnType = "*SharedElement" }}{{ else if ne $attrs.Kind "compound" }}{{ $returnType = "*SimpleElement" }}{{ end -}}
+// {{ $functionName }}{{ $suffix }} returns a new {{ $element.Name }} element.
+func {{ $functionName }}{{ $suffix }}({{ $attrs.Signature "" }}{{ if eq $attrs.Kind "compound" }}, children ...Element{{ end }}) {{ $returnType }} {
+	return {{ if $attrs.ID }}newSharedEAttr("{{ $namespace }}{{ $element.Name }}", id, {{ else if eq $attrs.Kind "compound" }}newCEAttr("{{ $namespace }}{{ $element.Name }}", {{ else }}newSEAttr("{{ $namespace }}{{ $element.Name }}", {{ end }}[]xml.Attr{
+	{{- range $attrs.Params }}
+		{Name: xml.Name{Local: "{{ .XMLName }}"}, Value: {{ .Value }}},
+	{{- end }}
+	}, {{ if eq $attrs.Kind "compound" }}children{{ else if eq $attrs.Kind "simple" }}value{{ else }}""{{ end }})
+}
+{{ if and (eq $attrs.Kind "compound") (not $attrs.ID) (index $.Objects $element.Name) }}
+// Shared{{ $functionName }}{{ $suffix }} returns a new shared {{ $element.Name }} element.
+func Shared{{ $functionName }}{{ $suffix }}({{ $attrs.Signature "" "id" }}, children ...Element) *SharedElement {
+	return newSharedEAttr("{{ $namespace }}{{ $element.Name }}", id, []xml.Attr{
+	{{- range $attrs.Params }}
+		{Name: xml.Name{Local: "{{ .XMLName }}"}, Value: {{ .Value }}},
+	{{- end }}
+	}, children)
+}
+
+// Target{{ $functionName }}{{ $suffix }} returns a new {{ $element.Name }} element with a targetId.
+func Target{{ $functionName }}{{ $suffix }}({{ $attrs.Signature "" "targetID" }}, children ...Element) *CompoundElement {
+	return newCEAttr("{{ $namespace }}{{ $element.Name }}", []xml.Attr{
+		{Name: xml.Name{Local: "targetId"}, Value: targetID},
+	{{- range $attrs.Params }}
+		{Name: xml.Name{Local: "{{ .XMLName }}"}, Value: {{ .Value }}},
+	{{- end }}
+	}, children)
+}
+{{ end -}}
+{{ end -}}
+{{ if or (not $attrs) $suffix -}}
 {{ $goType := .Type -}}
 {{ $constructorName := printf "newSE%s" (.Type | title) -}}
 {{ $returnType := "*SimpleElement" -}}
@@ -153,37 +353,281 @@ const (
 func {{ $functionName }}({{ $arg }} {{ $goType }}) {{ $returnType }} {
 	return {{ $constructorName }}("{{ $namespace }}{{ .Name }}", {{ $value }})
 }
+{{ if eq .Type "kml:dateTimeType" }}
+// {{ $functionName }}KMLTime returns a new {{ .Name }} element with a value of
+// any precision.
+func {{ $functionName }}KMLTime(value KMLTime) *SimpleElement {
+	return newSEString("{{ $namespace }}{{ .Name }}", value.String())
+}
+{{ end -}}
+{{ if and (eq $returnType "*CompoundElement") (index $.Objects .Name) }}
+// Shared{{ $functionName }} returns a new shared {{ .Name }} element.
+func Shared{{ $functionName }}(id string, children ...Element) *SharedElement {
+	return newSharedE("{{ $namespace }}{{ .Name }}", id, children)
+}
+
+// Target{{ $functionName }} returns a new {{ .Name }} element with a targetId.
+func Target{{ $functionName }}(targetID string, children ...Element) *CompoundElement {
+	return newTargetE("{{ $namespace }}{{ .Name }}", targetID, children)
+}
+{{ end -}}
 {{ end -}}
 {{ end -}}
+{{ end }}
+// {{ if eq $namespace "gx:" }}gxSimpleElementTypes{{ else }}simpleElementTypes{{ end }} maps simple element names to their types.
+var {{ if eq $namespace "gx:" }}gxSimpleElementTypes{{ else }}simpleElementTypes{{ end }} = map[string]simpleElementType{
+{{- range .SimpleElementTypes }}
+	"{{ $namespace }}{{ .Name }}": { kind: {{ .Kind }} },
+{{- end }}
+}
 `))
 
+func readXSD(filename string) (*xsd, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	x := &xsd{}
+	if err := xml.NewDecoder(f).Decode(x); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// objectElements returns the names of the elements in x whose types are
+// derived from kml:AbstractObjectType, resolving types in x with prefix and
+// types in refs with the kml: prefix.
+func objectElements(x *xsd, prefix string, refs ...*xsd) map[string]bool {
+	bases := make(map[string]string)
+	for _, ref := range refs {
+		for _, ct := range ref.ComplexTypes {
+			bases["kml:"+ct.Name] = ct.ComplexContent.Extension.Base
+		}
+	}
+	for _, ct := range x.ComplexTypes {
+		bases[prefix+ct.Name] = ct.ComplexContent.Extension.Base
+	}
+
+	objects := make(map[string]bool)
+	for _, e := range x.Elements {
+		for t := e.Type; t != ""; t = bases[t] {
+			if t == "kml:AbstractObjectType" {
+				objects[e.Name] = true
+				break
+			}
+		}
+	}
+	return objects
+}
+
+// attributeTypes maps XSD attribute types to Go types and the formats of their
+// values.
+var attributeTypes = map[string]struct {
+	goType string
+	format string
+}{
+	"ID":      {goType: "string", format: "%s"},
+	"anyURI":  {goType: "string", format: "%s"},
+	"boolean": {goType: "bool", format: "strconv.FormatBool(%s)"},
+	"double":  {goType: "float64", format: "strconv.FormatFloat(%s, 'f', -1, 64)"},
+	"int":     {goType: "int", format: "strconv.Itoa(%s)"},
+	"string":  {goType: "string", format: "%s"},
+}
+
+// attributeTypeOverrides maps attributes to Go types that are more specific
+// than their XSD types.
+var attributeTypeOverrides = map[string]string{
+	"gx:option/name": "GxOptionName",
+}
+
+// attributeVariants are elements whose constructors predate attribute
+// support. They keep their constructors without attributes, and their
+// attribute constructors are named with a suffix.
+var attributeVariants = map[string]bool{
+	"kml:Data":           true,
+	"gx:SimpleArrayData": true,
+}
+
+// attributeParamName returns the Go parameter name of the attribute name.
+func attributeParamName(name string) string {
+	switch name {
+	case "type":
+		return "_type"
+	default:
+		return strings.ReplaceAll(name, "Url", "URL")
+	}
+}
+
+// attributeElements returns the elements in x with attributes, except for
+// the id and targetId attributes of kml:AbstractObjectType and attributes of
+// simple types, keyed by element name.
+func attributeElements(x *xsd, prefix string) map[string]*attributeElement {
+	complexTypes := make(map[string]complexType)
+	for _, ct := range x.ComplexTypes {
+		complexTypes[prefix+ct.Name] = ct
+	}
+	attributeElements := make(map[string]*attributeElement)
+	for _, e := range x.Elements {
+		ct, ok := complexTypes[e.Type]
+		if !ok || e.Type == "kml:vec2Type" {
+			continue
+		}
+		var attributes []attribute
+		attributes = append(attributes, ct.Attributes...)
+		attributes = append(attributes, ct.ComplexContent.Extension.Attributes...)
+		attributes = append(attributes, ct.SimpleContent.Extension.Attributes...)
+		if len(attributes) == 0 {
+			continue
+		}
+		ae := &attributeElement{
+			Kind: "empty",
+		}
+		switch {
+		case ct.SimpleContent.Extension.Base != "":
+			ae.Kind = "simple"
+		case ct.ComplexContent.Extension.Base != "" || len(ct.Sequence.Elements) != 0:
+			ae.Kind = "compound"
+		}
+		// Order parameters with id first, name second, and the rest in
+		// schema order.
+		sort.SliceStable(attributes, func(i, j int) bool {
+			rank := func(a attribute) int {
+				switch a.Name {
+				case "id":
+					return 0
+				case "name":
+					return 1
+				default:
+					return 2
+				}
+			}
+			return rank(attributes[i]) < rank(attributes[j])
+		})
+		for _, a := range attributes {
+			if a.Name == "id" {
+				ae.ID = true
+				continue
+			}
+			at, ok := attributeTypes[a.Type]
+			if !ok {
+				at = attributeTypes["string"]
+			}
+			name := attributeParamName(a.Name)
+			goType, value := at.goType, fmt.Sprintf(at.format, name)
+			if override, ok := attributeTypeOverrides[prefix+e.Name+"/"+a.Name]; ok {
+				goType, value = override, "string("+name+")"
+			}
+			ae.Params = append(ae.Params, attributeParam{
+				Name:    name,
+				Type:    goType,
+				XMLName: a.Name,
+				Value:   value,
+			})
+		}
+		if attributeVariants[prefix+e.Name] {
+			ae.Suffix = "With"
+			for i, p := range ae.Params {
+				if i > 0 {
+					ae.Suffix += "And"
+				}
+				ae.Suffix += strings.Title(p.XMLName)
+			}
+		}
+		attributeElements[e.Name] = ae
+	}
+	return attributeElements
+}
+
+// simpleElementTypes returns the types of the simple elements in x.
+func simpleElementTypes(x *xsd) []simpleElementType {
+	complexTypes := make(map[string]bool)
+	for _, ct := range x.ComplexTypes {
+		complexTypes[ct.Name] = true
+	}
+	var types []simpleElementType
+	for _, e := range x.Elements {
+		if e.Abstract || e.Type == "" || e.Name == strings.Title(e.Name) || e.Name == "coordinates" {
+			continue
+		}
+		if i := strings.Index(e.Type, ":"); i >= 0 && complexTypes[e.Type[i+1:]] {
+			continue
+		}
+		kind := "simpleKindString"
+		switch {
+		case e.Type == "boolean":
+			kind = "simpleKindBool"
+		case e.Type == "int" || e.Type == "integer":
+			kind = "simpleKindInt"
+		case e.Type == "double" || e.Type == "float" || e.Type == "gx:outerWidthType" || strings.HasPrefix(e.Type, "kml:angle"):
+			kind = "simpleKindFloat"
+		case e.Type == "kml:colorType":
+			kind = "simpleKindColor"
+		}
+		types = append(types, simpleElementType{
+			Name: e.Name,
+			Kind: kind,
+		})
+	}
+	return types
+}
+
 func run() error {
 	flag.Parse()
 
-	f, err := os.Open(flag.Arg(0))
+	if *strict {
+		return runStrict(flag.Args())
+	}
+
+	if *schema {
+		return runSchema(flag.Args())
+	}
+
+	if *profile {
+		return runProfile(flag.Args())
+	}
+
+	x, err := readXSD(flag.Arg(0))
 	if err != nil {
 		return err
 	}
-	defer f.Close()
 
-	xsd := &xsd{}
-	if err := xml.NewDecoder(f).Decode(xsd); err != nil {
-		return err
+	var refs []*xsd
+	if *reference != "" {
+		ref, err := readXSD(*reference)
+		if err != nil {
+			return err
+		}
+		refs = append(refs, ref)
+	}
+
+	prefix := *namespace
+	if prefix == "" {
+		prefix = "kml:"
 	}
 
 	source := &strings.Builder{}
 	if err := outputTemplate.Execute(source, data{
-		Namespace: *namespace,
-		XSD:       xsd,
+		Namespace:          *namespace,
+		XSD:                x,
+		Objects:            objectElements(x, prefix, refs...),
+		SimpleElementTypes: simpleElementTypes(x),
+		Attributes:         attributeElements(x, prefix),
 	}); err != nil {
 		return err
 	}
 
+	return writeSource(source.String())
+}
+
+// writeSource writes source to the output, formatting it if requested.
+func writeSource(source string) error {
 	if !*gofmt {
-		return ioutil.WriteFile(*output, []byte(source.String()), 0o666)
+		return ioutil.WriteFile(*output, []byte(source), 0o666)
 	}
 
-	formattedSource, err := format.Source([]byte(source.String()))
+	formattedSource, err := format.Source([]byte(source))
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,230 @@
+package main
+
+import (
+	"encoding/xml"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// namespace23 is the KML 2.3 namespace.
+const namespace23 = "http://www.opengis.net/kml/2.3"
+
+// An xsdNode is a node in an XSD, in document order.
+type xsdNode struct {
+	XMLName  xml.Name
+	Name     string    `xml:"name,attr"`
+	Ref      string    `xml:"ref,attr"`
+	Base     string    `xml:"base,attr"`
+	Children []xsdNode `xml:",any"`
+}
+
+// child returns n's first child with local name, if any.
+func (n *xsdNode) child(name string) *xsdNode {
+	for i := range n.Children {
+		if n.Children[i].XMLName.Local == name {
+			return &n.Children[i]
+		}
+	}
+	return nil
+}
+
+// An orderedComplexType is a complex type with the prefix of its namespace,
+// with its particles in document order.
+type orderedComplexType struct {
+	prefix string
+	node   xsdNode
+}
+
+type profileData struct {
+	Names    map[string]string
+	Children map[string]map[string]int
+}
+
+var profileTemplate = template.Must(template.New("profile").Parse(`
+// Code generated by github.com/twpayne/go-kml/internal/generate. DO NOT EDIT.
+
+package kml
+
+// kml23Names maps Google extensions to the KML 2.3 elements that replace
+// them.
+var kml23Names = map[string]string{
+{{- range $gxName, $name := .Names }}
+	{{ printf "%q" $gxName }}: {{ printf "%q" $name }},
+{{- end }}
+}
+
+// kml23Children maps the names of the compound elements in KML 2.3 to the
+// positions of their children in the schema. Children that can appear at the
+// same position, for example the members of a substitution group, share a
+// position.
+var kml23Children = map[string]map[string]int{
+{{- range $name, $children := .Children }}
+	{{ printf "%q" $name }}: {
+{{- range $child, $position := $children }}
+		{{ printf "%q" $child }}: {{ $position }},
+{{- end }}
+	},
+{{- end }}
+}
+`))
+
+// A profiler computes the KML 2.3 profile tables.
+type profiler struct {
+	m     *model
+	types map[string]orderedComplexType
+	names map[string]string
+	next  int
+}
+
+// runProfile generates the KML 2.3 profile tables of package kml from the
+// XSDs in filenames. Definitions in later XSDs replace definitions with the
+// same name in earlier XSDs, so the KML 2.3 XSD should be last.
+func runProfile(filenames []string) error {
+	m, err := readModel(filenames)
+	if err != nil {
+		return err
+	}
+	p := &profiler{
+		m:     m,
+		types: make(map[string]orderedComplexType),
+		names: make(map[string]string),
+	}
+	for _, filename := range filenames {
+		if err := p.readTypes(filename); err != nil {
+			return err
+		}
+	}
+
+	children := make(map[string]map[string]int)
+	for name, e := range m.elementsByName {
+		if e.Abstract || m.kind(e) == "simple" {
+			continue
+		}
+		if _, ok := p.names[name]; ok {
+			continue
+		}
+		p.next = 0
+		positions := make(map[string]int)
+		p.appendPositions(e.Type, positions)
+		children[p.name23(name)] = positions
+	}
+
+	source := &strings.Builder{}
+	if err := profileTemplate.Execute(source, profileData{
+		Names:    p.names,
+		Children: children,
+	}); err != nil {
+		return err
+	}
+	return writeSource(source.String())
+}
+
+// readTypes reads the complex types in filename. If filename is a KML 2.3
+// XSD then the Google extensions with the same names as the elements that it
+// declares are recorded as replaced.
+func (p *profiler) readTypes(filename string) error {
+	f, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var x struct {
+		TargetNamespace string    `xml:"targetNamespace,attr"`
+		Nodes           []xsdNode `xml:",any"`
+	}
+	if err := xml.NewDecoder(f).Decode(&x); err != nil {
+		return err
+	}
+	prefix := namespacePrefixes[x.TargetNamespace]
+	for _, node := range x.Nodes {
+		switch node.XMLName.Local {
+		case "complexType":
+			p.types[prefix+node.Name] = orderedComplexType{prefix: prefix, node: node}
+		case "element":
+			if _, ok := p.m.elementsByName["gx:"+node.Name]; ok && x.TargetNamespace == namespace23 {
+				p.names["gx:"+node.Name] = node.Name
+			}
+		}
+	}
+	return nil
+}
+
+// name23 returns the KML 2.3 name of the element with qualified name name.
+func (p *profiler) name23(name string) string {
+	if name23, ok := p.names[name]; ok {
+		return name23
+	}
+	return xmlName(name)
+}
+
+// appendPositions adds the positions of the children of elements of type t
+// to positions, starting with the children of its base types.
+func (p *profiler) appendPositions(t string, positions map[string]int) {
+	ct, ok := p.types[t]
+	if !ok {
+		return
+	}
+	sequence := ct.node.child("sequence")
+	for _, contentName := range []string{"complexContent", "simpleContent"} {
+		if content := ct.node.child(contentName); content != nil {
+			if extension := content.child("extension"); extension != nil {
+				p.appendPositions(extension.Base, positions)
+				sequence = extension.child("sequence")
+			}
+		}
+	}
+	if sequence != nil {
+		p.appendParticles(ct.prefix, sequence, positions)
+	}
+}
+
+// appendParticles adds the positions of the elements in the sequence node to
+// positions. All alternatives of a choice share a position.
+func (p *profiler) appendParticles(prefix string, node *xsdNode, positions map[string]int) {
+	for i := range node.Children {
+		child := &node.Children[i]
+		switch child.XMLName.Local {
+		case "element":
+			p.addElement(prefix, child, positions)
+			p.next++
+		case "choice":
+			for j := range child.Children {
+				if child.Children[j].XMLName.Local == "element" {
+					p.addElement(prefix, &child.Children[j], positions)
+				}
+			}
+			p.next++
+		case "sequence":
+			p.appendParticles(prefix, child, positions)
+		}
+	}
+}
+
+// addElement adds the concrete elements that can appear where the element
+// node is to positions at the current position. References to elements in
+// other namespaces are added by name.
+func (p *profiler) addElement(prefix string, node *xsdNode, positions map[string]int) {
+	ref := node.Ref
+	if ref == "" {
+		ref = prefix + node.Name
+	}
+	resolved := make(map[string]bool)
+	if _, ok := p.m.elementsByName[ref]; ok {
+		p.m.resolve(ref, resolved)
+	} else {
+		resolved[ref] = true
+	}
+	names := make([]string, 0, len(resolved))
+	for name := range resolved {
+		names = append(names, p.name23(name))
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if _, ok := positions[name]; !ok {
+			positions[name] = p.next
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// A schemaElement is an element in the schema tables of package kml.
+type schemaElement struct {
+	XMLName  string
+	Compound bool
+	Children []string
+	Defaults []schemaDefault
+	EnumType string
+	List     bool
+}
+
+// A schemaDefault is the default value of a simple child element.
+type schemaDefault struct {
+	XMLName string
+	Value   string
+}
+
+// ignoredDefaults are simple elements whose defaults in the XSDs are not
+// used. The defaults of north and south, 180 and -180, are not valid
+// latitudes, and key is required in Pair.
+var ignoredDefaults = map[string]bool{
+	"kml:key":   true,
+	"kml:north": true,
+	"kml:south": true,
+}
+
+var schemaTemplate = template.Must(template.New("schema").Parse(`
+// Code generated by github.com/twpayne/go-kml/internal/generate. DO NOT EDIT.
+
+package kml
+
+// schemaElements maps the names of the concrete elements in the KML schema to
+// their descriptions.
+var schemaElements = map[string]schemaElement{
+{{- range . }}
+	{{ printf "%q" .XMLName }}: {
+{{- if .Compound }}
+		compound: true,
+		children: []string{
+{{- range .Children }}
+			{{ printf "%q" . }},
+{{- end }}
+		},
+{{- if .Defaults }}
+		defaults: map[string]string{
+{{- range .Defaults }}
+			{{ printf "%q" .XMLName }}: {{ printf "%q" .Value }},
+{{- end }}
+		},
+{{- end }}
+{{- end }}
+{{- if .EnumType }}
+		valid: func(s string) bool {
+{{- if .List }}
+			return validList(s, func(s string) bool { return {{ .EnumType }}(s).IsValid() })
+{{- else }}
+			return {{ .EnumType }}(s).IsValid()
+{{- end }}
+		},
+{{- end }}
+	},
+{{- end }}
+}
+`))
+
+// runSchema generates the schema tables of package kml from the XSDs in
+// filenames.
+func runSchema(filenames []string) error {
+	m, err := readModel(filenames)
+	if err != nil {
+		return err
+	}
+
+	var schemaElements []*schemaElement
+	for _, e := range m.elements {
+		if e.Abstract {
+			continue
+		}
+		name := e.prefix + e.Name
+		se := &schemaElement{
+			XMLName: xmlName(name),
+		}
+		if kind := m.kind(e); kind == "simple" {
+			if m.attributes[name] == nil {
+				argType, _ := goArgType(e.prefix, e.element)
+				if strings.HasSuffix(argType, "Enum") {
+					se.EnumType = strings.TrimPrefix(argType, "kml.")
+					se.List = e.Type == "kml:itemIconStateType"
+				}
+			}
+		} else {
+			se.Compound = true
+			se.Children = []string{}
+			for child := range m.childrenOf(e.Type) {
+				se.Children = append(se.Children, xmlName(child))
+				if c, ok := m.elementsByName[child]; ok && c.Default != "" && !ignoredDefaults[child] && m.kind(c) == "simple" {
+					se.Defaults = append(se.Defaults, schemaDefault{
+						XMLName: xmlName(child),
+						Value:   c.Default,
+					})
+				}
+			}
+			sort.Strings(se.Children)
+			sort.Slice(se.Defaults, func(i, j int) bool {
+				return se.Defaults[i].XMLName < se.Defaults[j].XMLName
+			})
+		}
+		schemaElements = append(schemaElements, se)
+	}
+	sort.Slice(schemaElements, func(i, j int) bool {
+		return schemaElements[i].XMLName < schemaElements[j].XMLName
+	})
+
+	source := &strings.Builder{}
+	if err := schemaTemplate.Execute(source, schemaElements); err != nil {
+		return err
+	}
+	return writeSource(source.String())
+}
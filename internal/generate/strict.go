@@ -0,0 +1,423 @@
+package main
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/Masterminds/sprig"
+)
+
+// namespacePrefixes maps target namespaces to prefixes.
+var namespacePrefixes = map[string]string{
+	"http://www.opengis.net/kml/2.2":    "kml:",
+	"http://www.google.com/kml/ext/2.2": "gx:",
+	namespace23:                         "kml:",
+}
+
+// handwrittenRegexp matches the names of elements whose constructors are not
+// generated.
+var handwrittenRegexp = regexp.MustCompile(`^(angles|coord|coordinates|kml|AbstractTourPrimitive|Snippet)$`)
+
+// handwrittenElements are the elements whose constructors are written by hand
+// in both package kml and package strict.
+var handwrittenElements = map[string]bool{
+	"kml:coordinates": true,
+	"kml:kml":         true,
+	"gx:angles":       true,
+	"gx:coord":        true,
+}
+
+// singleChildTypes are the types of elements whose constructors take a single
+// child.
+var singleChildTypes = map[string]bool{
+	"kml:BoundaryType": true,
+	"kml:KmlType":      true,
+	"kml:SnippetType":  true,
+}
+
+// A strictElement is an element in package strict.
+type strictElement struct {
+	GoName      string
+	XMLName     string
+	Handwritten bool
+	Kind        string
+	ArgType     string
+	KMLTime     bool
+	Object      bool
+	HasChildren bool
+	Parents     []string
+	Attributes  *attributeElement
+}
+
+type strictData struct {
+	Elements []*strictElement
+	Parents  []string
+}
+
+var strictTemplate = template.Must(template.New("strict").Funcs(sprig.HermeticTxtFuncMap()).Parse(`
+// Code generated by github.com/twpayne/go-kml/internal/generate. DO NOT EDIT.
+
+package strict
+
+import (
+	"image/color"
+	"time"
+
+	"github.com/twpayne/go-kml"
+)
+
+{{ range .Elements -}}
+{{ $element := . -}}
+{{ if .HasChildren -}}
+// A{{ if regexMatch "^[AEIOU]" .GoName }}n{{ end }} {{ .GoName }}Child is an element that can be a child of a{{ if regexMatch "^[aeiou]" .XMLName }}n{{ end }} {{ .XMLName }} element.
+type {{ .GoName }}Child interface {
+	Child
+	is{{ .GoName }}Child()
+}
+
+{{ if eq .Kind "compound" -}}
+// unwrap{{ .GoName }}Children returns the kml.Elements of children.
+func unwrap{{ .GoName }}Children(children []{{ .GoName }}Child) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+{{ end -}}
+{{ end -}}
+// A{{ if regexMatch "^[AEIOU]" .GoName }}n{{ end }} {{ .GoName }}Element is a{{ if regexMatch "^[aeiou]" .XMLName }}n{{ end }} {{ .XMLName }} element.
+type {{ .GoName }}Element struct {
+	element
+}
+
+{{ range .Parents -}}
+func ({{ $element.GoName }}Element) is{{ . }}Child() {}
+{{ end -}}
+{{ if not .Handwritten -}}
+{{ if .Attributes -}}
+{{ $attrs := .Attributes -}}
+{{ if eq .Kind "compound" }}
+// {{ .GoName }}{{ $attrs.Suffix }} returns a new {{ .XMLName }} element.
+func {{ .GoName }}{{ $attrs.Suffix }}({{ $attrs.Signature "kml." }}, children ...{{ .GoName }}Child) {{ .GoName }}Element {
+	return {{ .GoName }}Element{element{kml.{{ .GoName }}{{ $attrs.Suffix }}({{ $attrs.Args }}, unwrap{{ .GoName }}Children(children)...)}}
+}
+{{ if and .Object (not $attrs.ID) }}
+// Shared{{ .GoName }}{{ $attrs.Suffix }} returns a new shared {{ .XMLName }} element.
+func Shared{{ .GoName }}{{ $attrs.Suffix }}({{ $attrs.Signature "kml." "id" }}, children ...{{ .GoName }}Child) {{ .GoName }}Element {
+	return {{ .GoName }}Element{element{kml.Shared{{ .GoName }}{{ $attrs.Suffix }}(id, {{ $attrs.Args }}, unwrap{{ .GoName }}Children(children)...)}}
+}
+
+// Target{{ .GoName }}{{ $attrs.Suffix }} returns a new {{ .XMLName }} element with a targetId.
+func Target{{ .GoName }}{{ $attrs.Suffix }}({{ $attrs.Signature "kml." "targetID" }}, children ...{{ .GoName }}Child) {{ .GoName }}Element {
+	return {{ .GoName }}Element{element{kml.Target{{ .GoName }}{{ $attrs.Suffix }}(targetID, {{ $attrs.Args }}, unwrap{{ .GoName }}Children(children)...)}}
+}
+{{ end -}}
+{{ if $attrs.Suffix }}
+{{ template "compound" . -}}
+{{ end -}}
+{{ else }}
+// {{ .GoName }} returns a new {{ .XMLName }} element.
+func {{ .GoName }}({{ $attrs.Signature "kml." }}) {{ .GoName }}Element {
+	return {{ .GoName }}Element{element{kml.{{ .GoName }}({{ $attrs.Args }})}}
+}
+{{ end -}}
+{{ else if eq .Kind "compound" }}
+{{ template "compound" . -}}
+{{ else if eq .Kind "single" }}
+// {{ .GoName }} returns a new {{ .XMLName }} element.
+func {{ .GoName }}(child {{ .GoName }}Child) {{ .GoName }}Element {
+	return {{ .GoName }}Element{element{kml.{{ .GoName }}(child.kmlElement())}}
+}
+{{ else }}
+// {{ .GoName }} returns a new {{ .XMLName }} element.
+func {{ .GoName }}(value {{ .ArgType }}) {{ .GoName }}Element {
+	return {{ .GoName }}Element{element{kml.{{ .GoName }}(value)}}
+}
+{{ if .KMLTime }}
+// {{ .GoName }}KMLTime returns a new {{ .XMLName }} element with a value of
+// any precision.
+func {{ .GoName }}KMLTime(value kml.KMLTime) {{ .GoName }}Element {
+	return {{ .GoName }}Element{element{kml.{{ .GoName }}KMLTime(value)}}
+}
+{{ end -}}
+{{ end -}}
+{{ end }}
+{{ end -}}
+{{ range .Parents -}}
+func (RawElement) is{{ . }}Child() {}
+{{ end -}}
+{{ define "compound" -}}
+// {{ .GoName }} returns a new {{ .XMLName }} element.
+func {{ .GoName }}(children ...{{ .GoName }}Child) {{ .GoName }}Element {
+	return {{ .GoName }}Element{element{kml.{{ .GoName }}(unwrap{{ .GoName }}Children(children)...)}}
+}
+{{ if .Object }}
+// Shared{{ .GoName }} returns a new shared {{ .XMLName }} element.
+func Shared{{ .GoName }}(id string, children ...{{ .GoName }}Child) {{ .GoName }}Element {
+	return {{ .GoName }}Element{element{kml.Shared{{ .GoName }}(id, unwrap{{ .GoName }}Children(children)...)}}
+}
+
+// Target{{ .GoName }} returns a new {{ .XMLName }} element with a targetId.
+func Target{{ .GoName }}(targetID string, children ...{{ .GoName }}Child) {{ .GoName }}Element {
+	return {{ .GoName }}Element{element{kml.Target{{ .GoName }}(targetID, unwrap{{ .GoName }}Children(children)...)}}
+}
+{{ end -}}
+{{ end -}}
+`))
+
+// goName returns the Go name of the element name with prefix.
+func goName(prefix, name string) string {
+	if prefix == "kml:" && name == "Scale" {
+		return "ModelScale"
+	}
+	goName := strings.Title(name)
+	for _, r := range []struct{ old, new string }{
+		{"Fov", "FOV"},
+		{"Http", "HTTP"},
+		{"Kml", "KML"},
+		{"Lod", "LOD"},
+		{"Url", "URL"},
+	} {
+		goName = strings.ReplaceAll(goName, r.old, r.new)
+	}
+	if prefix == "gx:" {
+		goName = "Gx" + goName
+	}
+	return goName
+}
+
+// goArgType returns the Go type of the value of the simple element e with
+// prefix.
+func goArgType(prefix string, e element) (string, bool) {
+	switch {
+	case e.Type == "kml:itemIconStateType":
+		return "kml.ItemIconStateEnum", false
+	case strings.HasSuffix(e.Type, "EnumType"):
+		gxPrefix := ""
+		if prefix == "gx:" {
+			gxPrefix = "Gx"
+		}
+		t := strings.TrimSuffix(strings.TrimPrefix(strings.TrimPrefix(e.Type, "gx:"), "kml:"), "Type")
+		return "kml." + gxPrefix + strings.Title(t), false
+	case e.Type == "boolean":
+		return "bool", false
+	case e.Type == "double" || e.Type == "float" || e.Type == "gx:outerWidthType" || strings.HasPrefix(e.Type, "kml:angle"):
+		return "float64", false
+	case e.Type == "integer":
+		return "int", false
+	case e.Type == "anyURI":
+		return "string", false
+	case e.Type == "kml:colorType":
+		return "color.Color", false
+	case e.Type == "kml:dateTimeType":
+		return "time.Time", true
+	case e.Type == "kml:vec2Type":
+		return "kml.Vec2", false
+	default:
+		return e.Type, false
+	}
+}
+
+// appendRefs appends the qualified names of the elements referenced by ps to
+// refs.
+func appendRefs(refs []string, prefix string, ps particles) []string {
+	for _, e := range ps.Elements {
+		switch {
+		case e.Ref != "":
+			refs = append(refs, e.Ref)
+		case e.Name != "":
+			refs = append(refs, prefix+e.Name)
+		}
+	}
+	for _, choice := range ps.Choices {
+		refs = appendRefs(refs, prefix, choice)
+	}
+	for _, sequence := range ps.Sequences {
+		refs = appendRefs(refs, prefix, sequence)
+	}
+	return refs
+}
+
+// A qualifiedElement is an element with the prefix of its namespace.
+type qualifiedElement struct {
+	prefix string
+	element
+}
+
+// A qualifiedComplexType is a complex type with the prefix of its namespace.
+type qualifiedComplexType struct {
+	prefix string
+	complexType
+}
+
+// A model is the content model of a set of XSDs.
+type model struct {
+	elements       []qualifiedElement
+	elementsByName map[string]qualifiedElement
+	complexTypes   map[string]qualifiedComplexType
+	substitutes    map[string][]string
+	objects        map[string]bool
+	attributes     map[string]*attributeElement
+}
+
+// readModel reads the content model of the XSDs in filenames.
+func readModel(filenames []string) (*model, error) {
+	m := &model{
+		elementsByName: make(map[string]qualifiedElement),
+		complexTypes:   make(map[string]qualifiedComplexType),
+		substitutes:    make(map[string][]string),
+		objects:        make(map[string]bool),
+		attributes:     make(map[string]*attributeElement),
+	}
+	var xsds []*xsd
+	for _, filename := range filenames {
+		x, err := readXSD(filename)
+		if err != nil {
+			return nil, err
+		}
+		xsds = append(xsds, x)
+		prefix := namespacePrefixes[x.TargetNamespace]
+		for _, ct := range x.ComplexTypes {
+			m.complexTypes[prefix+ct.Name] = qualifiedComplexType{prefix: prefix, complexType: ct}
+		}
+		for name, ae := range attributeElements(x, prefix) {
+			m.attributes[prefix+name] = ae
+		}
+		for _, e := range x.Elements {
+			qe := qualifiedElement{prefix: prefix, element: e}
+			m.elements = append(m.elements, qe)
+			m.elementsByName[prefix+e.Name] = qe
+			if e.SubstitutionGroup != "" {
+				m.substitutes[e.SubstitutionGroup] = append(m.substitutes[e.SubstitutionGroup], prefix+e.Name)
+			}
+		}
+	}
+	for i, x := range xsds {
+		prefix := namespacePrefixes[x.TargetNamespace]
+		for name := range objectElements(x, prefix, xsds[:i]...) {
+			m.objects[prefix+name] = true
+		}
+	}
+	return m, nil
+}
+
+// resolve adds the names of the concrete elements that can appear where ref
+// is referenced to result.
+func (m *model) resolve(ref string, result map[string]bool) {
+	e, ok := m.elementsByName[ref]
+	if !ok {
+		return
+	}
+	if !e.Abstract {
+		result[ref] = true
+	}
+	for _, substitute := range m.substitutes[ref] {
+		m.resolve(substitute, result)
+	}
+}
+
+// childrenOf returns the names of the elements that can be children of
+// elements of type t.
+func (m *model) childrenOf(t string) map[string]bool {
+	children := make(map[string]bool)
+	for t != "" {
+		ct, ok := m.complexTypes[t]
+		if !ok {
+			break
+		}
+		var refs []string
+		refs = appendRefs(refs, ct.prefix, ct.Sequence)
+		refs = appendRefs(refs, ct.prefix, ct.ComplexContent.Extension.Sequence)
+		for _, ref := range refs {
+			m.resolve(ref, children)
+		}
+		t = ct.ComplexContent.Extension.Base
+	}
+	return children
+}
+
+// kind returns the kind of the element e with prefix: simple, single, or
+// compound.
+func (m *model) kind(e qualifiedElement) string {
+	switch ae := m.attributes[e.prefix+e.Name]; {
+	case ae != nil && ae.Kind != "compound":
+		return "simple"
+	case singleChildTypes[e.Type]:
+		return "single"
+	case e.Name == strings.Title(e.Name):
+		return "compound"
+	default:
+		return "simple"
+	}
+}
+
+// xmlName returns the XML name of the element with qualified name name.
+func xmlName(name string) string {
+	return strings.TrimPrefix(name, "kml:")
+}
+
+// runStrict generates package strict from the XSDs in filenames.
+func runStrict(filenames []string) error {
+	m, err := readModel(filenames)
+	if err != nil {
+		return err
+	}
+
+	strictElements := make(map[string]*strictElement)
+	var names []string
+	for _, e := range m.elements {
+		name := e.prefix + e.Name
+		handwritten := handwrittenRegexp.MatchString(e.Name)
+		if e.Abstract || (handwritten && !handwrittenElements[name]) {
+			continue
+		}
+		se := &strictElement{
+			GoName:      goName(e.prefix, e.Name),
+			XMLName:     xmlName(name),
+			Handwritten: handwritten,
+			Kind:        m.kind(e),
+			Object:      m.objects[name],
+			Attributes:  m.attributes[name],
+		}
+		if se.Kind == "simple" && se.Attributes == nil {
+			se.ArgType, se.KMLTime = goArgType(e.prefix, e.element)
+		}
+		strictElements[name] = se
+		names = append(names, name)
+	}
+
+	var parents []string
+	for _, name := range names {
+		se := strictElements[name]
+		if se.Kind == "simple" {
+			continue
+		}
+		se.HasChildren = true
+		parents = append(parents, se.GoName)
+		for child := range m.childrenOf(m.elementsByName[name].Type) {
+			if childElement, ok := strictElements[child]; ok {
+				childElement.Parents = append(childElement.Parents, se.GoName)
+			}
+		}
+	}
+	sort.Strings(parents)
+
+	data := strictData{
+		Parents: parents,
+	}
+	for _, name := range names {
+		se := strictElements[name]
+		sort.Strings(se.Parents)
+		data.Elements = append(data.Elements, se)
+	}
+
+	source := &strings.Builder{}
+	if err := strictTemplate.Execute(source, data); err != nil {
+		return err
+	}
+	return writeSource(source.String())
+}
@@ -1,5 +1,8 @@
-//go:generate go run ./internal/generate -f -o kml22gx.gen.go -n gx: xsd/kml22gx.xsd
+//go:generate go run ./internal/generate -f -o kml22gx.gen.go -n gx: -r xsd/ogckml22.xsd xsd/kml22gx.xsd
 //go:generate go run ./internal/generate -f -o ogckml22.gen.go xsd/ogckml22.xsd
+//go:generate go run ./internal/generate -p -f -o kml23.gen.go xsd/ogckml22.xsd xsd/kml22gx.xsd xsd/kml23.xsd
+//go:generate go run ./internal/generate -m -f -o schema.gen.go xsd/ogckml22.xsd xsd/kml22gx.xsd
+//go:generate go run ./internal/generate -s -f -o strict/strict.gen.go xsd/ogckml22.xsd xsd/kml22gx.xsd
 
 // Package kml provides convenience methods for creating and writing KML documents.
 //
@@ -19,6 +22,7 @@ package kml
 
 import (
 	"encoding/xml"
+	"errors"
 	"fmt"
 	"image/color"
 	"io"
@@ -26,6 +30,9 @@ import (
 	"time"
 )
 
+// ErrInvalidEnumValue is returned when parsing an invalid enumeration value.
+var ErrInvalidEnumValue = errors.New("kml: invalid enum value")
+
 // An Element represents an abstract KML element.
 type Element interface {
 	xml.Marshaler
@@ -37,6 +44,7 @@ type Element interface {
 type SimpleElement struct {
 	xml.StartElement
 	value string
+	cdata bool
 }
 
 // A CompoundElement is an Element with children.
@@ -53,9 +61,21 @@ type SharedElement struct {
 
 // MarshalXML marshals se to e. start is ignored.
 func (se *SimpleElement) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if se.cdata {
+		return e.EncodeElement(struct {
+			Value string `xml:",cdata"`
+		}{
+			Value: se.value,
+		}, se.StartElement)
+	}
 	return e.EncodeElement(xml.CharData(se.value), se.StartElement)
 }
 
+// Value returns se's value.
+func (se *SimpleElement) Value() string {
+	return se.value
+}
+
 // Write writes an XML header and se to w.
 func (se *SimpleElement) Write(w io.Writer) error {
 	return write(w, "", "", se)
@@ -72,6 +92,50 @@ func (ce *CompoundElement) Add(children ...Element) *CompoundElement {
 	return ce
 }
 
+// Children returns ce's children.
+func (ce *CompoundElement) Children() []Element {
+	return ce.children
+}
+
+// SetChildren replaces ce's children with children.
+func (ce *CompoundElement) SetChildren(children ...Element) *CompoundElement {
+	ce.children = children
+	return ce
+}
+
+// StartElementOf returns the start element of e. Coordinates elements have
+// the start element of a coordinates element. It returns false if e is not an
+// element of this package.
+func StartElementOf(e Element) (xml.StartElement, bool) {
+	switch e := e.(type) {
+	case *SimpleElement:
+		return e.StartElement, true
+	case *CompoundElement:
+		return e.StartElement, true
+	case *SharedElement:
+		return e.StartElement, true
+	case *CoordinatesElement, *CoordinatesArrayElement, *CoordinatesFlatElement:
+		return xml.StartElement{Name: xml.Name{Local: "coordinates"}}, true
+	default:
+		return xml.StartElement{}, false
+	}
+}
+
+// ChildrenOf returns e's children, or nil if e is not a compound element.
+func ChildrenOf(e Element) []Element {
+	if ce := compoundElement(e); ce != nil {
+		return ce.children
+	}
+	return nil
+}
+
+// AttrValue returns the value of e's attribute name, or the empty string if e
+// does not have it.
+func AttrValue(e Element, name string) string {
+	start, _ := StartElementOf(e)
+	return attrValue(start, name)
+}
+
 // MarshalXML marshals ce to e. start is ignored.
 func (ce *CompoundElement) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if err := e.EncodeToken(ce.StartElement); err != nil {
@@ -179,6 +243,14 @@ func newSEString(name, value string) *SimpleElement {
 	}
 }
 
+func newSECDATA(name, value string) *SimpleElement {
+	return &SimpleElement{
+		StartElement: xml.StartElement{Name: xml.Name{Local: name}},
+		value:        value,
+		cdata:        true,
+	}
+}
+
 func newSETime(name string, value time.Time) *SimpleElement {
 	return &SimpleElement{
 		StartElement: xml.StartElement{Name: xml.Name{Local: name}},
@@ -186,6 +258,32 @@ func newSETime(name string, value time.Time) *SimpleElement {
 	}
 }
 
+func newSEAttr(name string, attr []xml.Attr, value string) *SimpleElement {
+	return &SimpleElement{
+		StartElement: xml.StartElement{
+			Name: xml.Name{Local: name},
+			Attr: attr,
+		},
+		value: value,
+	}
+}
+
+func newCEAttr(name string, attr []xml.Attr, children []Element) *CompoundElement {
+	return &CompoundElement{
+		StartElement: xml.StartElement{
+			Name: xml.Name{Local: name},
+			Attr: attr,
+		},
+		children: children,
+	}
+}
+
+func newSharedEAttr(name, id string, attr []xml.Attr, children []Element) *SharedElement {
+	se := newSharedE(name, id, children)
+	se.Attr = append(se.Attr, attr...)
+	return se
+}
+
 func newCE(name string, children []Element) *CompoundElement {
 	return &CompoundElement{
 		StartElement: xml.StartElement{
@@ -211,3 +309,15 @@ func newSharedE(name, id string, children []Element) *SharedElement {
 		id: id,
 	}
 }
+
+func newTargetE(name, targetID string, children []Element) *CompoundElement {
+	return &CompoundElement{
+		StartElement: xml.StartElement{
+			Name: xml.Name{Local: name},
+			Attr: []xml.Attr{
+				{Name: xml.Name{Local: "targetId"}, Value: targetID},
+			},
+		},
+		children: children,
+	}
+}
@@ -3,7 +3,10 @@
 package kml
 
 import (
+	"encoding/xml"
+	"fmt"
 	"image/color"
+	"strconv"
 )
 
 // A GxAltitudeModeEnum is an altitudeModeEnumType.
@@ -18,6 +21,51 @@ const (
 	GxAltitudeModeRelativeToSeaFloor GxAltitudeModeEnum = "relativeToSeaFloor"
 )
 
+// GxAltitudeModeEnumValues returns all GxAltitudeModeEnums.
+func GxAltitudeModeEnumValues() []GxAltitudeModeEnum {
+	return []GxAltitudeModeEnum{
+		GxAltitudeModeClampToGround,
+		GxAltitudeModeRelativeToGround,
+		GxAltitudeModeAbsolute,
+		GxAltitudeModeClampToSeaFloor,
+		GxAltitudeModeRelativeToSeaFloor,
+	}
+}
+
+// ParseGxAltitudeModeEnum parses s as a GxAltitudeModeEnum.
+func ParseGxAltitudeModeEnum(s string) (GxAltitudeModeEnum, error) {
+	e := GxAltitudeModeEnum(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("%w: GxAltitudeModeEnum %q", ErrInvalidEnumValue, s)
+	}
+	return e, nil
+}
+
+// IsValid returns true if e is a valid GxAltitudeModeEnum.
+func (e GxAltitudeModeEnum) IsValid() bool {
+	for _, value := range GxAltitudeModeEnumValues() {
+		if e == value {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns e as a string.
+func (e GxAltitudeModeEnum) String() string {
+	return string(e)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (e *GxAltitudeModeEnum) UnmarshalText(text []byte) error {
+	value, err := ParseGxAltitudeModeEnum(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
 // A GxFlyToModeEnum is a flyToModeEnumType.
 type GxFlyToModeEnum string
 
@@ -27,6 +75,48 @@ const (
 	GxFlyToModeSmooth GxFlyToModeEnum = "smooth"
 )
 
+// GxFlyToModeEnumValues returns all GxFlyToModeEnums.
+func GxFlyToModeEnumValues() []GxFlyToModeEnum {
+	return []GxFlyToModeEnum{
+		GxFlyToModeBounce,
+		GxFlyToModeSmooth,
+	}
+}
+
+// ParseGxFlyToModeEnum parses s as a GxFlyToModeEnum.
+func ParseGxFlyToModeEnum(s string) (GxFlyToModeEnum, error) {
+	e := GxFlyToModeEnum(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("%w: GxFlyToModeEnum %q", ErrInvalidEnumValue, s)
+	}
+	return e, nil
+}
+
+// IsValid returns true if e is a valid GxFlyToModeEnum.
+func (e GxFlyToModeEnum) IsValid() bool {
+	for _, value := range GxFlyToModeEnumValues() {
+		if e == value {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns e as a string.
+func (e GxFlyToModeEnum) String() string {
+	return string(e)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (e *GxFlyToModeEnum) UnmarshalText(text []byte) error {
+	value, err := ParseGxFlyToModeEnum(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
 // A GxPlayModeEnum is a playModeEnumType.
 type GxPlayModeEnum string
 
@@ -35,6 +125,47 @@ const (
 	GxPlayModePause GxPlayModeEnum = "pause"
 )
 
+// GxPlayModeEnumValues returns all GxPlayModeEnums.
+func GxPlayModeEnumValues() []GxPlayModeEnum {
+	return []GxPlayModeEnum{
+		GxPlayModePause,
+	}
+}
+
+// ParseGxPlayModeEnum parses s as a GxPlayModeEnum.
+func ParseGxPlayModeEnum(s string) (GxPlayModeEnum, error) {
+	e := GxPlayModeEnum(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("%w: GxPlayModeEnum %q", ErrInvalidEnumValue, s)
+	}
+	return e, nil
+}
+
+// IsValid returns true if e is a valid GxPlayModeEnum.
+func (e GxPlayModeEnum) IsValid() bool {
+	for _, value := range GxPlayModeEnumValues() {
+		if e == value {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns e as a string.
+func (e GxPlayModeEnum) String() string {
+	return string(e)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (e *GxPlayModeEnum) UnmarshalText(text []byte) error {
+	value, err := ParseGxPlayModeEnum(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
 // GxAltitudeMode returns a new altitudeMode element.
 func GxAltitudeMode(value GxAltitudeModeEnum) *SimpleElement {
 	return newSEString("gx:altitudeMode", string(value))
@@ -140,67 +271,271 @@ func GxAnimatedUpdate(children ...Element) *CompoundElement {
 	return newCE("gx:AnimatedUpdate", children)
 }
 
+// SharedGxAnimatedUpdate returns a new shared AnimatedUpdate element.
+func SharedGxAnimatedUpdate(id string, children ...Element) *SharedElement {
+	return newSharedE("gx:AnimatedUpdate", id, children)
+}
+
+// TargetGxAnimatedUpdate returns a new AnimatedUpdate element with a targetId.
+func TargetGxAnimatedUpdate(targetID string, children ...Element) *CompoundElement {
+	return newTargetE("gx:AnimatedUpdate", targetID, children)
+}
+
 // GxFlyTo returns a new FlyTo element.
 func GxFlyTo(children ...Element) *CompoundElement {
 	return newCE("gx:FlyTo", children)
 }
 
+// SharedGxFlyTo returns a new shared FlyTo element.
+func SharedGxFlyTo(id string, children ...Element) *SharedElement {
+	return newSharedE("gx:FlyTo", id, children)
+}
+
+// TargetGxFlyTo returns a new FlyTo element with a targetId.
+func TargetGxFlyTo(targetID string, children ...Element) *CompoundElement {
+	return newTargetE("gx:FlyTo", targetID, children)
+}
+
 // GxPlaylist returns a new Playlist element.
 func GxPlaylist(children ...Element) *CompoundElement {
 	return newCE("gx:Playlist", children)
 }
 
+// SharedGxPlaylist returns a new shared Playlist element.
+func SharedGxPlaylist(id string, children ...Element) *SharedElement {
+	return newSharedE("gx:Playlist", id, children)
+}
+
+// TargetGxPlaylist returns a new Playlist element with a targetId.
+func TargetGxPlaylist(targetID string, children ...Element) *CompoundElement {
+	return newTargetE("gx:Playlist", targetID, children)
+}
+
 // GxSoundCue returns a new SoundCue element.
 func GxSoundCue(children ...Element) *CompoundElement {
 	return newCE("gx:SoundCue", children)
 }
 
+// SharedGxSoundCue returns a new shared SoundCue element.
+func SharedGxSoundCue(id string, children ...Element) *SharedElement {
+	return newSharedE("gx:SoundCue", id, children)
+}
+
+// TargetGxSoundCue returns a new SoundCue element with a targetId.
+func TargetGxSoundCue(targetID string, children ...Element) *CompoundElement {
+	return newTargetE("gx:SoundCue", targetID, children)
+}
+
 // GxTour returns a new Tour element.
 func GxTour(children ...Element) *CompoundElement {
 	return newCE("gx:Tour", children)
 }
 
+// SharedGxTour returns a new shared Tour element.
+func SharedGxTour(id string, children ...Element) *SharedElement {
+	return newSharedE("gx:Tour", id, children)
+}
+
+// TargetGxTour returns a new Tour element with a targetId.
+func TargetGxTour(targetID string, children ...Element) *CompoundElement {
+	return newTargetE("gx:Tour", targetID, children)
+}
+
 // GxTimeStamp returns a new TimeStamp element.
 func GxTimeStamp(children ...Element) *CompoundElement {
 	return newCE("gx:TimeStamp", children)
 }
 
+// SharedGxTimeStamp returns a new shared TimeStamp element.
+func SharedGxTimeStamp(id string, children ...Element) *SharedElement {
+	return newSharedE("gx:TimeStamp", id, children)
+}
+
+// TargetGxTimeStamp returns a new TimeStamp element with a targetId.
+func TargetGxTimeStamp(targetID string, children ...Element) *CompoundElement {
+	return newTargetE("gx:TimeStamp", targetID, children)
+}
+
 // GxTimeSpan returns a new TimeSpan element.
 func GxTimeSpan(children ...Element) *CompoundElement {
 	return newCE("gx:TimeSpan", children)
 }
 
+// SharedGxTimeSpan returns a new shared TimeSpan element.
+func SharedGxTimeSpan(id string, children ...Element) *SharedElement {
+	return newSharedE("gx:TimeSpan", id, children)
+}
+
+// TargetGxTimeSpan returns a new TimeSpan element with a targetId.
+func TargetGxTimeSpan(targetID string, children ...Element) *CompoundElement {
+	return newTargetE("gx:TimeSpan", targetID, children)
+}
+
 // GxTourControl returns a new TourControl element.
 func GxTourControl(children ...Element) *CompoundElement {
 	return newCE("gx:TourControl", children)
 }
 
+// SharedGxTourControl returns a new shared TourControl element.
+func SharedGxTourControl(id string, children ...Element) *SharedElement {
+	return newSharedE("gx:TourControl", id, children)
+}
+
+// TargetGxTourControl returns a new TourControl element with a targetId.
+func TargetGxTourControl(targetID string, children ...Element) *CompoundElement {
+	return newTargetE("gx:TourControl", targetID, children)
+}
+
 // GxWait returns a new Wait element.
 func GxWait(children ...Element) *CompoundElement {
 	return newCE("gx:Wait", children)
 }
 
+// SharedGxWait returns a new shared Wait element.
+func SharedGxWait(id string, children ...Element) *SharedElement {
+	return newSharedE("gx:Wait", id, children)
+}
+
+// TargetGxWait returns a new Wait element with a targetId.
+func TargetGxWait(targetID string, children ...Element) *CompoundElement {
+	return newTargetE("gx:Wait", targetID, children)
+}
+
 // GxLatLonQuad returns a new LatLonQuad element.
 func GxLatLonQuad(children ...Element) *CompoundElement {
 	return newCE("gx:LatLonQuad", children)
 }
 
+// SharedGxLatLonQuad returns a new shared LatLonQuad element.
+func SharedGxLatLonQuad(id string, children ...Element) *SharedElement {
+	return newSharedE("gx:LatLonQuad", id, children)
+}
+
+// TargetGxLatLonQuad returns a new LatLonQuad element with a targetId.
+func TargetGxLatLonQuad(targetID string, children ...Element) *CompoundElement {
+	return newTargetE("gx:LatLonQuad", targetID, children)
+}
+
 // GxTrack returns a new Track element.
 func GxTrack(children ...Element) *CompoundElement {
 	return newCE("gx:Track", children)
 }
 
+// SharedGxTrack returns a new shared Track element.
+func SharedGxTrack(id string, children ...Element) *SharedElement {
+	return newSharedE("gx:Track", id, children)
+}
+
+// TargetGxTrack returns a new Track element with a targetId.
+func TargetGxTrack(targetID string, children ...Element) *CompoundElement {
+	return newTargetE("gx:Track", targetID, children)
+}
+
 // GxMultiTrack returns a new MultiTrack element.
 func GxMultiTrack(children ...Element) *CompoundElement {
 	return newCE("gx:MultiTrack", children)
 }
 
+// SharedGxMultiTrack returns a new shared MultiTrack element.
+func SharedGxMultiTrack(id string, children ...Element) *SharedElement {
+	return newSharedE("gx:MultiTrack", id, children)
+}
+
+// TargetGxMultiTrack returns a new MultiTrack element with a targetId.
+func TargetGxMultiTrack(targetID string, children ...Element) *CompoundElement {
+	return newTargetE("gx:MultiTrack", targetID, children)
+}
+
+// GxSimpleArrayField returns a new SimpleArrayField element.
+func GxSimpleArrayField(name, _type string, children ...Element) *CompoundElement {
+	return newCEAttr("gx:SimpleArrayField", []xml.Attr{
+		{Name: xml.Name{Local: "name"}, Value: name},
+		{Name: xml.Name{Local: "type"}, Value: _type},
+	}, children)
+}
+
+// GxSimpleArrayDataWithName returns a new SimpleArrayData element.
+func GxSimpleArrayDataWithName(name string, children ...Element) *CompoundElement {
+	return newCEAttr("gx:SimpleArrayData", []xml.Attr{
+		{Name: xml.Name{Local: "name"}, Value: name},
+	}, children)
+}
+
+// SharedGxSimpleArrayDataWithName returns a new shared SimpleArrayData element.
+func SharedGxSimpleArrayDataWithName(id, name string, children ...Element) *SharedElement {
+	return newSharedEAttr("gx:SimpleArrayData", id, []xml.Attr{
+		{Name: xml.Name{Local: "name"}, Value: name},
+	}, children)
+}
+
+// TargetGxSimpleArrayDataWithName returns a new SimpleArrayData element with a targetId.
+func TargetGxSimpleArrayDataWithName(targetID, name string, children ...Element) *CompoundElement {
+	return newCEAttr("gx:SimpleArrayData", []xml.Attr{
+		{Name: xml.Name{Local: "targetId"}, Value: targetID},
+		{Name: xml.Name{Local: "name"}, Value: name},
+	}, children)
+}
+
 // GxSimpleArrayData returns a new SimpleArrayData element.
 func GxSimpleArrayData(children ...Element) *CompoundElement {
 	return newCE("gx:SimpleArrayData", children)
 }
 
+// SharedGxSimpleArrayData returns a new shared SimpleArrayData element.
+func SharedGxSimpleArrayData(id string, children ...Element) *SharedElement {
+	return newSharedE("gx:SimpleArrayData", id, children)
+}
+
+// TargetGxSimpleArrayData returns a new SimpleArrayData element with a targetId.
+func TargetGxSimpleArrayData(targetID string, children ...Element) *CompoundElement {
+	return newTargetE("gx:SimpleArrayData", targetID, children)
+}
+
 // GxViewerOptions returns a new ViewerOptions element.
 func GxViewerOptions(children ...Element) *CompoundElement {
 	return newCE("gx:ViewerOptions", children)
 }
+
+// SharedGxViewerOptions returns a new shared ViewerOptions element.
+func SharedGxViewerOptions(id string, children ...Element) *SharedElement {
+	return newSharedE("gx:ViewerOptions", id, children)
+}
+
+// TargetGxViewerOptions returns a new ViewerOptions element with a targetId.
+func TargetGxViewerOptions(targetID string, children ...Element) *CompoundElement {
+	return newTargetE("gx:ViewerOptions", targetID, children)
+}
+
+// GxOption returns a new option element.
+func GxOption(name GxOptionName, enabled bool) *SimpleElement {
+	return newSEAttr("gx:option", []xml.Attr{
+		{Name: xml.Name{Local: "name"}, Value: string(name)},
+		{Name: xml.Name{Local: "enabled"}, Value: strconv.FormatBool(enabled)},
+	}, "")
+}
+
+// gxSimpleElementTypes maps simple element names to their types.
+var gxSimpleElementTypes = map[string]simpleElementType{
+	"gx:altitudeMode":      {kind: simpleKindString},
+	"gx:altitudeOffset":    {kind: simpleKindFloat},
+	"gx:angles":            {kind: simpleKindString},
+	"gx:balloonVisibility": {kind: simpleKindBool},
+	"gx:coord":             {kind: simpleKindString},
+	"gx:delayedStart":      {kind: simpleKindFloat},
+	"gx:drawOrder":         {kind: simpleKindInt},
+	"gx:duration":          {kind: simpleKindFloat},
+	"gx:flyToMode":         {kind: simpleKindString},
+	"gx:horizFov":          {kind: simpleKindFloat},
+	"gx:interpolate":       {kind: simpleKindBool},
+	"gx:labelVisibility":   {kind: simpleKindBool},
+	"gx:outerColor":        {kind: simpleKindColor},
+	"gx:outerWidth":        {kind: simpleKindFloat},
+	"gx:physicalWidth":     {kind: simpleKindFloat},
+	"gx:playMode":          {kind: simpleKindString},
+	"gx:rank":              {kind: simpleKindFloat},
+	"gx:value":             {kind: simpleKindString},
+	"gx:x":                 {kind: simpleKindInt},
+	"gx:y":                 {kind: simpleKindInt},
+	"gx:w":                 {kind: simpleKindInt},
+	"gx:h":                 {kind: simpleKindInt},
+}
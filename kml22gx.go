@@ -2,12 +2,21 @@ package kml
 
 import (
 	"encoding/xml"
+	"errors"
 	"strconv"
 )
 
 // GxNamespace is the default namespace for Google Earth extensions.
 const GxNamespace = "http://www.google.com/kml/ext/2.2"
 
+// ErrNotCounterClockwise is returned when coordinates that must be in
+// counter-clockwise order are not.
+var ErrNotCounterClockwise = errors.New("kml: coordinates are not in counter-clockwise order")
+
+// ErrSelfIntersecting is returned when coordinates that must form a simple
+// polygon intersect themselves.
+var ErrSelfIntersecting = errors.New("kml: coordinates are self-intersecting")
+
 // A GxOptionName is a gx:option name.
 type GxOptionName string
 
@@ -55,28 +64,46 @@ func GxKML(child Element) *CompoundElement {
 	return kml
 }
 
-// GxOption returns a new gx:option element.
-func GxOption(name GxOptionName, enabled bool) *SimpleElement {
-	return &SimpleElement{
-		StartElement: xml.StartElement{
-			Name: xml.Name{Local: "gx:option"},
-			Attr: []xml.Attr{
-				{Name: xml.Name{Local: "name"}, Value: string(name)},
-				{Name: xml.Name{Local: "enabled"}, Value: strconv.FormatBool(enabled)},
-			},
-		},
+// GxLatLonQuadCorners returns a new gx:LatLonQuad element with corners ll,
+// lr, ur, and ul, which must be in counter-clockwise order and must not
+// intersect themselves.
+func GxLatLonQuadCorners(ll, lr, ur, ul Coordinate) (*CompoundElement, error) {
+	corners := []Coordinate{ll, lr, ur, ul}
+	if signedArea(corners) <= 0 {
+		return nil, ErrNotCounterClockwise
+	}
+	if segmentsIntersect(ll, lr, ur, ul) || segmentsIntersect(lr, ur, ul, ll) {
+		return nil, ErrSelfIntersecting
 	}
+	return GxLatLonQuad(Coordinates(corners...)), nil
 }
 
-// GxSimpleArrayField returns a new gx:SimpleArrayField element.
-func GxSimpleArrayField(name, _type string) *CompoundElement {
-	return &CompoundElement{
-		StartElement: xml.StartElement{
-			Name: xml.Name{Local: "gx:SimpleArrayField"},
-			Attr: []xml.Attr{
-				{Name: xml.Name{Local: "name"}, Value: name},
-				{Name: xml.Name{Local: "type"}, Value: _type},
-			},
-		},
+// GxLatLonQuadGeoTransform returns a new gx:LatLonQuad element for an image
+// of width by height pixels georeferenced by gt.
+func GxLatLonQuadGeoTransform(gt GeoTransform, width, height int) (*CompoundElement, error) {
+	w, h := float64(width), float64(height)
+	return GxLatLonQuadCorners(gt.Apply(0, h), gt.Apply(w, h), gt.Apply(w, 0), gt.Apply(0, 0))
+}
+
+// signedArea returns twice the signed area of the polygon with vertices cs,
+// which is positive if cs are in counter-clockwise order.
+func signedArea(cs []Coordinate) float64 {
+	area := 0.0
+	for i, c := range cs {
+		next := cs[(i+1)%len(cs)]
+		area += c.Lon*next.Lat - next.Lon*c.Lat
 	}
+	return area
+}
+
+// segmentsIntersect returns true if the segment from a to b intersects or
+// touches the segment from c to d.
+func segmentsIntersect(a, b, c, d Coordinate) bool {
+	return cross(c, d, a)*cross(c, d, b) <= 0 && cross(a, b, c)*cross(a, b, d) <= 0
+}
+
+// cross returns the z component of the cross product of b-a and c-a, which
+// is positive if a, b, and c are in counter-clockwise order.
+func cross(a, b, c Coordinate) float64 {
+	return (b.Lon-a.Lon)*(c.Lat-a.Lat) - (b.Lat-a.Lat)*(c.Lon-a.Lon)
 }
@@ -0,0 +1,737 @@
+// Code generated by github.com/twpayne/go-kml/internal/generate. DO NOT EDIT.
+
+package kml
+
+// kml23Names maps Google extensions to the KML 2.3 elements that replace
+// them.
+var kml23Names = map[string]string{
+	"gx:AnimatedUpdate":    "AnimatedUpdate",
+	"gx:FlyTo":             "FlyTo",
+	"gx:LatLonQuad":        "LatLonQuad",
+	"gx:MultiTrack":        "MultiTrack",
+	"gx:Playlist":          "Playlist",
+	"gx:SimpleArrayData":   "SimpleArrayData",
+	"gx:SimpleArrayField":  "SimpleArrayField",
+	"gx:SoundCue":          "SoundCue",
+	"gx:TimeSpan":          "TimeSpan",
+	"gx:TimeStamp":         "TimeStamp",
+	"gx:Tour":              "Tour",
+	"gx:TourControl":       "TourControl",
+	"gx:Track":             "Track",
+	"gx:Wait":              "Wait",
+	"gx:altitudeMode":      "altitudeMode",
+	"gx:altitudeOffset":    "altitudeOffset",
+	"gx:angles":            "angles",
+	"gx:balloonVisibility": "balloonVisibility",
+	"gx:coord":             "coord",
+	"gx:delayedStart":      "delayedStart",
+	"gx:duration":          "duration",
+	"gx:flyToMode":         "flyToMode",
+	"gx:horizFov":          "horizFov",
+	"gx:interpolate":       "interpolate",
+	"gx:playMode":          "playMode",
+	"gx:value":             "value",
+}
+
+// kml23Children maps the names of the compound elements in KML 2.3 to the
+// positions of their children in the schema. Children that can appear at the
+// same position, for example the members of a substitution group, share a
+// position.
+var kml23Children = map[string]map[string]int{
+	"Alias": {
+		"sourceHref": 2,
+		"targetHref": 1,
+	},
+	"AnimatedUpdate": {
+		"Update":       2,
+		"delayedStart": 3,
+		"duration":     1,
+	},
+	"BalloonStyle": {
+		"bgColor":     3,
+		"color":       3,
+		"displayMode": 6,
+		"text":        5,
+		"textColor":   4,
+	},
+	"Camera": {
+		"TimeSpan":         2,
+		"TimeStamp":        2,
+		"altitude":         5,
+		"altitudeMode":     9,
+		"gx:ViewerOptions": 2,
+		"heading":          6,
+		"horizFov":         1,
+		"latitude":         4,
+		"longitude":        3,
+		"roll":             8,
+		"tilt":             7,
+	},
+	"Change": {
+		"Alias":            0,
+		"AnimatedUpdate":   0,
+		"BalloonStyle":     0,
+		"Camera":           0,
+		"Data":             0,
+		"Document":         0,
+		"FlyTo":            0,
+		"Folder":           0,
+		"GroundOverlay":    0,
+		"Icon":             0,
+		"IconStyle":        0,
+		"ImagePyramid":     0,
+		"ItemIcon":         0,
+		"LabelStyle":       0,
+		"LatLonAltBox":     0,
+		"LatLonBox":        0,
+		"LatLonQuad":       0,
+		"LineString":       0,
+		"LineStyle":        0,
+		"LinearRing":       0,
+		"Link":             0,
+		"ListStyle":        0,
+		"Location":         0,
+		"Lod":              0,
+		"LookAt":           0,
+		"Model":            0,
+		"MultiGeometry":    0,
+		"MultiTrack":       0,
+		"NetworkLink":      0,
+		"Orientation":      0,
+		"Pair":             0,
+		"PhotoOverlay":     0,
+		"Placemark":        0,
+		"Playlist":         0,
+		"Point":            0,
+		"PolyStyle":        0,
+		"Polygon":          0,
+		"Region":           0,
+		"ResourceMap":      0,
+		"Scale":            0,
+		"SchemaData":       0,
+		"ScreenOverlay":    0,
+		"SoundCue":         0,
+		"Style":            0,
+		"StyleMap":         0,
+		"TimeSpan":         0,
+		"TimeStamp":        0,
+		"Tour":             0,
+		"TourControl":      0,
+		"Track":            0,
+		"Url":              0,
+		"ViewVolume":       0,
+		"Wait":             0,
+		"gx:ViewerOptions": 0,
+	},
+	"Create": {
+		"Document": 0,
+		"Folder":   0,
+	},
+	"Data": {
+		"displayName": 1,
+		"value":       2,
+	},
+	"Delete": {
+		"Document":      0,
+		"Folder":        0,
+		"GroundOverlay": 0,
+		"NetworkLink":   0,
+		"PhotoOverlay":  0,
+		"Placemark":     0,
+		"ScreenOverlay": 0,
+		"Tour":          0,
+	},
+	"Document": {
+		"Camera":             11,
+		"Document":           22,
+		"ExtendedData":       16,
+		"Folder":             22,
+		"GroundOverlay":      22,
+		"LookAt":             11,
+		"Metadata":           16,
+		"NetworkLink":        22,
+		"PhotoOverlay":       22,
+		"Placemark":          22,
+		"Region":             15,
+		"Schema":             21,
+		"ScreenOverlay":      22,
+		"Snippet":            9,
+		"Style":              14,
+		"StyleMap":           14,
+		"TimeSpan":           12,
+		"TimeStamp":          12,
+		"Tour":               22,
+		"address":            6,
+		"atom:author":        4,
+		"atom:link":          5,
+		"balloonVisibility":  17,
+		"description":        10,
+		"gx:rank":            17,
+		"name":               1,
+		"open":               3,
+		"phoneNumber":        8,
+		"snippet":            9,
+		"styleUrl":           13,
+		"visibility":         2,
+		"xal:AddressDetails": 7,
+	},
+	"ExtendedData": {
+		"Data":       0,
+		"SchemaData": 1,
+	},
+	"FlyTo": {
+		"Camera":    3,
+		"LookAt":    3,
+		"duration":  1,
+		"flyToMode": 2,
+	},
+	"Folder": {
+		"Camera":             11,
+		"Document":           21,
+		"ExtendedData":       16,
+		"Folder":             21,
+		"GroundOverlay":      21,
+		"LookAt":             11,
+		"Metadata":           16,
+		"NetworkLink":        21,
+		"PhotoOverlay":       21,
+		"Placemark":          21,
+		"Region":             15,
+		"ScreenOverlay":      21,
+		"Snippet":            9,
+		"Style":              14,
+		"StyleMap":           14,
+		"TimeSpan":           12,
+		"TimeStamp":          12,
+		"Tour":               21,
+		"address":            6,
+		"atom:author":        4,
+		"atom:link":          5,
+		"balloonVisibility":  17,
+		"description":        10,
+		"gx:rank":            17,
+		"name":               1,
+		"open":               3,
+		"phoneNumber":        8,
+		"snippet":            9,
+		"styleUrl":           13,
+		"visibility":         2,
+		"xal:AddressDetails": 7,
+	},
+	"GroundOverlay": {
+		"Camera":             11,
+		"ExtendedData":       16,
+		"Icon":               21,
+		"LatLonBox":          26,
+		"LatLonQuad":         28,
+		"LookAt":             11,
+		"Metadata":           16,
+		"Region":             15,
+		"Snippet":            9,
+		"Style":              14,
+		"StyleMap":           14,
+		"TimeSpan":           12,
+		"TimeStamp":          12,
+		"address":            6,
+		"altitude":           24,
+		"altitudeMode":       25,
+		"atom:author":        4,
+		"atom:link":          5,
+		"balloonVisibility":  17,
+		"color":              19,
+		"description":        10,
+		"drawOrder":          20,
+		"gx:rank":            17,
+		"name":               1,
+		"open":               3,
+		"phoneNumber":        8,
+		"snippet":            9,
+		"styleUrl":           13,
+		"visibility":         2,
+		"xal:AddressDetails": 7,
+	},
+	"Icon": {
+		"gx:h":            2,
+		"gx:w":            2,
+		"gx:x":            2,
+		"gx:y":            2,
+		"href":            1,
+		"httpQuery":       10,
+		"refreshInterval": 5,
+		"refreshMode":     4,
+		"viewBoundScale":  8,
+		"viewFormat":      9,
+		"viewRefreshMode": 6,
+		"viewRefreshTime": 7,
+	},
+	"IconStyle": {
+		"Icon":               9,
+		"color":              3,
+		"colorMode":          4,
+		"gx:labelVisibility": 5,
+		"heading":            8,
+		"hotSpot":            10,
+		"scale":              7,
+	},
+	"ImagePyramid": {
+		"gridOrigin": 4,
+		"maxHeight":  3,
+		"maxWidth":   2,
+		"tileSize":   1,
+	},
+	"ItemIcon": {
+		"href":  2,
+		"state": 1,
+	},
+	"LabelStyle": {
+		"color":              3,
+		"colorMode":          4,
+		"gx:labelVisibility": 5,
+		"scale":              7,
+	},
+	"LatLonAltBox": {
+		"altitudeMode": 9,
+		"east":         3,
+		"maxAltitude":  8,
+		"minAltitude":  7,
+		"north":        1,
+		"south":        2,
+		"west":         4,
+	},
+	"LatLonBox": {
+		"east":     3,
+		"north":    1,
+		"rotation": 7,
+		"south":    2,
+		"west":     4,
+	},
+	"LatLonQuad": {
+		"coordinates": 1,
+	},
+	"LineString": {
+		"altitudeMode":   5,
+		"altitudeOffset": 1,
+		"coordinates":    6,
+		"extrude":        3,
+		"gx:drawOrder":   1,
+		"tessellate":     4,
+	},
+	"LineStyle": {
+		"color":              3,
+		"colorMode":          4,
+		"gx:labelVisibility": 5,
+		"gx:outerColor":      8,
+		"gx:outerWidth":      8,
+		"gx:physicalWidth":   8,
+		"width":              7,
+	},
+	"LinearRing": {
+		"altitudeMode":   5,
+		"altitudeOffset": 1,
+		"coordinates":    6,
+		"extrude":        3,
+		"gx:drawOrder":   1,
+		"tessellate":     4,
+	},
+	"Link": {
+		"gx:h":            2,
+		"gx:w":            2,
+		"gx:x":            2,
+		"gx:y":            2,
+		"href":            1,
+		"httpQuery":       10,
+		"refreshInterval": 5,
+		"refreshMode":     4,
+		"viewBoundScale":  8,
+		"viewFormat":      9,
+		"viewRefreshMode": 6,
+		"viewRefreshTime": 7,
+	},
+	"ListStyle": {
+		"ItemIcon":        5,
+		"bgColor":         4,
+		"listItemType":    3,
+		"maxSnippetLines": 6,
+	},
+	"Location": {
+		"altitude":  3,
+		"latitude":  2,
+		"longitude": 1,
+	},
+	"Lod": {
+		"Scale":         5,
+		"maxFadeExtent": 4,
+		"maxLodPixels":  2,
+		"minFadeExtent": 3,
+		"minLodPixels":  1,
+	},
+	"LookAt": {
+		"TimeSpan":         2,
+		"TimeStamp":        2,
+		"altitude":         5,
+		"altitudeMode":     9,
+		"gx:ViewerOptions": 2,
+		"heading":          6,
+		"horizFov":         1,
+		"latitude":         4,
+		"longitude":        3,
+		"range":            8,
+		"tilt":             7,
+	},
+	"Metadata": {},
+	"Model": {
+		"Link":           7,
+		"Location":       4,
+		"Orientation":    5,
+		"ResourceMap":    8,
+		"Scale":          6,
+		"altitudeMode":   3,
+		"altitudeOffset": 1,
+		"gx:drawOrder":   1,
+	},
+	"MultiGeometry": {
+		"LineString":     3,
+		"LinearRing":     3,
+		"Model":          3,
+		"MultiGeometry":  3,
+		"MultiTrack":     3,
+		"Point":          3,
+		"Polygon":        3,
+		"Track":          3,
+		"altitudeOffset": 1,
+		"gx:drawOrder":   1,
+	},
+	"MultiTrack": {
+		"Track":          5,
+		"altitudeMode":   3,
+		"altitudeOffset": 1,
+		"gx:drawOrder":   1,
+		"interpolate":    4,
+	},
+	"NetworkLink": {
+		"Camera":             11,
+		"ExtendedData":       16,
+		"Link":               21,
+		"LookAt":             11,
+		"Metadata":           16,
+		"Region":             15,
+		"Snippet":            9,
+		"Style":              14,
+		"StyleMap":           14,
+		"TimeSpan":           12,
+		"TimeStamp":          12,
+		"Url":                21,
+		"address":            6,
+		"atom:author":        4,
+		"atom:link":          5,
+		"balloonVisibility":  17,
+		"description":        10,
+		"flyToView":          20,
+		"gx:rank":            17,
+		"name":               1,
+		"open":               3,
+		"phoneNumber":        8,
+		"refreshVisibility":  19,
+		"snippet":            9,
+		"styleUrl":           13,
+		"visibility":         2,
+		"xal:AddressDetails": 7,
+	},
+	"NetworkLinkControl": {
+		"Camera":           9,
+		"LookAt":           9,
+		"Update":           8,
+		"cookie":           2,
+		"expires":          7,
+		"linkDescription":  5,
+		"linkName":         4,
+		"linkSnippet":      6,
+		"maxSessionLength": 1,
+		"message":          3,
+		"minRefreshPeriod": 0,
+	},
+	"Orientation": {
+		"heading": 1,
+		"roll":    3,
+		"tilt":    2,
+	},
+	"Pair": {
+		"Style":    3,
+		"StyleMap": 3,
+		"key":      1,
+		"styleUrl": 2,
+	},
+	"PhotoOverlay": {
+		"Camera":             11,
+		"ExtendedData":       16,
+		"Icon":               21,
+		"ImagePyramid":       26,
+		"LookAt":             11,
+		"Metadata":           16,
+		"Point":              27,
+		"Region":             15,
+		"Snippet":            9,
+		"Style":              14,
+		"StyleMap":           14,
+		"TimeSpan":           12,
+		"TimeStamp":          12,
+		"ViewVolume":         25,
+		"address":            6,
+		"atom:author":        4,
+		"atom:link":          5,
+		"balloonVisibility":  17,
+		"color":              19,
+		"description":        10,
+		"drawOrder":          20,
+		"gx:rank":            17,
+		"name":               1,
+		"open":               3,
+		"phoneNumber":        8,
+		"rotation":           24,
+		"shape":              28,
+		"snippet":            9,
+		"styleUrl":           13,
+		"visibility":         2,
+		"xal:AddressDetails": 7,
+	},
+	"Placemark": {
+		"Camera":             11,
+		"ExtendedData":       16,
+		"LineString":         19,
+		"LinearRing":         19,
+		"LookAt":             11,
+		"Metadata":           16,
+		"Model":              19,
+		"MultiGeometry":      19,
+		"MultiTrack":         19,
+		"Point":              19,
+		"Polygon":            19,
+		"Region":             15,
+		"Snippet":            9,
+		"Style":              14,
+		"StyleMap":           14,
+		"TimeSpan":           12,
+		"TimeStamp":          12,
+		"Track":              19,
+		"address":            6,
+		"atom:author":        4,
+		"atom:link":          5,
+		"balloonVisibility":  17,
+		"description":        10,
+		"gx:rank":            17,
+		"name":               1,
+		"open":               3,
+		"phoneNumber":        8,
+		"snippet":            9,
+		"styleUrl":           13,
+		"visibility":         2,
+		"xal:AddressDetails": 7,
+	},
+	"Playlist": {
+		"AnimatedUpdate": 1,
+		"FlyTo":          1,
+		"SoundCue":       1,
+		"TourControl":    1,
+		"Wait":           1,
+	},
+	"Point": {
+		"Orientation":    6,
+		"altitudeMode":   4,
+		"altitudeOffset": 1,
+		"coordinates":    5,
+		"extrude":        3,
+		"gx:drawOrder":   1,
+	},
+	"PolyStyle": {
+		"color":              3,
+		"colorMode":          4,
+		"fill":               7,
+		"gx:labelVisibility": 5,
+		"outline":            8,
+	},
+	"Polygon": {
+		"altitudeMode":    5,
+		"altitudeOffset":  1,
+		"extrude":         3,
+		"gx:drawOrder":    1,
+		"innerBoundaryIs": 7,
+		"outerBoundaryIs": 6,
+		"tessellate":      4,
+	},
+	"Region": {
+		"LatLonAltBox": 1,
+		"Lod":          2,
+	},
+	"ResourceMap": {
+		"Alias": 1,
+	},
+	"Scale": {
+		"x": 1,
+		"y": 2,
+		"z": 3,
+	},
+	"Schema": {
+		"SimpleArrayField": 1,
+		"SimpleField":      0,
+	},
+	"SchemaData": {
+		"SimpleArrayData": 2,
+		"SimpleData":      1,
+	},
+	"ScreenOverlay": {
+		"Camera":             11,
+		"ExtendedData":       16,
+		"Icon":               21,
+		"LookAt":             11,
+		"Metadata":           16,
+		"Region":             15,
+		"Snippet":            9,
+		"Style":              14,
+		"StyleMap":           14,
+		"TimeSpan":           12,
+		"TimeStamp":          12,
+		"address":            6,
+		"atom:author":        4,
+		"atom:link":          5,
+		"balloonVisibility":  17,
+		"color":              19,
+		"description":        10,
+		"drawOrder":          20,
+		"gx:rank":            17,
+		"name":               1,
+		"open":               3,
+		"overlayXY":          24,
+		"phoneNumber":        8,
+		"rotation":           28,
+		"rotationXY":         26,
+		"screenXY":           25,
+		"size":               27,
+		"snippet":            9,
+		"styleUrl":           13,
+		"visibility":         2,
+		"xal:AddressDetails": 7,
+	},
+	"SimpleArrayData": {
+		"value": 1,
+	},
+	"SimpleArrayField": {
+		"displayName": 0,
+	},
+	"SimpleField": {
+		"displayName": 0,
+	},
+	"SoundCue": {
+		"delayedStart": 2,
+		"href":         1,
+	},
+	"Style": {
+		"BalloonStyle": 7,
+		"IconStyle":    3,
+		"LabelStyle":   4,
+		"LineStyle":    5,
+		"ListStyle":    8,
+		"PolyStyle":    6,
+	},
+	"StyleMap": {
+		"Pair": 3,
+	},
+	"TimeSpan": {
+		"begin": 3,
+		"end":   4,
+	},
+	"TimeStamp": {
+		"when": 3,
+	},
+	"Tour": {
+		"Camera":             11,
+		"ExtendedData":       16,
+		"LookAt":             11,
+		"Metadata":           16,
+		"Playlist":           19,
+		"Region":             15,
+		"Snippet":            9,
+		"Style":              14,
+		"StyleMap":           14,
+		"TimeSpan":           12,
+		"TimeStamp":          12,
+		"address":            6,
+		"atom:author":        4,
+		"atom:link":          5,
+		"balloonVisibility":  17,
+		"description":        10,
+		"gx:rank":            17,
+		"name":               1,
+		"open":               3,
+		"phoneNumber":        8,
+		"snippet":            9,
+		"styleUrl":           13,
+		"visibility":         2,
+		"xal:AddressDetails": 7,
+	},
+	"TourControl": {
+		"playMode": 1,
+	},
+	"Track": {
+		"ExtendedData":   10,
+		"Model":          9,
+		"altitudeMode":   5,
+		"altitudeOffset": 1,
+		"angles":         8,
+		"coord":          7,
+		"extrude":        3,
+		"gx:drawOrder":   1,
+		"tessellate":     4,
+		"when":           6,
+	},
+	"Update": {
+		"Change":     1,
+		"Create":     1,
+		"Delete":     1,
+		"targetHref": 0,
+	},
+	"Url": {
+		"gx:h":            2,
+		"gx:w":            2,
+		"gx:x":            2,
+		"gx:y":            2,
+		"href":            1,
+		"httpQuery":       10,
+		"refreshInterval": 5,
+		"refreshMode":     4,
+		"viewBoundScale":  8,
+		"viewFormat":      9,
+		"viewRefreshMode": 6,
+		"viewRefreshTime": 7,
+	},
+	"ViewVolume": {
+		"bottomFov": 3,
+		"leftFov":   1,
+		"near":      5,
+		"rightFov":  2,
+		"topFov":    4,
+	},
+	"Wait": {
+		"duration": 1,
+	},
+	"gx:AbstractTourPrimitive": {},
+	"gx:ViewerOptions": {
+		"gx:option": 1,
+	},
+	"innerBoundaryIs": {
+		"LinearRing": 0,
+	},
+	"kml": {
+		"Document":           1,
+		"Folder":             1,
+		"GroundOverlay":      1,
+		"NetworkLink":        1,
+		"NetworkLinkControl": 0,
+		"PhotoOverlay":       1,
+		"Placemark":          1,
+		"ScreenOverlay":      1,
+		"Tour":               1,
+	},
+	"outerBoundaryIs": {
+		"LinearRing": 0,
+	},
+}
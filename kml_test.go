@@ -96,11 +96,41 @@ func TestSimpleElements(t *testing.T) {
 			element:  Folder(),
 			expected: `<Folder></Folder>`,
 		},
+		{
+			name:     "Data",
+			element:  Data(DisplayName("Hole Number"), Value("1")),
+			expected: `<Data><displayName>Hole Number</displayName><value>1</value></Data>`,
+		},
+		{
+			name:     "DataWithName",
+			element:  DataWithName("holeNumber", DisplayName("Hole Number"), Value("1")),
+			expected: `<Data name="holeNumber"><displayName>Hole Number</displayName><value>1</value></Data>`,
+		},
 		{
 			name:     "GxCoord",
 			element:  GxCoord(Coordinate{1.23, 4.56, 7.89}),
 			expected: `<gx:coord>1.23 4.56 7.89</gx:coord>`,
 		},
+		{
+			name:     "GxOption",
+			element:  GxOption(GxOptionNameStreetView, true),
+			expected: `<gx:option name="streetview" enabled="true"></gx:option>`,
+		},
+		{
+			name:     "GxSimpleArrayData",
+			element:  GxSimpleArrayData(GxValue("86")),
+			expected: `<gx:SimpleArrayData><gx:value>86</gx:value></gx:SimpleArrayData>`,
+		},
+		{
+			name:     "GxSimpleArrayDataWithName",
+			element:  GxSimpleArrayDataWithName("cadence", GxValue("86")),
+			expected: `<gx:SimpleArrayData name="cadence"><gx:value>86</gx:value></gx:SimpleArrayData>`,
+		},
+		{
+			name:     "GxSimpleArrayField",
+			element:  GxSimpleArrayField("heartrate", "int", DisplayName("Heart Rate")),
+			expected: `<gx:SimpleArrayField name="heartrate" type="int"><displayName>Heart Rate</displayName></gx:SimpleArrayField>`,
+		},
 		{
 			name:     "Heading",
 			element:  Heading(0),
@@ -136,6 +166,16 @@ func TestSimpleElements(t *testing.T) {
 			element:  OverlayXY(Vec2{X: 0, Y: 0, XUnits: UnitsFraction, YUnits: UnitsFraction}),
 			expected: `<overlayXY x="0" y="0" xunits="fraction" yunits="fraction"></overlayXY>`,
 		},
+		{
+			name:     "SharedDataWithName",
+			element:  SharedDataWithName("d1", "holeNumber", Value("1")),
+			expected: `<Data id="d1" name="holeNumber"><value>1</value></Data>`,
+		},
+		{
+			name:     "SimpleData",
+			element:  SimpleData("TrailHeadName", "Pi in the sky"),
+			expected: `<SimpleData name="TrailHeadName">Pi in the sky</SimpleData>`,
+		},
 		{
 			name:     "Style",
 			element:  Style(),
@@ -222,6 +262,42 @@ func TestCompoundElements(t *testing.T) {
 	}
 }
 
+func TestGxLatLonQuad(t *testing.T) {
+	ll := Coordinate{Lon: 0, Lat: 0}
+	lr := Coordinate{Lon: 1, Lat: 0}
+	ur := Coordinate{Lon: 1, Lat: 1}
+	ul := Coordinate{Lon: 0, Lat: 1}
+
+	element, err := GxLatLonQuadCorners(ll, lr, ur, ul)
+	require.NoError(t, err)
+	sb := &strings.Builder{}
+	require.NoError(t, xml.NewEncoder(sb).Encode(element))
+	assert.Equal(t, `<gx:LatLonQuad><coordinates>0,0 1,0 1,1 0,1</coordinates></gx:LatLonQuad>`, sb.String())
+
+	_, err = GxLatLonQuadCorners(ll, ul, ur, lr)
+	assert.Equal(t, ErrNotCounterClockwise, err)
+
+	// A bow-tie with unequal lobes has a positive signed area.
+	_, err = GxLatLonQuadCorners(
+		Coordinate{Lon: 0, Lat: 0},
+		Coordinate{Lon: 2, Lat: 0},
+		Coordinate{Lon: 0, Lat: 1},
+		Coordinate{Lon: 1, Lat: 1},
+	)
+	assert.Equal(t, ErrSelfIntersecting, err)
+
+	rotated := GeoTransform{0, 1, 1, 4, 1, -1}
+	element, err = GxLatLonQuadGeoTransform(rotated, 2, 2)
+	require.NoError(t, err)
+	sb = &strings.Builder{}
+	require.NoError(t, xml.NewEncoder(sb).Encode(element))
+	assert.Equal(t, `<gx:LatLonQuad><coordinates>2,2 4,4 2,6 0,4</coordinates></gx:LatLonQuad>`, sb.String())
+
+	mirrored := GeoTransform{0, 1, 0, 0, 0, 1}
+	_, err = GxLatLonQuadGeoTransform(mirrored, 2, 2)
+	assert.Equal(t, ErrNotCounterClockwise, err)
+}
+
 func TestSharedStyles(t *testing.T) {
 	style0 := SharedStyle("0")
 	highlightPlacemarkStyle := SharedStyle(
@@ -371,6 +447,95 @@ func TestSharedStyles(t *testing.T) {
 	}
 }
 
+func TestObjectIDs(t *testing.T) {
+	placemark := SharedPlacemark("pm1", Name("Placemark"))
+	assert.Equal(t, "#pm1", placemark.URL())
+	for _, tc := range []struct {
+		name     string
+		element  Element
+		expected string
+	}{
+		{
+			name:     "SharedPlacemark",
+			element:  placemark,
+			expected: `<Placemark id="pm1"><name>Placemark</name></Placemark>`,
+		},
+		{
+			name:     "TargetLineString",
+			element:  Change(TargetLineString("ls1", Tessellate(true))),
+			expected: `<Change><LineString targetId="ls1"><tessellate>1</tessellate></LineString></Change>`,
+		},
+		{
+			name:     "SharedGxTour",
+			element:  SharedGxTour("tour1"),
+			expected: `<gx:Tour id="tour1"></gx:Tour>`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			sb := &strings.Builder{}
+			e := xml.NewEncoder(sb)
+			require.NoError(t, e.Encode(tc.element))
+			assert.Equal(t, tc.expected, sb.String())
+		})
+	}
+}
+
+func TestAccessors(t *testing.T) {
+	for _, tc := range []struct {
+		name             string
+		element          Element
+		expectedName     string
+		expectedOK       bool
+		expectedID       string
+		expectedChildren int
+	}{
+		{
+			name:         "simple",
+			element:      Name("a"),
+			expectedName: "name",
+			expectedOK:   true,
+		},
+		{
+			name:             "compound",
+			element:          Placemark(Name("a"), Visibility(true)),
+			expectedName:     "Placemark",
+			expectedOK:       true,
+			expectedChildren: 2,
+		},
+		{
+			name:             "shared",
+			element:          SharedStyle("s", IconStyle()),
+			expectedName:     "Style",
+			expectedOK:       true,
+			expectedID:       "s",
+			expectedChildren: 1,
+		},
+		{
+			name:         "coordinates",
+			element:      Coordinates(Coordinate{Lon: 1, Lat: 2}),
+			expectedName: "coordinates",
+			expectedOK:   true,
+		},
+		{
+			name:         "coordinates_flat",
+			element:      CoordinatesFlat([]float64{1, 2}, 0, 2, 2, 2),
+			expectedName: "coordinates",
+			expectedOK:   true,
+		},
+		{
+			name: "nil",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			start, ok := StartElementOf(tc.element)
+			assert.Equal(t, tc.expectedOK, ok)
+			assert.Equal(t, tc.expectedName, start.Name.Local)
+			assert.Equal(t, tc.expectedID, AttrValue(tc.element, "id"))
+			assert.Len(t, ChildrenOf(tc.element), tc.expectedChildren)
+		})
+	}
+}
+
 func TestWrite(t *testing.T) {
 	for _, tc := range []struct {
 		name     string
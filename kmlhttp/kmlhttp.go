@@ -0,0 +1,247 @@
+// Package kmlhttp provides an http.Handler for serving dynamic KML to
+// NetworkLinks.
+//
+// See https://developers.google.com/kml/documentation/kmlreference#viewformat.
+package kmlhttp
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/twpayne/go-kml"
+	"github.com/twpayne/go-kml/kmz"
+)
+
+// Content types.
+const (
+	KMLContentType = "application/vnd.google-earth.kml+xml"
+	KMZContentType = "application/vnd.google-earth.kmz"
+)
+
+// ViewFormat is a value for a Link's viewFormat element that requests all the
+// view parameters parsed by ParseViewRequest.
+const ViewFormat = "BBOX=[bboxWest],[bboxSouth],[bboxEast],[bboxNorth]" +
+	"&LOOKAT=[lookatLon],[lookatLat],[lookatRange],[lookatTilt],[lookatHeading]" +
+	"&CAMERA=[cameraLon],[cameraLat],[cameraAlt]" +
+	"&VIEW=[horizFov],[vertFov],[horizPixels],[vertPixels],[terrainEnabled]"
+
+// HTTPQuery is a value for a Link's httpQuery element that requests all the
+// client parameters parsed by ParseViewRequest.
+const HTTPQuery = "client=[clientName]&version=[clientVersion]&kmlVersion=[kmlVersion]&language=[language]"
+
+// Errors.
+var (
+	errInvalidParameter = errors.New("invalid parameter")
+	errNilFunc          = errors.New("nil Func")
+)
+
+// A LookAt is the position that the client is looking at.
+type LookAt struct {
+	Lon, Lat, Range, Tilt, Heading float64
+}
+
+// A Camera is the position of the client's camera.
+type Camera struct {
+	Lon, Lat, Alt float64
+}
+
+// A View is the client's field of view.
+type View struct {
+	HorizFOV, VertFOV       float64
+	HorizPixels, VertPixels int
+	TerrainEnabled          bool
+}
+
+// A ViewRequest is a request from a NetworkLink. Fields are nil if the
+// corresponding parameters are absent.
+type ViewRequest struct {
+	*http.Request
+	Bounds        *kml.Bounds
+	LookAt        *LookAt
+	Camera        *Camera
+	View          *View
+	ClientName    string
+	ClientVersion string
+	KMLVersion    string
+	Language      string
+}
+
+// A HandlerFunc returns the Element to serve in response to r.
+type HandlerFunc func(r *ViewRequest) (kml.Element, error)
+
+// A Handler serves the Elements returned by Func.
+type Handler struct {
+	// Func returns the Element to serve.
+	Func HandlerFunc
+	// KMZ controls whether responses are KMZ archives rather than KML
+	// documents.
+	KMZ bool
+	// MaxAge, if non-zero, sets the Cache-Control and Expires headers.
+	MaxAge time.Duration
+	// ErrorLog logs errors returned by Func, which are not sent to clients.
+	// If nil, the log package's standard logger is used.
+	ErrorLog *log.Logger
+}
+
+// ParseViewRequest parses the view parameters in r's query.
+func ParseViewRequest(r *http.Request) (*ViewRequest, error) {
+	query := r.URL.Query()
+	vr := &ViewRequest{
+		Request:       r,
+		ClientName:    query.Get("client"),
+		ClientVersion: query.Get("version"),
+		KMLVersion:    query.Get("kmlVersion"),
+		Language:      query.Get("language"),
+	}
+	if vr.Language == "" {
+		vr.Language = strings.TrimSpace(strings.Split(strings.Split(r.Header.Get("Accept-Language"), ",")[0], ";")[0])
+	}
+
+	if values, err := parseFloats(query, "BBOX", 4); err != nil {
+		return nil, err
+	} else if values != nil {
+		vr.Bounds = &kml.Bounds{West: values[0], South: values[1], East: values[2], North: values[3]}
+	}
+	if values, err := parseFloats(query, "LOOKAT", 5); err != nil {
+		return nil, err
+	} else if values != nil {
+		vr.LookAt = &LookAt{Lon: values[0], Lat: values[1], Range: values[2], Tilt: values[3], Heading: values[4]}
+	}
+	if values, err := parseFloats(query, "CAMERA", 3); err != nil {
+		return nil, err
+	} else if values != nil {
+		vr.Camera = &Camera{Lon: values[0], Lat: values[1], Alt: values[2]}
+	}
+	if values, err := parseFloats(query, "VIEW", 5); err != nil {
+		return nil, err
+	} else if values != nil {
+		vr.View = &View{
+			HorizFOV:       values[0],
+			VertFOV:        values[1],
+			HorizPixels:    int(values[2]),
+			VertPixels:     int(values[3]),
+			TerrainEnabled: values[4] != 0,
+		}
+	}
+	return vr, nil
+}
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	vr, err := ParseViewRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if h.Func == nil {
+		h.internalServerError(w, errNilFunc)
+		return
+	}
+	e, err := h.Func(vr)
+	if err != nil {
+		h.internalServerError(w, err)
+		return
+	}
+
+	body := &bytes.Buffer{}
+	contentType := KMLContentType
+	if h.KMZ {
+		contentType = KMZContentType
+		err = kmz.Write(body, e, nil)
+	} else {
+		err = e.Write(body)
+	}
+	if err != nil {
+		h.internalServerError(w, err)
+		return
+	}
+
+	sum := sha256.Sum256(body.Bytes())
+	etag := `W/"` + hex.EncodeToString(sum[:]) + `"`
+	header := w.Header()
+	header.Set("Content-Type", contentType)
+	header.Set("ETag", etag)
+	header.Add("Vary", "Accept-Encoding")
+	if h.MaxAge != 0 {
+		header.Set("Cache-Control", "max-age="+strconv.Itoa(int(h.MaxAge.Seconds())))
+		header.Set("Expires", time.Now().Add(h.MaxAge).UTC().Format(http.TimeFormat))
+	}
+	if etagMatches(r.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	if h.KMZ || !acceptsGzip(r) {
+		header.Set("Content-Length", strconv.Itoa(body.Len()))
+		_, _ = w.Write(body.Bytes())
+		return
+	}
+	header.Set("Content-Encoding", "gzip")
+	gzw := gzip.NewWriter(w)
+	_, _ = gzw.Write(body.Bytes())
+	_ = gzw.Close()
+}
+
+// internalServerError logs err and responds to w with a generic error.
+func (h *Handler) internalServerError(w http.ResponseWriter, err error) {
+	if h.ErrorLog != nil {
+		h.ErrorLog.Printf("kmlhttp: %v", err)
+	} else {
+		log.Printf("kmlhttp: %v", err)
+	}
+	http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+}
+
+// etagMatches returns true if the If-None-Match header value ifNoneMatch
+// matches etag, using the weak comparison.
+func etagMatches(ifNoneMatch, etag string) bool {
+	for _, tag := range strings.Split(ifNoneMatch, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" || strings.TrimPrefix(tag, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
+
+// acceptsGzip returns true if r accepts gzip-encoded responses.
+func acceptsGzip(r *http.Request) bool {
+	for _, encoding := range strings.Split(r.Header.Get("Accept-Encoding"), ",") {
+		if strings.TrimSpace(strings.Split(encoding, ";")[0]) == "gzip" {
+			return true
+		}
+	}
+	return false
+}
+
+// parseFloats parses the n comma-separated floats in query parameter key. It
+// returns nil if the parameter is absent or all its values are empty, which
+// occurs when the client has no view.
+func parseFloats(query map[string][]string, key string, n int) ([]float64, error) {
+	values, ok := query[key]
+	if !ok || len(values) == 0 || strings.Trim(values[0], ",") == "" {
+		return nil, nil
+	}
+	fields := strings.Split(values[0], ",")
+	if len(fields) != n {
+		return nil, fmt.Errorf("%s: %w", key, errInvalidParameter)
+	}
+	floats := make([]float64, n)
+	for i, field := range fields {
+		f, err := strconv.ParseFloat(strings.TrimSpace(field), 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", key, errInvalidParameter)
+		}
+		floats[i] = f
+	}
+	return floats, nil
+}
@@ -0,0 +1,171 @@
+package kmlhttp
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/twpayne/go-kml"
+)
+
+func TestParseViewRequest(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		url         string
+		header      http.Header
+		expected    *ViewRequest
+		expectedErr bool
+	}{
+		{
+			name:     "empty",
+			url:      "/",
+			expected: &ViewRequest{},
+		},
+		{
+			name: "all",
+			url: "/?BBOX=-1,-2,3,4&LOOKAT=1,2,3000,45,90&CAMERA=1,2,300&VIEW=60,40,800,600,1" +
+				"&client=Google+Earth&version=7.3&kmlVersion=2.2&language=de",
+			expected: &ViewRequest{
+				Bounds:        &kml.Bounds{West: -1, South: -2, East: 3, North: 4},
+				LookAt:        &LookAt{Lon: 1, Lat: 2, Range: 3000, Tilt: 45, Heading: 90},
+				Camera:        &Camera{Lon: 1, Lat: 2, Alt: 300},
+				View:          &View{HorizFOV: 60, VertFOV: 40, HorizPixels: 800, VertPixels: 600, TerrainEnabled: true},
+				ClientName:    "Google Earth",
+				ClientVersion: "7.3",
+				KMLVersion:    "2.2",
+				Language:      "de",
+			},
+		},
+		{
+			name:     "no_view",
+			url:      "/?BBOX=,,,",
+			expected: &ViewRequest{},
+		},
+		{
+			name:     "accept_language",
+			url:      "/",
+			header:   http.Header{"Accept-Language": []string{"fr-CH, fr;q=0.9"}},
+			expected: &ViewRequest{Language: "fr-CH"},
+		},
+		{
+			name:        "invalid_float",
+			url:         "/?BBOX=a,b,c,d",
+			expectedErr: true,
+		},
+		{
+			name:        "wrong_length",
+			url:         "/?CAMERA=1,2",
+			expectedErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, tc.url, nil)
+			for key, values := range tc.header {
+				r.Header[key] = values
+			}
+			actual, err := ParseViewRequest(r)
+			if tc.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			tc.expected.Request = r
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestHandler(t *testing.T) {
+	errorLog := &bytes.Buffer{}
+	h := &Handler{
+		Func: func(r *ViewRequest) (kml.Element, error) {
+			if r.Bounds == nil {
+				return nil, errors.New("no bounds")
+			}
+			return kml.KML(kml.Placemark(kml.Point(kml.Coordinates(r.Bounds.Center())))), nil
+		},
+		MaxAge:   time.Minute,
+		ErrorLog: log.New(errorLog, "", 0),
+	}
+	expectedBody := `<?xml version="1.0" encoding="UTF-8"?>` + "\n" +
+		`<kml xmlns="http://www.opengis.net/kml/2.2"><Placemark><Point><coordinates>1,2</coordinates></Point></Placemark></kml>`
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/?BBOX=0,0,2,4", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, KMLContentType, w.Header().Get("Content-Type"))
+	assert.Equal(t, "max-age=60", w.Header().Get("Cache-Control"))
+	assert.NotEmpty(t, w.Header().Get("Expires"))
+	assert.Equal(t, expectedBody, w.Body.String())
+	assert.Equal(t, "Accept-Encoding", w.Header().Get("Vary"))
+	etag := w.Header().Get("ETag")
+	assert.NotEmpty(t, etag)
+
+	for _, ifNoneMatch := range []string{
+		etag,
+		strings.TrimPrefix(etag, "W/"),
+		`"other", ` + etag,
+		"*",
+	} {
+		r := httptest.NewRequest(http.MethodGet, "/?BBOX=0,0,2,4", nil)
+		r.Header.Set("If-None-Match", ifNoneMatch)
+		w = httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		assert.Equal(t, http.StatusNotModified, w.Code, ifNoneMatch)
+		assert.Equal(t, "Accept-Encoding", w.Header().Get("Vary"))
+	}
+
+	r := httptest.NewRequest(http.MethodGet, "/?BBOX=0,0,2,4", nil)
+	r.Header.Set("If-None-Match", `W/"other"`)
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	r = httptest.NewRequest(http.MethodGet, "/?BBOX=0,0,2,4", nil)
+	r.Header.Set("Accept-Encoding", "gzip, deflate")
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	assert.Equal(t, "gzip", w.Header().Get("Content-Encoding"))
+	gzr, err := gzip.NewReader(w.Body)
+	require.NoError(t, err)
+	body, err := ioutil.ReadAll(gzr)
+	require.NoError(t, err)
+	assert.Equal(t, expectedBody, string(body))
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/?BBOX=x", nil))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.NotContains(t, w.Body.String(), "no bounds")
+	assert.Equal(t, "kmlhttp: no bounds\n", errorLog.String())
+
+	h.KMZ = true
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/?BBOX=0,0,2,4", nil))
+	assert.Equal(t, KMZContentType, w.Header().Get("Content-Type"))
+	assert.Equal(t, "PK", w.Body.String()[:2])
+}
+
+func TestHandlerNilFunc(t *testing.T) {
+	errorLog := &bytes.Buffer{}
+	h := &Handler{
+		ErrorLog: log.New(errorLog, "", 0),
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Equal(t, "kmlhttp: nil Func\n", errorLog.String())
+}
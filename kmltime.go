@@ -0,0 +1,89 @@
+package kml
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ErrInvalidKMLTime is returned when a KMLTime cannot be parsed.
+var ErrInvalidKMLTime = errors.New("kml: invalid time")
+
+// A TimePrecision is the precision of a KMLTime.
+type TimePrecision int
+
+// TimePrecisions.
+const (
+	// TimePrecisionDateTime is a date and time with a time zone, e.g.
+	// 1997-07-16T07:30:15Z.
+	TimePrecisionDateTime TimePrecision = iota
+	// TimePrecisionLocalDateTime is a date and time without a time zone,
+	// e.g. 1997-07-16T07:30:15.
+	TimePrecisionLocalDateTime
+	// TimePrecisionDate is a date, e.g. 1997-07-16.
+	TimePrecisionDate
+	// TimePrecisionYearMonth is a year and month, e.g. 1997-07.
+	TimePrecisionYearMonth
+	// TimePrecisionYear is a year, e.g. 1997.
+	TimePrecisionYear
+)
+
+// kmlTimeLayouts are the layouts of each TimePrecision.
+var kmlTimeLayouts = map[TimePrecision]string{
+	TimePrecisionDateTime:      time.RFC3339Nano,
+	TimePrecisionLocalDateTime: "2006-01-02T15:04:05.999999999",
+	TimePrecisionDate:          "2006-01-02",
+	TimePrecisionYearMonth:     "2006-01",
+	TimePrecisionYear:          "2006",
+}
+
+// A KMLTime is a time with a precision, corresponding to KML's dateTimeType.
+type KMLTime struct {
+	Time      time.Time
+	Precision TimePrecision
+}
+
+// ParseKMLTime parses s as a KMLTime. The precision is determined by the
+// format of s.
+func ParseKMLTime(s string) (KMLTime, error) {
+	s = strings.TrimSpace(s)
+	if t, err := time.Parse(kmlTimeLayouts[TimePrecisionDateTime], s); err == nil {
+		return KMLTime{Time: t, Precision: TimePrecisionDateTime}, nil
+	}
+	for _, precision := range []TimePrecision{
+		TimePrecisionLocalDateTime,
+		TimePrecisionDate,
+		TimePrecisionYearMonth,
+		TimePrecisionYear,
+	} {
+		if t, err := time.Parse(kmlTimeLayouts[precision], s); err == nil {
+			return KMLTime{Time: t, Precision: precision}, nil
+		}
+	}
+	return KMLTime{}, fmt.Errorf("%w: %q", ErrInvalidKMLTime, s)
+}
+
+// String returns kt formatted with its precision.
+func (kt KMLTime) String() string {
+	layout, ok := kmlTimeLayouts[kt.Precision]
+	if !ok {
+		layout = time.RFC3339Nano
+	}
+	return kt.Time.Format(layout)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (kt KMLTime) MarshalText() ([]byte, error) {
+	return []byte(kt.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (kt *KMLTime) UnmarshalText(data []byte) error {
+	parsed, err := ParseKMLTime(string(data))
+	if err != nil {
+		return err
+	}
+	*kt = parsed
+	return nil
+}
@@ -0,0 +1,78 @@
+package kml
+
+import (
+	"encoding/xml"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKMLTime(t *testing.T) {
+	for _, tc := range []struct {
+		s        string
+		expected KMLTime
+	}{
+		{
+			s:        "1997",
+			expected: KMLTime{Time: time.Date(1997, 1, 1, 0, 0, 0, 0, time.UTC), Precision: TimePrecisionYear},
+		},
+		{
+			s:        "1997-07",
+			expected: KMLTime{Time: time.Date(1997, 7, 1, 0, 0, 0, 0, time.UTC), Precision: TimePrecisionYearMonth},
+		},
+		{
+			s:        "1997-07-16",
+			expected: KMLTime{Time: time.Date(1997, 7, 16, 0, 0, 0, 0, time.UTC), Precision: TimePrecisionDate},
+		},
+		{
+			s:        "1997-07-16T07:30:15",
+			expected: KMLTime{Time: time.Date(1997, 7, 16, 7, 30, 15, 0, time.UTC), Precision: TimePrecisionLocalDateTime},
+		},
+		{
+			s:        "1997-07-16T07:30:15.25",
+			expected: KMLTime{Time: time.Date(1997, 7, 16, 7, 30, 15, 250000000, time.UTC), Precision: TimePrecisionLocalDateTime},
+		},
+		{
+			s:        "1997-07-16T07:30:15Z",
+			expected: KMLTime{Time: time.Date(1997, 7, 16, 7, 30, 15, 0, time.UTC), Precision: TimePrecisionDateTime},
+		},
+		{
+			s:        "1997-07-16T07:30:15.123Z",
+			expected: KMLTime{Time: time.Date(1997, 7, 16, 7, 30, 15, 123000000, time.UTC), Precision: TimePrecisionDateTime},
+		},
+	} {
+		t.Run(tc.s, func(t *testing.T) {
+			actual, err := ParseKMLTime(tc.s)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, actual)
+			assert.Equal(t, tc.s, actual.String())
+		})
+	}
+
+	actual, err := ParseKMLTime("1997-07-16T10:30:15+03:00")
+	require.NoError(t, err)
+	assert.True(t, actual.Time.Equal(time.Date(1997, 7, 16, 7, 30, 15, 0, time.UTC)))
+	assert.Equal(t, "1997-07-16T10:30:15+03:00", actual.String())
+
+	_, err = ParseKMLTime("July 1997")
+	assert.True(t, errors.Is(err, ErrInvalidKMLTime))
+}
+
+func TestKMLTimeElements(t *testing.T) {
+	sb := &strings.Builder{}
+	require.NoError(t, xml.NewEncoder(sb).Encode(TimeSpan(
+		BeginKMLTime(KMLTime{Time: time.Date(1876, 1, 1, 0, 0, 0, 0, time.UTC), Precision: TimePrecisionYear}),
+		EndKMLTime(KMLTime{Time: time.Date(1876, 8, 1, 0, 0, 0, 0, time.UTC), Precision: TimePrecisionYearMonth}),
+	)))
+	assert.Equal(t, `<TimeSpan><begin>1876</begin><end>1876-08</end></TimeSpan>`, sb.String())
+
+	var timeStamp struct {
+		When KMLTime `xml:"when"`
+	}
+	require.NoError(t, xml.Unmarshal([]byte(`<TimeStamp><when> 1997-07 </when></TimeStamp>`), &timeStamp))
+	assert.Equal(t, KMLTime{Time: time.Date(1997, 7, 1, 0, 0, 0, 0, time.UTC), Precision: TimePrecisionYearMonth}, timeStamp.When)
+}
@@ -0,0 +1,74 @@
+// Package kmz provides functions for writing KMZ archives.
+//
+// See https://developers.google.com/kml/documentation/kmzarchives.
+package kmz
+
+import (
+	"archive/zip"
+	"io"
+	"sort"
+
+	"github.com/twpayne/go-kml"
+)
+
+// DefaultFilename is the conventional filename of the root KML document in a
+// KMZ archive.
+const DefaultFilename = "doc.kml"
+
+// A Writer writes a KMZ archive. Google Earth treats the first KML file in
+// the archive as the root document, so it should be written first.
+type Writer struct {
+	zw *zip.Writer
+}
+
+// NewWriter returns a new Writer that writes a KMZ archive to w.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{
+		zw: zip.NewWriter(w),
+	}
+}
+
+// WriteKML writes e to the archive as filename.
+func (w *Writer) WriteKML(filename string, e kml.Element) error {
+	fw, err := w.zw.Create(filename)
+	if err != nil {
+		return err
+	}
+	return e.Write(fw)
+}
+
+// WriteFile writes data to the archive as filename.
+func (w *Writer) WriteFile(filename string, data []byte) error {
+	fw, err := w.zw.Create(filename)
+	if err != nil {
+		return err
+	}
+	_, err = fw.Write(data)
+	return err
+}
+
+// Close finishes writing the archive. It does not close the underlying
+// writer.
+func (w *Writer) Close() error {
+	return w.zw.Close()
+}
+
+// Write writes a KMZ archive to w containing root as DefaultFilename followed
+// by files, in filename order.
+func Write(w io.Writer, root kml.Element, files map[string][]byte) error {
+	kmzw := NewWriter(w)
+	if err := kmzw.WriteKML(DefaultFilename, root); err != nil {
+		return err
+	}
+	filenames := make([]string, 0, len(files))
+	for filename := range files {
+		filenames = append(filenames, filename)
+	}
+	sort.Strings(filenames)
+	for _, filename := range filenames {
+		if err := kmzw.WriteFile(filename, files[filename]); err != nil {
+			return err
+		}
+	}
+	return kmzw.Close()
+}
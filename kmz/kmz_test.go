@@ -0,0 +1,39 @@
+package kmz
+
+import (
+	"archive/zip"
+	"bytes"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/twpayne/go-kml"
+)
+
+func TestWrite(t *testing.T) {
+	buf := &bytes.Buffer{}
+	require.NoError(t, Write(buf, kml.KML(kml.Placemark()), map[string][]byte{
+		"images/b.png": []byte("b"),
+		"images/a.png": []byte("a"),
+	}))
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	var filenames []string
+	contents := make(map[string]string)
+	for _, f := range zr.File {
+		filenames = append(filenames, f.Name)
+		rc, err := f.Open()
+		require.NoError(t, err)
+		data, err := ioutil.ReadAll(rc)
+		require.NoError(t, err)
+		require.NoError(t, rc.Close())
+		contents[f.Name] = string(data)
+	}
+	assert.Equal(t, []string{"doc.kml", "images/a.png", "images/b.png"}, filenames)
+	assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>`+"\n"+
+		`<kml xmlns="http://www.opengis.net/kml/2.2"><Placemark></Placemark></kml>`, contents["doc.kml"])
+	assert.Equal(t, "a", contents["images/a.png"])
+}
@@ -0,0 +1,277 @@
+// Package legend renders color legends as ScreenOverlays.
+//
+// See https://developers.google.com/kml/documentation/kmlreference#screenoverlay.
+package legend
+
+import (
+	"bytes"
+	"encoding/base64"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+
+	"github.com/twpayne/go-kml"
+)
+
+// Filename is the conventional filename of a legend image in a KMZ archive.
+const Filename = "files/legend.png"
+
+// A Corner is a corner of the screen.
+type Corner int
+
+// Corners.
+const (
+	CornerTopLeft Corner = iota
+	CornerTopRight
+	CornerBottomLeft
+	CornerBottomRight
+)
+
+// An Entry is a color and its label.
+type Entry struct {
+	Color color.Color
+	Label string
+}
+
+// Options control how legends are rendered and placed.
+type Options struct {
+	// Title is drawn above the entries, if not empty.
+	Title string
+	// Corner is the corner of the screen to which the legend is anchored.
+	Corner Corner
+	// Margin is the distance from the legend to the edges of the screen, in
+	// pixels.
+	Margin int
+	// Scale is the scale of the built-in font. The default is 2.
+	Scale int
+	// Background is the background color. The default is translucent white.
+	Background color.Color
+	// Foreground is the color of text and borders. The default is black.
+	Foreground color.Color
+}
+
+// A Legend is a rendered legend.
+type Legend struct {
+	image  *image.RGBA
+	corner Corner
+	margin int
+}
+
+// layout contains the dimensions of a legend, in pixels.
+type layout struct {
+	scale     int
+	padding   int
+	rowHeight int
+	swatch    int
+	titleRows int
+}
+
+// newLayout returns the layout for options.
+func newLayout(options *Options) layout {
+	if options.Scale <= 0 {
+		options.Scale = 2
+	}
+	if options.Background == nil {
+		options.Background = color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xc0}
+	}
+	if options.Foreground == nil {
+		options.Foreground = color.Black
+	}
+	l := layout{
+		scale:     options.Scale,
+		padding:   4 * options.Scale,
+		rowHeight: (glyphHeight + 4) * options.Scale,
+		swatch:    (glyphHeight + 2) * options.Scale,
+	}
+	if options.Title != "" {
+		l.titleRows = 1
+	}
+	return l
+}
+
+// newImage returns a new image of width by height filled with the background
+// color and containing the title.
+func (l layout) newImage(width, height int, options Options) *image.RGBA {
+	if titleWidth := 2*l.padding + l.scale*textWidth(options.Title); titleWidth > width {
+		width = titleWidth
+	}
+	m := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(m, m.Bounds(), image.NewUniform(options.Background), image.Point{}, draw.Src)
+	if options.Title != "" {
+		drawText(m, image.Pt(l.padding, l.padding), options.Title, options.Foreground, l.scale)
+	}
+	return m
+}
+
+// maxLabelWidth returns the width of the widest label in entries, in pixels.
+func (l layout) maxLabelWidth(entries []Entry) int {
+	width := 0
+	for _, entry := range entries {
+		if w := l.scale * textWidth(entry.Label); w > width {
+			width = w
+		}
+	}
+	return width
+}
+
+// New returns a new Legend with a swatch and a label for each of entries.
+func New(entries []Entry, options Options) *Legend {
+	l := newLayout(&options)
+	width := 3*l.padding + l.swatch + l.maxLabelWidth(entries)
+	height := 2*l.padding + (l.titleRows+len(entries))*l.rowHeight - (l.rowHeight - l.swatch)
+	m := l.newImage(width, height, options)
+	for i, entry := range entries {
+		y := l.padding + (l.titleRows+i)*l.rowHeight
+		swatch := image.Rect(l.padding, y, l.padding+l.swatch, y+l.swatch)
+		draw.Draw(m, swatch, image.NewUniform(options.Foreground), image.Point{}, draw.Src)
+		draw.Draw(m, swatch.Inset(l.scale), image.NewUniform(entry.Color), image.Point{}, draw.Src)
+		labelY := y + (l.swatch-glyphHeight*l.scale)/2
+		drawText(m, image.Pt(2*l.padding+l.swatch, labelY), entry.Label, options.Foreground, l.scale)
+	}
+	return &Legend{
+		image:  m,
+		corner: options.Corner,
+		margin: options.Margin,
+	}
+}
+
+// NewRamp returns a new Legend with a continuous color ramp that passes
+// through the colors of stops from top to bottom, each labeled with its
+// label.
+func NewRamp(stops []Entry, options Options) *Legend {
+	l := newLayout(&options)
+	barHeight := 2*l.rowHeight*maxInt(len(stops)-1, 1) + 1
+	width := 3*l.padding + l.swatch + l.maxLabelWidth(stops)
+	top := l.padding + l.titleRows*l.rowHeight + glyphHeight*l.scale/2
+	height := top + barHeight + glyphHeight*l.scale/2 + l.padding
+	m := l.newImage(width, height, options)
+
+	bar := image.Rect(l.padding, top, l.padding+l.swatch, top+barHeight)
+	draw.Draw(m, bar.Inset(-l.scale), image.NewUniform(options.Foreground), image.Point{}, draw.Src)
+	for y := bar.Min.Y; y < bar.Max.Y; y++ {
+		c := rampColor(stops, float64(y-bar.Min.Y)/float64(barHeight-1))
+		draw.Draw(m, image.Rect(bar.Min.X, y, bar.Max.X, y+1), image.NewUniform(c), image.Point{}, draw.Src)
+	}
+	for i, stop := range stops {
+		y := top
+		if len(stops) > 1 {
+			y += i * (barHeight - 1) / (len(stops) - 1)
+		}
+		drawText(m, image.Pt(2*l.padding+l.swatch, y-glyphHeight*l.scale/2), stop.Label, options.Foreground, l.scale)
+	}
+	return &Legend{
+		image:  m,
+		corner: options.Corner,
+		margin: options.Margin,
+	}
+}
+
+// Image returns l's image.
+func (l *Legend) Image() image.Image {
+	return l.image
+}
+
+// PNG returns l's image encoded as a PNG.
+func (l *Legend) PNG() ([]byte, error) {
+	b := &bytes.Buffer{}
+	if err := png.Encode(b, l.image); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// Files returns the files to add to a KMZ archive for a ScreenOverlay
+// returned by l.ScreenOverlay(Filename).
+func (l *Legend) Files() (map[string][]byte, error) {
+	data, err := l.PNG()
+	if err != nil {
+		return nil, err
+	}
+	return map[string][]byte{
+		Filename: data,
+	}, nil
+}
+
+// DataURI returns l's image as a PNG data URI.
+func (l *Legend) DataURI() (string, error) {
+	data, err := l.PNG()
+	if err != nil {
+		return "", err
+	}
+	return "data:image/png;base64," + base64.StdEncoding.EncodeToString(data), nil
+}
+
+// ScreenOverlay returns a new ScreenOverlay element containing children that
+// displays l's image at href, anchored to l's corner of the screen.
+func (l *Legend) ScreenOverlay(href string, children ...kml.Element) *kml.CompoundElement {
+	var overlayXY, screenXY kml.Vec2
+	margin := float64(l.margin)
+	switch l.corner {
+	case CornerTopRight:
+		overlayXY = kml.Vec2{X: 1, Y: 1, XUnits: kml.UnitsFraction, YUnits: kml.UnitsFraction}
+		screenXY = kml.Vec2{X: margin, Y: margin, XUnits: kml.UnitsInsetPixels, YUnits: kml.UnitsInsetPixels}
+	case CornerBottomLeft:
+		overlayXY = kml.Vec2{X: 0, Y: 0, XUnits: kml.UnitsFraction, YUnits: kml.UnitsFraction}
+		screenXY = kml.Vec2{X: margin, Y: margin, XUnits: kml.UnitsPixels, YUnits: kml.UnitsPixels}
+	case CornerBottomRight:
+		overlayXY = kml.Vec2{X: 1, Y: 0, XUnits: kml.UnitsFraction, YUnits: kml.UnitsFraction}
+		screenXY = kml.Vec2{X: margin, Y: margin, XUnits: kml.UnitsInsetPixels, YUnits: kml.UnitsPixels}
+	default:
+		overlayXY = kml.Vec2{X: 0, Y: 1, XUnits: kml.UnitsFraction, YUnits: kml.UnitsFraction}
+		screenXY = kml.Vec2{X: margin, Y: margin, XUnits: kml.UnitsPixels, YUnits: kml.UnitsInsetPixels}
+	}
+	size := l.image.Bounds().Size()
+	return kml.ScreenOverlay(append(children,
+		kml.Icon(
+			kml.Href(href),
+		),
+		kml.OverlayXY(overlayXY),
+		kml.ScreenXY(screenXY),
+		kml.Size(kml.Vec2{X: float64(size.X), Y: float64(size.Y), XUnits: kml.UnitsPixels, YUnits: kml.UnitsPixels}),
+	)...)
+}
+
+// DataURIScreenOverlay returns a new ScreenOverlay element containing children
+// that embeds l's image as a data URI.
+func (l *Legend) DataURIScreenOverlay(children ...kml.Element) (*kml.CompoundElement, error) {
+	dataURI, err := l.DataURI()
+	if err != nil {
+		return nil, err
+	}
+	return l.ScreenOverlay(dataURI, children...), nil
+}
+
+// rampColor returns the color at t, between 0 and 1, along the ramp through
+// the colors of stops.
+func rampColor(stops []Entry, t float64) color.Color {
+	switch {
+	case len(stops) == 0:
+		return color.Transparent
+	case len(stops) == 1 || t <= 0:
+		return stops[0].Color
+	case t >= 1:
+		return stops[len(stops)-1].Color
+	}
+	t *= float64(len(stops) - 1)
+	i := int(t)
+	t -= float64(i)
+	c0 := color.NRGBAModel.Convert(stops[i].Color).(color.NRGBA)
+	c1 := color.NRGBAModel.Convert(stops[i+1].Color).(color.NRGBA)
+	lerp := func(a, b uint8) uint8 {
+		return uint8(float64(a) + t*(float64(b)-float64(a)) + 0.5)
+	}
+	return color.NRGBA{
+		R: lerp(c0.R, c1.R),
+		G: lerp(c0.G, c1.G),
+		B: lerp(c0.B, c1.B),
+		A: lerp(c0.A, c1.A),
+	}
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
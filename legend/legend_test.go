@@ -0,0 +1,135 @@
+package legend
+
+import (
+	"bytes"
+	"image/color"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/twpayne/go-kml"
+)
+
+var (
+	red   = color.RGBA{R: 0xff, A: 0xff}
+	green = color.RGBA{G: 0xff, A: 0xff}
+	blue  = color.RGBA{B: 0xff, A: 0xff}
+)
+
+func TestNew(t *testing.T) {
+	l := New([]Entry{
+		{Color: red, Label: "Red"},
+		{Color: green, Label: "Green"},
+	}, Options{Scale: 1})
+	m := l.Image()
+	// Padding 4, swatch 9, padding 4, "Green" 29, padding 4.
+	assert.Equal(t, 50, m.Bounds().Dx())
+	// Padding 4, two rows of 11 less the gap below the last swatch, padding 4.
+	assert.Equal(t, 28, m.Bounds().Dy())
+	assert.Equal(t, color.RGBAModel.Convert(color.Black), m.At(4, 4))
+	assert.Equal(t, red, m.At(8, 8))
+	assert.Equal(t, green, m.At(8, 19))
+
+	foreground := 0
+	for x := 17; x < 46; x++ {
+		for y := 15; y < 24; y++ {
+			if m.At(x, y) == color.RGBAModel.Convert(color.Black) {
+				foreground++
+			}
+		}
+	}
+	assert.NotZero(t, foreground)
+}
+
+func TestNewTitle(t *testing.T) {
+	l := New([]Entry{
+		{Color: red, Label: "A"},
+	}, Options{Title: "Long title", Scale: 2})
+	assert.Equal(t, 2*8+2*textWidth("Long title"), l.Image().Bounds().Dx())
+}
+
+func TestNewRamp(t *testing.T) {
+	l := NewRamp([]Entry{
+		{Color: red, Label: "100"},
+		{Color: green, Label: "50"},
+		{Color: blue, Label: "0"},
+	}, Options{Scale: 1})
+	m := l.Image()
+	top := 4 + glyphHeight/2
+	barHeight := 2*11*2 + 1
+	assert.Equal(t, top+barHeight+glyphHeight/2+4, m.Bounds().Dy())
+	assert.Equal(t, red, m.At(8, top))
+	assert.Equal(t, color.RGBAModel.Convert(color.NRGBA{G: 0xff, A: 0xff}), m.At(8, top+(barHeight-1)/2))
+	assert.Equal(t, blue, m.At(8, top+barHeight-1))
+}
+
+func TestRampColor(t *testing.T) {
+	stops := []Entry{{Color: color.Black}, {Color: color.White}}
+	assert.Equal(t, color.Black, rampColor(stops, -1))
+	assert.Equal(t, color.NRGBA{R: 0x80, G: 0x80, B: 0x80, A: 0xff}, rampColor(stops, 0.5))
+	assert.Equal(t, color.White, rampColor(stops, 2))
+	assert.Equal(t, color.Transparent, rampColor(nil, 0.5))
+}
+
+func TestScreenOverlay(t *testing.T) {
+	for _, tc := range []struct {
+		corner    Corner
+		overlayXY kml.Vec2
+		screenXY  kml.Vec2
+	}{
+		{
+			corner:    CornerTopLeft,
+			overlayXY: kml.Vec2{X: 0, Y: 1, XUnits: kml.UnitsFraction, YUnits: kml.UnitsFraction},
+			screenXY:  kml.Vec2{X: 10, Y: 10, XUnits: kml.UnitsPixels, YUnits: kml.UnitsInsetPixels},
+		},
+		{
+			corner:    CornerTopRight,
+			overlayXY: kml.Vec2{X: 1, Y: 1, XUnits: kml.UnitsFraction, YUnits: kml.UnitsFraction},
+			screenXY:  kml.Vec2{X: 10, Y: 10, XUnits: kml.UnitsInsetPixels, YUnits: kml.UnitsInsetPixels},
+		},
+		{
+			corner:    CornerBottomLeft,
+			overlayXY: kml.Vec2{X: 0, Y: 0, XUnits: kml.UnitsFraction, YUnits: kml.UnitsFraction},
+			screenXY:  kml.Vec2{X: 10, Y: 10, XUnits: kml.UnitsPixels, YUnits: kml.UnitsPixels},
+		},
+		{
+			corner:    CornerBottomRight,
+			overlayXY: kml.Vec2{X: 1, Y: 0, XUnits: kml.UnitsFraction, YUnits: kml.UnitsFraction},
+			screenXY:  kml.Vec2{X: 10, Y: 10, XUnits: kml.UnitsInsetPixels, YUnits: kml.UnitsPixels},
+		},
+	} {
+		l := New([]Entry{{Color: red, Label: "Red"}}, Options{Corner: tc.corner, Margin: 10})
+		size := l.Image().Bounds().Size()
+		expected := kml.ScreenOverlay(
+			kml.Name("Legend"),
+			kml.Icon(
+				kml.Href(Filename),
+			),
+			kml.OverlayXY(tc.overlayXY),
+			kml.ScreenXY(tc.screenXY),
+			kml.Size(kml.Vec2{X: float64(size.X), Y: float64(size.Y), XUnits: kml.UnitsPixels, YUnits: kml.UnitsPixels}),
+		)
+		actual := l.ScreenOverlay(Filename, kml.Name("Legend"))
+		assert.True(t, kml.Equal(expected, actual), "%v", kml.Diff(expected, actual))
+	}
+}
+
+func TestFilesAndDataURI(t *testing.T) {
+	l := New([]Entry{{Color: red, Label: "Red"}}, Options{})
+
+	files, err := l.Files()
+	require.NoError(t, err)
+	require.Contains(t, files, Filename)
+	m, err := png.Decode(bytes.NewReader(files[Filename]))
+	require.NoError(t, err)
+	assert.Equal(t, l.Image().Bounds(), m.Bounds())
+
+	screenOverlay, err := l.DataURIScreenOverlay()
+	require.NoError(t, err)
+	b := &strings.Builder{}
+	require.NoError(t, screenOverlay.Write(b))
+	assert.Contains(t, b.String(), "<href>data:image/png;base64,")
+}
@@ -0,0 +1,198 @@
+// Package lint checks KML documents for elements and constructs that are not
+// supported, or are rendered unexpectedly, by popular KML consumers, and
+// fixes them where possible.
+package lint
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/twpayne/go-kml"
+)
+
+// A Profile is a KML consumer.
+type Profile string
+
+// Profiles.
+const (
+	GoogleEarthPro Profile = "google-earth-pro"
+	GoogleEarthWeb Profile = "google-earth-web"
+	GoogleMyMaps   Profile = "google-my-maps"
+	Cesium         Profile = "cesium"
+	QGIS           Profile = "qgis"
+)
+
+// Profiles are all known profiles.
+var Profiles = []Profile{
+	GoogleEarthPro,
+	GoogleEarthWeb,
+	GoogleMyMaps,
+	Cesium,
+	QGIS,
+}
+
+// A Severity is the severity of a problem.
+type Severity int
+
+// Severities.
+const (
+	// SeverityInfo problems are unlikely to affect how a document is
+	// displayed.
+	SeverityInfo Severity = iota
+	// SeverityWarning problems cause parts of a document to be ignored or
+	// displayed differently than intended.
+	SeverityWarning
+	// SeverityError problems make a document invalid.
+	SeverityError
+)
+
+var severityStrings = map[Severity]string{
+	SeverityInfo:    "info",
+	SeverityWarning: "warning",
+	SeverityError:   "error",
+}
+
+// String returns s as a string.
+func (s Severity) String() string {
+	if str, ok := severityStrings[s]; ok {
+		return str
+	}
+	return fmt.Sprintf("Severity(%d)", int(s))
+}
+
+// A Rule is a lint rule.
+type Rule struct {
+	Name        string
+	Description string
+	Severity    Severity
+	// Profiles are the profiles that the rule applies to. A nil Profiles
+	// applies to all profiles.
+	Profiles []Profile
+	// check returns a description of the problem with e, or the empty string
+	// if there is no problem.
+	check func(p Profile, e kml.Element) string
+	// fix returns e with the problem fixed, or nil if e should be removed.
+	fix func(p Profile, e kml.Element) kml.Element
+}
+
+// A Problem is a problem found by Lint.
+type Problem struct {
+	Rule    *Rule
+	Path    string
+	Element kml.Element
+	Message string
+}
+
+// AppliesTo returns true if r applies to p.
+func (r *Rule) AppliesTo(p Profile) bool {
+	if r.Profiles == nil {
+		return true
+	}
+	for _, profile := range r.Profiles {
+		if profile == p {
+			return true
+		}
+	}
+	return false
+}
+
+// Fixable returns true if Fix fixes problems found by r.
+func (r *Rule) Fixable() bool {
+	return r.fix != nil
+}
+
+// String returns a string representation of p.
+func (p Problem) String() string {
+	return fmt.Sprintf("%s: %s: %s (%s)", p.Rule.Severity, p.Path, p.Message, p.Rule.Name)
+}
+
+// Lint returns the problems in root for profile, in document order.
+func Lint(root kml.Element, profile Profile) []Problem {
+	rules := rulesFor(profile)
+	var problems []Problem
+	_ = kml.Walk(root, func(path []kml.Element, e kml.Element) error {
+		names := make([]string, 0, len(path)+1)
+		for _, ancestor := range path {
+			names = append(names, elementName(ancestor))
+		}
+		names = append(names, elementName(e))
+		var err error
+		for _, rule := range rules {
+			message := rule.check(profile, e)
+			if message == "" {
+				continue
+			}
+			problems = append(problems, Problem{
+				Rule:    rule,
+				Path:    strings.Join(names, "/"),
+				Element: e,
+				Message: message,
+			})
+			if rule == UnsupportedElement && rule.fix(profile, e) == nil {
+				err = kml.SkipChildren
+			}
+		}
+		return err
+	})
+	return problems
+}
+
+// Fix returns a copy of root with the fixable problems for profile fixed.
+func Fix(root kml.Element, profile Profile) kml.Element {
+	var rules []*Rule
+	for _, rule := range rulesFor(profile) {
+		if rule.Fixable() {
+			rules = append(rules, rule)
+		}
+	}
+	return kml.Rewrite(root, func(e kml.Element) kml.Element {
+		for _, rule := range rules {
+			if rule.check(profile, e) == "" {
+				continue
+			}
+			if e = rule.fix(profile, e); e == nil {
+				return nil
+			}
+		}
+		return e
+	})
+}
+
+// rulesFor returns the rules that apply to profile.
+func rulesFor(profile Profile) []*Rule {
+	var rules []*Rule
+	for _, rule := range Rules {
+		if rule.AppliesTo(profile) {
+			rules = append(rules, rule)
+		}
+	}
+	return rules
+}
+
+// elementName returns the name of e.
+func elementName(e kml.Element) string {
+	start, _ := kml.StartElementOf(e)
+	return start.Name.Local
+}
+
+// childValue returns the trimmed value of e's first simple child with name.
+func childValue(e kml.Element, name string) (string, bool) {
+	for _, c := range kml.ChildrenOf(e) {
+		if se, ok := c.(*kml.SimpleElement); ok && elementName(se) == name {
+			return strings.TrimSpace(se.Value()), true
+		}
+	}
+	return "", false
+}
+
+// withChildren returns a copy of e with its children replaced by children.
+// Shared elements remain shared elements with the same id.
+func withChildren(e kml.Element, children []kml.Element) kml.Element {
+	c := kml.Clone(e)
+	if ce, ok := c.(interface {
+		SetChildren(...kml.Element) *kml.CompoundElement
+	}); ok {
+		ce.SetChildren(children...)
+	}
+	return c
+}
@@ -0,0 +1,229 @@
+package lint
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/twpayne/go-kml"
+)
+
+func marshalString(t *testing.T, e kml.Element) string {
+	t.Helper()
+	data, err := xml.Marshal(e)
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestLint(t *testing.T) {
+	for _, tc := range []struct {
+		name             string
+		profile          Profile
+		root             kml.Element
+		expectedProblems []string
+		expectedFixed    kml.Element
+	}{
+		{
+			name:    "clean",
+			profile: GoogleEarthPro,
+			root: kml.Placemark(
+				kml.LineString(
+					kml.Tessellate(true),
+					kml.Coordinates(kml.Coordinate{Lon: 0, Lat: 0}, kml.Coordinate{Lon: 1, Lat: 1}),
+				),
+			),
+		},
+		{
+			name:    "unsupported_element",
+			profile: GoogleMyMaps,
+			root: kml.Document(
+				kml.ScreenOverlay(kml.Name("legend")),
+				kml.Placemark(
+					kml.GxTrack(kml.GxCoord(kml.Coordinate{Lon: 1, Lat: 2})),
+				),
+			),
+			expectedProblems: []string{
+				"warning: Document/ScreenOverlay: ScreenOverlay is not supported by google-my-maps (unsupported-element)",
+				"warning: Document/Placemark: gx:Track is not supported by google-my-maps, so Placemark has no geometry (unsupported-element)",
+			},
+			expectedFixed: kml.Document(),
+		},
+		{
+			name:    "unsupported_geometry",
+			profile: Cesium,
+			root: kml.Placemark(
+				kml.Name("a"),
+				kml.MultiGeometry(
+					kml.Point(kml.Coordinates(kml.Coordinate{Lon: 1, Lat: 2})),
+					kml.MultiGeometry(kml.Model()),
+					kml.Model(),
+				),
+			),
+			expectedProblems: []string{
+				"warning: Placemark/MultiGeometry: MultiGeometry, Model are not supported by cesium (unsupported-element)",
+			},
+			expectedFixed: kml.Placemark(
+				kml.Name("a"),
+				kml.MultiGeometry(
+					kml.Point(kml.Coordinates(kml.Coordinate{Lon: 1, Lat: 2})),
+				),
+			),
+		},
+		{
+			name:    "unsupported_geometry_in_placemark",
+			profile: QGIS,
+			root: kml.Placemark(
+				kml.Model(),
+				kml.LineString(
+					kml.Coordinates(kml.Coordinate{Lon: 0, Lat: 0}, kml.Coordinate{Lon: 1, Lat: 1}),
+				),
+			),
+			expectedProblems: []string{
+				"warning: Placemark: Model is not supported by qgis (unsupported-element)",
+			},
+			expectedFixed: kml.Placemark(
+				kml.LineString(
+					kml.Coordinates(kml.Coordinate{Lon: 0, Lat: 0}, kml.Coordinate{Lon: 1, Lat: 1}),
+				),
+			),
+		},
+		{
+			name:    "supported_element",
+			profile: GoogleEarthPro,
+			root: kml.Document(
+				kml.ScreenOverlay(kml.Name("legend")),
+			),
+		},
+		{
+			name:    "style_in_substyle",
+			profile: QGIS,
+			root: kml.SharedStyle("s",
+				kml.LineStyle(
+					kml.Width(2),
+					kml.Style(kml.PolyStyle()),
+				),
+			),
+			expectedProblems: []string{
+				"error: Style/LineStyle: Style in LineStyle is ignored (style-in-substyle)",
+			},
+			expectedFixed: kml.SharedStyle("s",
+				kml.LineStyle(
+					kml.Width(2),
+				),
+			),
+		},
+		{
+			name:    "missing_tessellate",
+			profile: GoogleEarthWeb,
+			root: kml.Placemark(
+				kml.LineString(
+					kml.Extrude(false),
+					kml.Coordinates(kml.Coordinate{Lon: 0, Lat: 0}, kml.Coordinate{Lon: 1, Lat: 1}),
+				),
+				kml.LineString(
+					kml.AltitudeMode(kml.AltitudeModeAbsolute),
+					kml.Coordinates(kml.Coordinate{Lon: 0, Lat: 0}, kml.Coordinate{Lon: 1, Lat: 1}),
+				),
+			),
+			expectedProblems: []string{
+				"warning: Placemark/LineString: LineString is clamped to the ground but not tessellated (missing-tessellate)",
+			},
+			expectedFixed: kml.Placemark(
+				kml.LineString(
+					kml.Extrude(false),
+					kml.Tessellate(true),
+					kml.Coordinates(kml.Coordinate{Lon: 0, Lat: 0}, kml.Coordinate{Lon: 1, Lat: 1}),
+				),
+				kml.LineString(
+					kml.AltitudeMode(kml.AltitudeModeAbsolute),
+					kml.Coordinates(kml.Coordinate{Lon: 0, Lat: 0}, kml.Coordinate{Lon: 1, Lat: 1}),
+				),
+			),
+		},
+		{
+			name:    "missing_tessellate_other_profile",
+			profile: QGIS,
+			root: kml.LineString(
+				kml.Coordinates(kml.Coordinate{Lon: 0, Lat: 0}, kml.Coordinate{Lon: 1, Lat: 1}),
+			),
+		},
+		{
+			name:    "rings",
+			profile: Cesium,
+			root: kml.Polygon(
+				kml.OuterBoundaryIs(
+					kml.LinearRing(
+						kml.Coordinates(
+							kml.Coordinate{Lon: 0, Lat: 0},
+							kml.Coordinate{Lon: 0, Lat: 1},
+							kml.Coordinate{Lon: 1, Lat: 1},
+							kml.Coordinate{Lon: 1, Lat: 0},
+						),
+					),
+				),
+			),
+			expectedProblems: []string{
+				"warning: Polygon/outerBoundaryIs: outer boundary is in clockwise order (ring-winding)",
+				"error: Polygon/outerBoundaryIs/LinearRing: LinearRing is not closed (unclosed-ring)",
+			},
+			expectedFixed: kml.Polygon(
+				kml.OuterBoundaryIs(
+					kml.LinearRing(
+						kml.Coordinates(
+							kml.Coordinate{Lon: 0, Lat: 0},
+							kml.Coordinate{Lon: 1, Lat: 0},
+							kml.Coordinate{Lon: 1, Lat: 1},
+							kml.Coordinate{Lon: 0, Lat: 1},
+							kml.Coordinate{Lon: 0, Lat: 0},
+						),
+					),
+				),
+			),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var problems []string
+			for _, problem := range Lint(tc.root, tc.profile) {
+				problems = append(problems, problem.String())
+			}
+			assert.Equal(t, tc.expectedProblems, problems)
+
+			expectedFixed := tc.expectedFixed
+			if expectedFixed == nil {
+				expectedFixed = tc.root
+			}
+			original := marshalString(t, tc.root)
+			fixed := Fix(tc.root, tc.profile)
+			assert.Equal(t, marshalString(t, expectedFixed), marshalString(t, fixed))
+			if se, ok := tc.root.(*kml.SharedElement); ok {
+				require.IsType(t, se, fixed)
+				assert.Equal(t, se.ID(), fixed.(*kml.SharedElement).ID())
+			}
+			assert.Equal(t, original, marshalString(t, tc.root))
+			assert.Empty(t, Lint(fixed, tc.profile))
+		})
+	}
+}
+
+func TestRules(t *testing.T) {
+	names := make(map[string]bool)
+	for _, rule := range Rules {
+		assert.False(t, names[rule.Name])
+		names[rule.Name] = true
+		assert.NotEmpty(t, rule.Description)
+		assert.True(t, rule.Fixable())
+	}
+	assert.True(t, MissingTessellate.AppliesTo(GoogleEarthPro))
+	assert.False(t, MissingTessellate.AppliesTo(GoogleMyMaps))
+	assert.True(t, UnclosedRing.AppliesTo(QGIS))
+}
+
+func TestSeverityString(t *testing.T) {
+	assert.Equal(t, "info", SeverityInfo.String())
+	assert.Equal(t, "warning", SeverityWarning.String())
+	assert.Equal(t, "error", SeverityError.String())
+	assert.True(t, strings.HasPrefix(Severity(-1).String(), "Severity("))
+}
@@ -0,0 +1,333 @@
+package lint
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/twpayne/go-kml"
+)
+
+// unsupportedElements maps profiles to the names of the elements that they
+// ignore.
+var unsupportedElements = map[Profile]map[string]bool{
+	GoogleEarthWeb: {
+		"PhotoOverlay":  true,
+		"ScreenOverlay": true,
+	},
+	GoogleMyMaps: {
+		"GroundOverlay":      true,
+		"Model":              true,
+		"NetworkLink":        true,
+		"NetworkLinkControl": true,
+		"PhotoOverlay":       true,
+		"Region":             true,
+		"ScreenOverlay":      true,
+		"TimeSpan":           true,
+		"TimeStamp":          true,
+	},
+	Cesium: {
+		"Model":        true,
+		"PhotoOverlay": true,
+	},
+	QGIS: {
+		"GroundOverlay": true,
+		"Model":         true,
+		"NetworkLink":   true,
+		"PhotoOverlay":  true,
+		"ScreenOverlay": true,
+		"gx:Tour":       true,
+	},
+}
+
+// geometryNames are the names of geometry elements. Unsupported geometries
+// are reported by the Placemark or MultiGeometry that contains them, so that
+// Placemarks are not left without a geometry.
+var geometryNames = map[string]bool{
+	"LineString":    true,
+	"LinearRing":    true,
+	"Model":         true,
+	"MultiGeometry": true,
+	"Point":         true,
+	"Polygon":       true,
+	"gx:MultiTrack": true,
+	"gx:Track":      true,
+}
+
+// subStyleNames are the names of the elements that are children of Style.
+var subStyleNames = map[string]bool{
+	"BalloonStyle": true,
+	"IconStyle":    true,
+	"LabelStyle":   true,
+	"LineStyle":    true,
+	"ListStyle":    true,
+	"PolyStyle":    true,
+}
+
+// Rules.
+var (
+	UnsupportedElement = &Rule{
+		Name:        "unsupported-element",
+		Description: "Element is ignored by the consumer.",
+		Severity:    SeverityWarning,
+		check:       checkUnsupportedElement,
+		fix:         fixUnsupportedElement,
+	}
+	StyleInSubStyle = &Rule{
+		Name:        "style-in-substyle",
+		Description: "Style or StyleMap is nested in a sub-style, such as LineStyle, where it is ignored.",
+		Severity:    SeverityError,
+		check:       checkStyleInSubStyle,
+		fix:         fixStyleInSubStyle,
+	}
+	MissingTessellate = &Rule{
+		Name:        "missing-tessellate",
+		Description: "LineString clamped to the ground is not tessellated, so it cuts through the terrain.",
+		Severity:    SeverityWarning,
+		Profiles:    []Profile{GoogleEarthPro, GoogleEarthWeb, Cesium},
+		check:       checkMissingTessellate,
+		fix:         fixMissingTessellate,
+	}
+	UnclosedRing = &Rule{
+		Name:        "unclosed-ring",
+		Description: "LinearRing's last coordinate is not the same as its first.",
+		Severity:    SeverityError,
+		check:       checkUnclosedRing,
+		fix:         fixUnclosedRing,
+	}
+	RingWinding = &Rule{
+		Name:        "ring-winding",
+		Description: "Polygon's outer boundary is not in counter-clockwise order, so extruded walls face inwards.",
+		Severity:    SeverityWarning,
+		check:       checkRingWinding,
+		fix:         fixRingWinding,
+	}
+)
+
+// Rules are all rules, in the order that they are checked.
+var Rules = []*Rule{
+	UnsupportedElement,
+	StyleInSubStyle,
+	MissingTessellate,
+	UnclosedRing,
+	RingWinding,
+}
+
+func checkUnsupportedElement(p Profile, e kml.Element) string {
+	name := elementName(e)
+	switch {
+	case geometryNames[name] && name != "MultiGeometry":
+		return ""
+	case unsupported(p, name):
+		return fmt.Sprintf("%s is not supported by %s", name, p)
+	case name != "Placemark" && name != "MultiGeometry":
+		return ""
+	}
+	names, displayed := unsupportedGeometries(p, e)
+	verb := "is"
+	if len(names) > 1 {
+		verb = "are"
+	}
+	switch {
+	case len(names) == 0:
+		return ""
+	case displayed:
+		return fmt.Sprintf("%s %s not supported by %s", strings.Join(names, ", "), verb, p)
+	case name == "Placemark":
+		return fmt.Sprintf("%s %s not supported by %s, so Placemark has no geometry", strings.Join(names, ", "), verb, p)
+	default:
+		return ""
+	}
+}
+
+func fixUnsupportedElement(p Profile, e kml.Element) kml.Element {
+	name := elementName(e)
+	if name != "Placemark" && name != "MultiGeometry" || unsupported(p, name) {
+		return nil
+	}
+	if _, displayed := unsupportedGeometries(p, e); !displayed {
+		return nil
+	}
+	var newChildren []kml.Element
+	for _, c := range kml.ChildrenOf(e) {
+		if !geometryNames[elementName(c)] || supportedGeometry(p, c) {
+			newChildren = append(newChildren, c)
+		}
+	}
+	return withChildren(e, newChildren)
+}
+
+func checkStyleInSubStyle(p Profile, e kml.Element) string {
+	if !subStyleNames[elementName(e)] {
+		return ""
+	}
+	for _, c := range kml.ChildrenOf(e) {
+		if name := elementName(c); name == "Style" || name == "StyleMap" {
+			return fmt.Sprintf("%s in %s is ignored", name, elementName(e))
+		}
+	}
+	return ""
+}
+
+func fixStyleInSubStyle(p Profile, e kml.Element) kml.Element {
+	var newChildren []kml.Element
+	for _, c := range kml.ChildrenOf(e) {
+		if name := elementName(c); name != "Style" && name != "StyleMap" {
+			newChildren = append(newChildren, c)
+		}
+	}
+	return withChildren(e, newChildren)
+}
+
+func checkMissingTessellate(p Profile, e kml.Element) string {
+	if elementName(e) != "LineString" {
+		return ""
+	}
+	if tessellate, _ := childValue(e, "tessellate"); tessellate == "1" || tessellate == "true" {
+		return ""
+	}
+	altitudeMode, ok := childValue(e, "altitudeMode")
+	if !ok {
+		altitudeMode, _ = childValue(e, "gx:altitudeMode")
+	}
+	switch altitudeMode {
+	case "", string(kml.AltitudeModeClampToGround), string(kml.GxAltitudeModeClampToSeaFloor):
+		return "LineString is clamped to the ground but not tessellated"
+	default:
+		return ""
+	}
+}
+
+func fixMissingTessellate(p Profile, e kml.Element) kml.Element {
+	newChildren := make([]kml.Element, 0, len(kml.ChildrenOf(e))+1)
+	inserted := false
+	for _, c := range kml.ChildrenOf(e) {
+		switch name := elementName(c); {
+		case name == "tessellate":
+			continue
+		case !inserted && name != "extrude":
+			newChildren = append(newChildren, kml.Tessellate(true))
+			inserted = true
+		}
+		newChildren = append(newChildren, c)
+	}
+	if !inserted {
+		newChildren = append(newChildren, kml.Tessellate(true))
+	}
+	return withChildren(e, newChildren)
+}
+
+func checkUnclosedRing(p Profile, e kml.Element) string {
+	if elementName(e) != "LinearRing" {
+		return ""
+	}
+	coords := ringCoordinates(e)
+	if len(coords) != 0 && coords[0] != coords[len(coords)-1] {
+		return "LinearRing is not closed"
+	}
+	return ""
+}
+
+func fixUnclosedRing(p Profile, e kml.Element) kml.Element {
+	coords := ringCoordinates(e)
+	return withCoordinates(e, append(coords[:len(coords):len(coords)], coords[0]))
+}
+
+func checkRingWinding(p Profile, e kml.Element) string {
+	if elementName(e) != "outerBoundaryIs" {
+		return ""
+	}
+	for _, c := range kml.ChildrenOf(e) {
+		if elementName(c) == "LinearRing" && signedArea(ringCoordinates(c)) < 0 {
+			return "outer boundary is in clockwise order"
+		}
+	}
+	return ""
+}
+
+func fixRingWinding(p Profile, e kml.Element) kml.Element {
+	newChildren := make([]kml.Element, 0, len(kml.ChildrenOf(e)))
+	for _, c := range kml.ChildrenOf(e) {
+		if elementName(c) == "LinearRing" {
+			coords := ringCoordinates(c)
+			reversed := make([]kml.Coordinate, len(coords))
+			for i, coord := range coords {
+				reversed[len(coords)-1-i] = coord
+			}
+			c = withCoordinates(c, reversed)
+		}
+		newChildren = append(newChildren, c)
+	}
+	return withChildren(e, newChildren)
+}
+
+// unsupported returns true if p ignores elements with name.
+func unsupported(p Profile, name string) bool {
+	return unsupportedElements[p][name] || p == GoogleMyMaps && strings.HasPrefix(name, "gx:")
+}
+
+// supportedGeometry returns true if p displays the geometry e. A
+// MultiGeometry is displayed unless all of its geometries are not.
+func supportedGeometry(p Profile, e kml.Element) bool {
+	name := elementName(e)
+	if unsupported(p, name) {
+		return false
+	}
+	if name != "MultiGeometry" {
+		return true
+	}
+	_, displayed := unsupportedGeometries(p, e)
+	return displayed
+}
+
+// unsupportedGeometries returns the names of the geometries of e that p does
+// not display, and true if p displays any of e's geometries or e has none.
+func unsupportedGeometries(p Profile, e kml.Element) ([]string, bool) {
+	var names []string
+	geometries := 0
+	for _, c := range kml.ChildrenOf(e) {
+		name := elementName(c)
+		if !geometryNames[name] {
+			continue
+		}
+		geometries++
+		if !supportedGeometry(p, c) {
+			names = append(names, name)
+		}
+	}
+	return names, len(names) < geometries || geometries == 0
+}
+
+// ringCoordinates returns the coordinates of the LinearRing e. Only
+// coordinates created with kml.Coordinates or kml.Parse are returned.
+func ringCoordinates(e kml.Element) []kml.Coordinate {
+	for _, c := range kml.ChildrenOf(e) {
+		if ce, ok := c.(*kml.CoordinatesElement); ok {
+			return ce.Coordinates()
+		}
+	}
+	return nil
+}
+
+// withCoordinates returns a copy of e with its coordinates replaced by
+// coords.
+func withCoordinates(e kml.Element, coords []kml.Coordinate) kml.Element {
+	newChildren := make([]kml.Element, 0, len(kml.ChildrenOf(e)))
+	for _, c := range kml.ChildrenOf(e) {
+		if _, ok := c.(*kml.CoordinatesElement); ok {
+			c = kml.Coordinates(coords...)
+		}
+		newChildren = append(newChildren, c)
+	}
+	return withChildren(e, newChildren)
+}
+
+// signedArea returns twice the signed area of the polygon with vertices cs,
+// which is positive if cs are in counter-clockwise order.
+func signedArea(cs []kml.Coordinate) float64 {
+	area := 0.0
+	for i, c := range cs {
+		next := cs[(i+1)%len(cs)]
+		area += c.Lon*next.Lat - next.Lon*c.Lat
+	}
+	return area
+}
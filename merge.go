@@ -0,0 +1,213 @@
+package kml
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// dedupedNames are the names of the shared elements that are deduplicated by
+// Merge.
+var dedupedNames = map[string]bool{
+	"Schema":   true,
+	"Style":    true,
+	"StyleMap": true,
+}
+
+// A merger merges documents.
+type merger struct {
+	ids     map[string]bool
+	shared  map[string]string
+	styles  []Element
+	schemas []Element
+	gx      bool
+}
+
+// Merge returns a new kml element containing a Document with a Folder for
+// each of docs. Each of docs may be a kml element, a Document, or any other
+// feature. The children of each kml element's or Document's Document are
+// moved into its Folder.
+//
+// Shared Style, StyleMap, and Schema elements that are identical to one in an
+// earlier document, apart from their ids, are removed. The remaining ones are
+// moved to the new Document, where Schemas must be. Other ids that collide
+// with an id in an earlier document are renamed by adding a numeric suffix.
+// Local references to removed or renamed ids in styleUrl and targetHref
+// values and in schemaUrl and targetId attributes are updated.
+func Merge(docs ...Element) (Element, error) {
+	m := &merger{
+		ids:    make(map[string]bool),
+		shared: make(map[string]string),
+	}
+	folders := make([]Element, 0, len(docs))
+	for i, doc := range docs {
+		children, err := m.sourceChildren(doc)
+		if err != nil {
+			return nil, fmt.Errorf("document %d: %w", i+1, err)
+		}
+		folders = append(folders, m.merge(children))
+	}
+	docChildren := make([]Element, 0, len(m.styles)+len(m.schemas)+len(folders))
+	docChildren = append(docChildren, m.styles...)
+	docChildren = append(docChildren, m.schemas...)
+	docChildren = append(docChildren, folders...)
+	if m.gx {
+		return GxKML(Document(docChildren...)), nil
+	}
+	return KML(Document(docChildren...)), nil
+}
+
+// sourceChildren returns the children of the Folder for doc.
+func (m *merger) sourceChildren(doc Element) ([]Element, error) {
+	ce := compoundElement(doc)
+	if ce == nil {
+		return nil, fmt.Errorf("%w: %s is not a compound element", ErrInvalidKML, elementName(doc))
+	}
+	if ce.Name.Local != "kml" {
+		if ce.Name.Local == "Document" {
+			return ce.children, nil
+		}
+		return []Element{doc}, nil
+	}
+	if attrValue(ce.StartElement, "xmlns:gx") != "" {
+		m.gx = true
+	}
+	var children []Element
+	for _, child := range ce.children {
+		switch childCE := compoundElement(child); {
+		case childCE != nil && childCE.Name.Local == "Document":
+			children = append(children, childCE.children...)
+		case childCE != nil && childCE.Name.Local == "NetworkLinkControl":
+		default:
+			children = append(children, child)
+		}
+	}
+	return children, nil
+}
+
+// merge returns a Folder containing children with their ids made unique and
+// their shared elements deduplicated and moved to m.
+func (m *merger) merge(children []Element) Element {
+	ids := make(map[string]string)
+	_ = Walk(Folder(children...), func(path []Element, e Element) error {
+		if strings.HasPrefix(elementName(e), "gx:") {
+			m.gx = true
+		}
+		start, ok := StartElementOf(e)
+		if !ok {
+			return nil
+		}
+		id := attrValue(start, "id")
+		if _, ok := ids[id]; id == "" || ok {
+			return nil
+		}
+		newID := id
+		for n := 2; m.ids[newID]; n++ {
+			newID = id + "-" + strconv.Itoa(n)
+		}
+		ids[id] = newID
+		m.ids[newID] = true
+		return nil
+	})
+
+	aliases := make(map[string]string)
+	folderChildren := make([]Element, 0, len(children))
+	for _, child := range children {
+		child = Rewrite(child, func(e Element) Element {
+			return renameIDs(e, ids)
+		})
+		if ce := compoundElement(child); ce != nil && dedupedNames[ce.Name.Local] && elementID(ce) != "" {
+			id := elementID(ce)
+			key := sharedKey(compoundElement(Rewrite(ce, func(e Element) Element {
+				return renameIDs(e, aliases)
+			})))
+			if sharedID, ok := m.shared[key]; ok {
+				aliases[id] = sharedID
+				delete(m.ids, id)
+				continue
+			}
+			m.shared[key] = id
+		}
+		folderChildren = append(folderChildren, child)
+	}
+
+	features := folderChildren[:0]
+	for _, child := range folderChildren {
+		if len(aliases) != 0 {
+			child = Rewrite(child, func(e Element) Element {
+				return renameIDs(e, aliases)
+			})
+		}
+		switch ce := compoundElement(child); {
+		case ce != nil && ce.Name.Local == "Schema":
+			m.schemas = append(m.schemas, child)
+		case ce != nil && dedupedNames[ce.Name.Local] && elementID(ce) != "":
+			m.styles = append(m.styles, child)
+		default:
+			features = append(features, child)
+		}
+	}
+	return Folder(features...)
+}
+
+// renameIDs returns e with its id and the local references in it renamed
+// according to ids.
+func renameIDs(e Element, ids map[string]string) Element {
+	rename := func(ref string) string {
+		if !strings.HasPrefix(ref, "#") {
+			return ref
+		}
+		if id, ok := ids[ref[1:]]; ok {
+			return "#" + id
+		}
+		return ref
+	}
+	renameAttrs := func(attrs []xml.Attr) {
+		for i, attr := range attrs {
+			switch {
+			case attr.Name.Space != "":
+			case attr.Name.Local == "id":
+				if id, ok := ids[attr.Value]; ok {
+					attrs[i].Value = id
+				}
+			case attr.Name.Local == "schemaUrl":
+				attrs[i].Value = rename(attr.Value)
+			case attr.Name.Local == "targetId":
+				if id, ok := ids[attr.Value]; ok {
+					attrs[i].Value = id
+				}
+			}
+		}
+	}
+	switch e := e.(type) {
+	case *SimpleElement:
+		renameAttrs(e.Attr)
+		if e.Name.Local == "styleUrl" || e.Name.Local == "targetHref" {
+			e.value = rename(strings.TrimSpace(e.value))
+		}
+	case *CompoundElement:
+		renameAttrs(e.Attr)
+	case *SharedElement:
+		renameAttrs(e.Attr)
+		if id, ok := ids[e.id]; ok {
+			e.id = id
+		}
+	}
+	return e
+}
+
+// sharedKey returns a key that is equal for shared elements that are
+// identical apart from their ids.
+func sharedKey(ce *CompoundElement) string {
+	attrs := make([]xml.Attr, 0, len(ce.Attr))
+	for _, attr := range ce.Attr {
+		if attr.Name.Space != "" || attr.Name.Local != "id" {
+			attrs = append(attrs, attr)
+		}
+	}
+	return marshalString(Canonical(&CompoundElement{
+		StartElement: xml.StartElement{Name: ce.Name, Attr: attrs},
+		children:     ce.children,
+	}, CanonicalOptions{}))
+}
@@ -0,0 +1,217 @@
+package kml
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMerge(t *testing.T) {
+	styledDoc := func(width float64) Element {
+		return KML(
+			Document(
+				SharedStyle("normal", LineStyle(Width(width))),
+				SharedStyleMap("map",
+					Pair(Key(StyleStateNormal), StyleURL("#normal")),
+				),
+				Placemark(StyleURL("#map")),
+			),
+		)
+	}
+	for _, tc := range []struct {
+		name        string
+		docs        []Element
+		expected    Element
+		expectedErr error
+	}{
+		{
+			name: "empty",
+			expected: KML(
+				Document(),
+			),
+		},
+		{
+			name: "features",
+			docs: []Element{
+				KML(
+					Document(
+						Name("a"),
+						Placemark(Name("1")),
+					),
+				).Add(NetworkLinkControl(MinRefreshPeriod(60))),
+				Document(
+					Placemark(Name("2")),
+				),
+				Placemark(Name("3")),
+			},
+			expected: KML(
+				Document(
+					Folder(
+						Name("a"),
+						Placemark(Name("1")),
+					),
+					Folder(
+						Placemark(Name("2")),
+					),
+					Folder(
+						Placemark(Name("3")),
+					),
+				),
+			),
+		},
+		{
+			name: "colliding_ids",
+			docs: []Element{
+				styledDoc(1),
+				styledDoc(2),
+			},
+			expected: KML(
+				Document(
+					SharedStyle("normal", LineStyle(Width(1))),
+					SharedStyleMap("map",
+						Pair(Key(StyleStateNormal), StyleURL("#normal")),
+					),
+					SharedStyle("normal-2", LineStyle(Width(2))),
+					SharedStyleMap("map-2",
+						Pair(Key(StyleStateNormal), StyleURL("#normal-2")),
+					),
+					Folder(
+						Placemark(StyleURL("#map")),
+					),
+					Folder(
+						Placemark(StyleURL("#map-2")),
+					),
+				),
+			),
+		},
+		{
+			name: "identical_styles",
+			docs: []Element{
+				styledDoc(1),
+				styledDoc(2),
+				styledDoc(1),
+			},
+			expected: KML(
+				Document(
+					SharedStyle("normal", LineStyle(Width(1))),
+					SharedStyleMap("map",
+						Pair(Key(StyleStateNormal), StyleURL("#normal")),
+					),
+					SharedStyle("normal-2", LineStyle(Width(2))),
+					SharedStyleMap("map-2",
+						Pair(Key(StyleStateNormal), StyleURL("#normal-2")),
+					),
+					Folder(
+						Placemark(StyleURL("#map")),
+					),
+					Folder(
+						Placemark(StyleURL("#map-2")),
+					),
+					Folder(
+						Placemark(StyleURL("#map")),
+					),
+				),
+			),
+		},
+		{
+			name: "schemas",
+			docs: []Element{
+				Document(
+					Schema("s", "s", SimpleField("a", "int")),
+					Placemark(ExtendedData(SchemaData("#s", SimpleData("a", "1")))),
+				),
+				Document(
+					Schema("s", "s", SimpleField("b", "string")),
+					Placemark(ExtendedData(SchemaData("#s", SimpleData("b", "x")))),
+					NetworkLink(Link(Href("other.kml"))),
+				),
+			},
+			expected: KML(
+				Document(
+					Schema("s", "s", SimpleField("a", "int")),
+					Schema("s-2", "s", SimpleField("b", "string")),
+					Folder(
+						Placemark(ExtendedData(SchemaData("#s", SimpleData("a", "1")))),
+					),
+					Folder(
+						Placemark(ExtendedData(SchemaData("#s-2", SimpleData("b", "x")))),
+						NetworkLink(Link(Href("other.kml"))),
+					),
+				),
+			),
+		},
+		{
+			name: "target_ids",
+			docs: []Element{
+				Document(
+					SharedPlacemark("pm", Name("a")),
+				),
+				Document(
+					SharedPlacemark("pm", Name("b")),
+					GxTour(GxPlaylist(GxAnimatedUpdate(Update(
+						TargetHref(""),
+						Change(TargetPlacemark("pm", Name("c"))),
+					)))),
+				),
+			},
+			expected: GxKML(
+				Document(
+					Folder(
+						SharedPlacemark("pm", Name("a")),
+					),
+					Folder(
+						SharedPlacemark("pm-2", Name("b")),
+						GxTour(GxPlaylist(GxAnimatedUpdate(Update(
+							TargetHref(""),
+							Change(TargetPlacemark("pm-2", Name("c"))),
+						)))),
+					),
+				),
+			),
+		},
+		{
+			name: "gx",
+			docs: []Element{
+				Placemark(GxTrack()),
+			},
+			expected: GxKML(
+				Document(
+					Folder(
+						Placemark(GxTrack()),
+					),
+				),
+			),
+		},
+		{
+			name: "invalid",
+			docs: []Element{
+				Name("a"),
+			},
+			expectedErr: ErrInvalidKML,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := Merge(tc.docs...)
+			if tc.expectedErr != nil {
+				assert.True(t, errors.Is(err, tc.expectedErr))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, marshalString(tc.expected), marshalString(actual))
+			assert.Empty(t, Validate(actual))
+		})
+	}
+}
+
+func TestMergeDoesNotModifyDocs(t *testing.T) {
+	doc := Document(
+		SharedStyle("s"),
+		Placemark(StyleURL("#s")),
+	)
+	expected := marshalString(doc)
+	_, err := Merge(Document(SharedStyle("s", LineStyle())), doc)
+	require.NoError(t, err)
+	assert.Equal(t, expected, marshalString(doc))
+}
@@ -0,0 +1,137 @@
+// Package model places 3D COLLADA models and bundles them in KMZ archives.
+//
+// See https://developers.google.com/kml/documentation/models.
+package model
+
+import (
+	"io"
+	"path"
+
+	"github.com/twpayne/go-kml"
+	"github.com/twpayne/go-kml/kmz"
+)
+
+// An Orientation is a rotation of a model, in degrees.
+type Orientation struct {
+	Heading, Tilt, Roll float64
+}
+
+// A Scale is a scale of a model along each axis.
+type Scale struct {
+	X, Y, Z float64
+}
+
+// A Texture is a texture referenced by a model.
+type Texture struct {
+	// SourceHref is the href of the texture as written in the COLLADA file.
+	SourceHref string
+	// TargetHref is the href of the texture in the KMZ archive, which Google
+	// Earth loads in place of SourceHref. If empty, it is SourceHref relative
+	// to the directory of the COLLADA file.
+	TargetHref string
+	// Data is the content of the texture.
+	Data []byte
+}
+
+// A Placement is a model at a location.
+type Placement struct {
+	// Location is the location of the model's origin.
+	Location kml.Coordinate
+	// AltitudeMode is the altitude mode of Location. If empty, the default
+	// altitude mode is used.
+	AltitudeMode kml.AltitudeModeEnum
+	// Orientation is the rotation of the model.
+	Orientation Orientation
+	// Scale is the scale of the model. Zero components are treated as 1.
+	Scale Scale
+	// Href is the href of the COLLADA file in the KMZ archive.
+	Href string
+	// DAE is the content of the COLLADA file.
+	DAE []byte
+	// Textures are the textures referenced by the COLLADA file.
+	Textures []Texture
+}
+
+// targetHref returns the href of t in the KMZ archive.
+func (p *Placement) targetHref(t Texture) string {
+	if t.TargetHref != "" {
+		return t.TargetHref
+	}
+	return path.Join(path.Dir(p.Href), t.SourceHref)
+}
+
+// Model returns a new Model element containing children for p.
+func (p *Placement) Model(children ...kml.Element) *kml.CompoundElement {
+	if p.AltitudeMode != "" {
+		children = append(children, kml.AltitudeMode(p.AltitudeMode))
+	}
+	children = append(children,
+		kml.Location(
+			kml.Longitude(p.Location.Lon),
+			kml.Latitude(p.Location.Lat),
+			kml.Altitude(p.Location.Alt),
+		),
+		kml.Orientation(
+			kml.Heading(p.Orientation.Heading),
+			kml.Tilt(p.Orientation.Tilt),
+			kml.Roll(p.Orientation.Roll),
+		),
+		kml.ModelScale(
+			kml.X(scaleComponent(p.Scale.X)),
+			kml.Y(scaleComponent(p.Scale.Y)),
+			kml.Z(scaleComponent(p.Scale.Z)),
+		),
+		kml.Link(
+			kml.Href(p.Href),
+		),
+	)
+	if len(p.Textures) != 0 {
+		aliases := make([]kml.Element, 0, len(p.Textures))
+		for _, t := range p.Textures {
+			aliases = append(aliases, kml.Alias(
+				kml.TargetHref(p.targetHref(t)),
+				kml.SourceHref(t.SourceHref),
+			))
+		}
+		children = append(children, kml.ResourceMap(aliases...))
+	}
+	return kml.Model(children...)
+}
+
+// Placemark returns a new Placemark element containing children and p's
+// Model.
+func (p *Placement) Placemark(children ...kml.Element) *kml.CompoundElement {
+	return kml.Placemark(append(children, p.Model())...)
+}
+
+// Files returns the COLLADA file and textures of p, keyed by their hrefs in
+// the KMZ archive.
+func (p *Placement) Files() map[string][]byte {
+	files := map[string][]byte{
+		p.Href: p.DAE,
+	}
+	for _, t := range p.Textures {
+		files[p.targetHref(t)] = t.Data
+	}
+	return files
+}
+
+// WriteKMZ writes a KMZ archive to w containing root and the files of
+// placements.
+func WriteKMZ(w io.Writer, root kml.Element, placements ...*Placement) error {
+	files := make(map[string][]byte)
+	for _, p := range placements {
+		for href, data := range p.Files() {
+			files[href] = data
+		}
+	}
+	return kmz.Write(w, root, files)
+}
+
+// scaleComponent returns s, or 1 if s is zero.
+func scaleComponent(s float64) float64 {
+	if s == 0 {
+		return 1
+	}
+	return s
+}
@@ -0,0 +1,130 @@
+package model
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/twpayne/go-kml"
+)
+
+func TestModel(t *testing.T) {
+	for _, tc := range []struct {
+		name          string
+		placement     *Placement
+		expected      *kml.CompoundElement
+		expectedFiles map[string][]byte
+	}{
+		{
+			name: "minimal",
+			placement: &Placement{
+				Location: kml.Coordinate{Lon: 1, Lat: 2},
+				Href:     "models/house.dae",
+				DAE:      []byte("dae"),
+			},
+			expected: kml.Model(
+				kml.Location(
+					kml.Longitude(1),
+					kml.Latitude(2),
+					kml.Altitude(0),
+				),
+				kml.Orientation(
+					kml.Heading(0),
+					kml.Tilt(0),
+					kml.Roll(0),
+				),
+				kml.ModelScale(
+					kml.X(1),
+					kml.Y(1),
+					kml.Z(1),
+				),
+				kml.Link(
+					kml.Href("models/house.dae"),
+				),
+			),
+			expectedFiles: map[string][]byte{
+				"models/house.dae": []byte("dae"),
+			},
+		},
+		{
+			name: "full",
+			placement: &Placement{
+				Location:     kml.Coordinate{Lon: 1, Lat: 2, Alt: 3},
+				AltitudeMode: kml.AltitudeModeRelativeToGround,
+				Orientation:  Orientation{Heading: 45, Tilt: 10, Roll: -5},
+				Scale:        Scale{X: 2, Y: 3, Z: 4},
+				Href:         "models/house.dae",
+				DAE:          []byte("dae"),
+				Textures: []Texture{
+					{SourceHref: "roof.png", Data: []byte("roof")},
+					{SourceHref: "../images/wall.jpg", TargetHref: "textures/wall.jpg", Data: []byte("wall")},
+				},
+			},
+			expected: kml.Model(
+				kml.AltitudeMode(kml.AltitudeModeRelativeToGround),
+				kml.Location(
+					kml.Longitude(1),
+					kml.Latitude(2),
+					kml.Altitude(3),
+				),
+				kml.Orientation(
+					kml.Heading(45),
+					kml.Tilt(10),
+					kml.Roll(-5),
+				),
+				kml.ModelScale(
+					kml.X(2),
+					kml.Y(3),
+					kml.Z(4),
+				),
+				kml.Link(
+					kml.Href("models/house.dae"),
+				),
+				kml.ResourceMap(
+					kml.Alias(
+						kml.TargetHref("models/roof.png"),
+						kml.SourceHref("roof.png"),
+					),
+					kml.Alias(
+						kml.TargetHref("textures/wall.jpg"),
+						kml.SourceHref("../images/wall.jpg"),
+					),
+				),
+			),
+			expectedFiles: map[string][]byte{
+				"models/house.dae":  []byte("dae"),
+				"models/roof.png":   []byte("roof"),
+				"textures/wall.jpg": []byte("wall"),
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := tc.placement.Model()
+			assert.True(t, kml.Equal(tc.expected, actual), "%v", kml.Diff(tc.expected, actual))
+			assert.Equal(t, tc.expectedFiles, tc.placement.Files())
+		})
+	}
+}
+
+func TestWriteKMZ(t *testing.T) {
+	placement := &Placement{
+		Href: "models/house.dae",
+		DAE:  []byte("dae"),
+		Textures: []Texture{
+			{SourceHref: "roof.png", Data: []byte("roof")},
+		},
+	}
+	b := &bytes.Buffer{}
+	require.NoError(t, WriteKMZ(b, kml.KML(placement.Placemark(kml.Name("house"))), placement))
+
+	zr, err := zip.NewReader(bytes.NewReader(b.Bytes()), int64(b.Len()))
+	require.NoError(t, err)
+	var names []string
+	for _, f := range zr.File {
+		names = append(names, f.Name)
+	}
+	assert.Equal(t, []string{"doc.kml", "models/house.dae", "models/roof.png"}, names)
+}
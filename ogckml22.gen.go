@@ -3,7 +3,10 @@
 package kml
 
 import (
+	"encoding/xml"
+	"fmt"
 	"image/color"
+	"strconv"
 	"time"
 )
 
@@ -17,6 +20,49 @@ const (
 	AltitudeModeAbsolute         AltitudeModeEnum = "absolute"
 )
 
+// AltitudeModeEnumValues returns all AltitudeModeEnums.
+func AltitudeModeEnumValues() []AltitudeModeEnum {
+	return []AltitudeModeEnum{
+		AltitudeModeClampToGround,
+		AltitudeModeRelativeToGround,
+		AltitudeModeAbsolute,
+	}
+}
+
+// ParseAltitudeModeEnum parses s as an AltitudeModeEnum.
+func ParseAltitudeModeEnum(s string) (AltitudeModeEnum, error) {
+	e := AltitudeModeEnum(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("%w: AltitudeModeEnum %q", ErrInvalidEnumValue, s)
+	}
+	return e, nil
+}
+
+// IsValid returns true if e is a valid AltitudeModeEnum.
+func (e AltitudeModeEnum) IsValid() bool {
+	for _, value := range AltitudeModeEnumValues() {
+		if e == value {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns e as a string.
+func (e AltitudeModeEnum) String() string {
+	return string(e)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (e *AltitudeModeEnum) UnmarshalText(text []byte) error {
+	value, err := ParseAltitudeModeEnum(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
 // A ColorModeEnum is a colorModeEnumType.
 type ColorModeEnum string
 
@@ -26,6 +72,48 @@ const (
 	ColorModeRandom ColorModeEnum = "random"
 )
 
+// ColorModeEnumValues returns all ColorModeEnums.
+func ColorModeEnumValues() []ColorModeEnum {
+	return []ColorModeEnum{
+		ColorModeNormal,
+		ColorModeRandom,
+	}
+}
+
+// ParseColorModeEnum parses s as a ColorModeEnum.
+func ParseColorModeEnum(s string) (ColorModeEnum, error) {
+	e := ColorModeEnum(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("%w: ColorModeEnum %q", ErrInvalidEnumValue, s)
+	}
+	return e, nil
+}
+
+// IsValid returns true if e is a valid ColorModeEnum.
+func (e ColorModeEnum) IsValid() bool {
+	for _, value := range ColorModeEnumValues() {
+		if e == value {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns e as a string.
+func (e ColorModeEnum) String() string {
+	return string(e)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (e *ColorModeEnum) UnmarshalText(text []byte) error {
+	value, err := ParseColorModeEnum(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
 // A DisplayModeEnum is a displayModeEnumType.
 type DisplayModeEnum string
 
@@ -35,6 +123,48 @@ const (
 	DisplayModeHide    DisplayModeEnum = "hide"
 )
 
+// DisplayModeEnumValues returns all DisplayModeEnums.
+func DisplayModeEnumValues() []DisplayModeEnum {
+	return []DisplayModeEnum{
+		DisplayModeDefault,
+		DisplayModeHide,
+	}
+}
+
+// ParseDisplayModeEnum parses s as a DisplayModeEnum.
+func ParseDisplayModeEnum(s string) (DisplayModeEnum, error) {
+	e := DisplayModeEnum(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("%w: DisplayModeEnum %q", ErrInvalidEnumValue, s)
+	}
+	return e, nil
+}
+
+// IsValid returns true if e is a valid DisplayModeEnum.
+func (e DisplayModeEnum) IsValid() bool {
+	for _, value := range DisplayModeEnumValues() {
+		if e == value {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns e as a string.
+func (e DisplayModeEnum) String() string {
+	return string(e)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (e *DisplayModeEnum) UnmarshalText(text []byte) error {
+	value, err := ParseDisplayModeEnum(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
 // A GridOriginEnum is a gridOriginEnumType.
 type GridOriginEnum string
 
@@ -44,6 +174,48 @@ const (
 	GridOriginUpperLeft GridOriginEnum = "upperLeft"
 )
 
+// GridOriginEnumValues returns all GridOriginEnums.
+func GridOriginEnumValues() []GridOriginEnum {
+	return []GridOriginEnum{
+		GridOriginLowerLeft,
+		GridOriginUpperLeft,
+	}
+}
+
+// ParseGridOriginEnum parses s as a GridOriginEnum.
+func ParseGridOriginEnum(s string) (GridOriginEnum, error) {
+	e := GridOriginEnum(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("%w: GridOriginEnum %q", ErrInvalidEnumValue, s)
+	}
+	return e, nil
+}
+
+// IsValid returns true if e is a valid GridOriginEnum.
+func (e GridOriginEnum) IsValid() bool {
+	for _, value := range GridOriginEnumValues() {
+		if e == value {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns e as a string.
+func (e GridOriginEnum) String() string {
+	return string(e)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (e *GridOriginEnum) UnmarshalText(text []byte) error {
+	value, err := ParseGridOriginEnum(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
 // A ItemIconStateEnum is a itemIconStateEnumType.
 type ItemIconStateEnum string
 
@@ -57,6 +229,52 @@ const (
 	ItemIconStateFetching2 ItemIconStateEnum = "fetching2"
 )
 
+// ItemIconStateEnumValues returns all ItemIconStateEnums.
+func ItemIconStateEnumValues() []ItemIconStateEnum {
+	return []ItemIconStateEnum{
+		ItemIconStateOpen,
+		ItemIconStateClosed,
+		ItemIconStateError,
+		ItemIconStateFetching0,
+		ItemIconStateFetching1,
+		ItemIconStateFetching2,
+	}
+}
+
+// ParseItemIconStateEnum parses s as an ItemIconStateEnum.
+func ParseItemIconStateEnum(s string) (ItemIconStateEnum, error) {
+	e := ItemIconStateEnum(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("%w: ItemIconStateEnum %q", ErrInvalidEnumValue, s)
+	}
+	return e, nil
+}
+
+// IsValid returns true if e is a valid ItemIconStateEnum.
+func (e ItemIconStateEnum) IsValid() bool {
+	for _, value := range ItemIconStateEnumValues() {
+		if e == value {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns e as a string.
+func (e ItemIconStateEnum) String() string {
+	return string(e)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (e *ItemIconStateEnum) UnmarshalText(text []byte) error {
+	value, err := ParseItemIconStateEnum(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
 // A ListItemTypeEnum is a listItemTypeEnumType.
 type ListItemTypeEnum string
 
@@ -68,6 +286,50 @@ const (
 	ListItemTypeCheckOffOnly      ListItemTypeEnum = "checkOffOnly"
 )
 
+// ListItemTypeEnumValues returns all ListItemTypeEnums.
+func ListItemTypeEnumValues() []ListItemTypeEnum {
+	return []ListItemTypeEnum{
+		ListItemTypeRadioFolder,
+		ListItemTypeCheck,
+		ListItemTypeCheckHideChildren,
+		ListItemTypeCheckOffOnly,
+	}
+}
+
+// ParseListItemTypeEnum parses s as a ListItemTypeEnum.
+func ParseListItemTypeEnum(s string) (ListItemTypeEnum, error) {
+	e := ListItemTypeEnum(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("%w: ListItemTypeEnum %q", ErrInvalidEnumValue, s)
+	}
+	return e, nil
+}
+
+// IsValid returns true if e is a valid ListItemTypeEnum.
+func (e ListItemTypeEnum) IsValid() bool {
+	for _, value := range ListItemTypeEnumValues() {
+		if e == value {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns e as a string.
+func (e ListItemTypeEnum) String() string {
+	return string(e)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (e *ListItemTypeEnum) UnmarshalText(text []byte) error {
+	value, err := ParseListItemTypeEnum(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
 // A RefreshModeEnum is a refreshModeEnumType.
 type RefreshModeEnum string
 
@@ -78,6 +340,49 @@ const (
 	RefreshModeOnExpire   RefreshModeEnum = "onExpire"
 )
 
+// RefreshModeEnumValues returns all RefreshModeEnums.
+func RefreshModeEnumValues() []RefreshModeEnum {
+	return []RefreshModeEnum{
+		RefreshModeOnChange,
+		RefreshModeOnInterval,
+		RefreshModeOnExpire,
+	}
+}
+
+// ParseRefreshModeEnum parses s as a RefreshModeEnum.
+func ParseRefreshModeEnum(s string) (RefreshModeEnum, error) {
+	e := RefreshModeEnum(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("%w: RefreshModeEnum %q", ErrInvalidEnumValue, s)
+	}
+	return e, nil
+}
+
+// IsValid returns true if e is a valid RefreshModeEnum.
+func (e RefreshModeEnum) IsValid() bool {
+	for _, value := range RefreshModeEnumValues() {
+		if e == value {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns e as a string.
+func (e RefreshModeEnum) String() string {
+	return string(e)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (e *RefreshModeEnum) UnmarshalText(text []byte) error {
+	value, err := ParseRefreshModeEnum(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
 // A ViewRefreshModeEnum is a viewRefreshModeEnumType.
 type ViewRefreshModeEnum string
 
@@ -89,6 +394,50 @@ const (
 	ViewRefreshModeOnRegion  ViewRefreshModeEnum = "onRegion"
 )
 
+// ViewRefreshModeEnumValues returns all ViewRefreshModeEnums.
+func ViewRefreshModeEnumValues() []ViewRefreshModeEnum {
+	return []ViewRefreshModeEnum{
+		ViewRefreshModeNever,
+		ViewRefreshModeOnRequest,
+		ViewRefreshModeOnStop,
+		ViewRefreshModeOnRegion,
+	}
+}
+
+// ParseViewRefreshModeEnum parses s as a ViewRefreshModeEnum.
+func ParseViewRefreshModeEnum(s string) (ViewRefreshModeEnum, error) {
+	e := ViewRefreshModeEnum(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("%w: ViewRefreshModeEnum %q", ErrInvalidEnumValue, s)
+	}
+	return e, nil
+}
+
+// IsValid returns true if e is a valid ViewRefreshModeEnum.
+func (e ViewRefreshModeEnum) IsValid() bool {
+	for _, value := range ViewRefreshModeEnumValues() {
+		if e == value {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns e as a string.
+func (e ViewRefreshModeEnum) String() string {
+	return string(e)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (e *ViewRefreshModeEnum) UnmarshalText(text []byte) error {
+	value, err := ParseViewRefreshModeEnum(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
 // A ShapeEnum is a shapeEnumType.
 type ShapeEnum string
 
@@ -99,6 +448,49 @@ const (
 	ShapeSphere    ShapeEnum = "sphere"
 )
 
+// ShapeEnumValues returns all ShapeEnums.
+func ShapeEnumValues() []ShapeEnum {
+	return []ShapeEnum{
+		ShapeRectangle,
+		ShapeCylinder,
+		ShapeSphere,
+	}
+}
+
+// ParseShapeEnum parses s as a ShapeEnum.
+func ParseShapeEnum(s string) (ShapeEnum, error) {
+	e := ShapeEnum(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("%w: ShapeEnum %q", ErrInvalidEnumValue, s)
+	}
+	return e, nil
+}
+
+// IsValid returns true if e is a valid ShapeEnum.
+func (e ShapeEnum) IsValid() bool {
+	for _, value := range ShapeEnumValues() {
+		if e == value {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns e as a string.
+func (e ShapeEnum) String() string {
+	return string(e)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (e *ShapeEnum) UnmarshalText(text []byte) error {
+	value, err := ParseShapeEnum(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
 // A StyleStateEnum is a styleStateEnumType.
 type StyleStateEnum string
 
@@ -108,6 +500,48 @@ const (
 	StyleStateHighlight StyleStateEnum = "highlight"
 )
 
+// StyleStateEnumValues returns all StyleStateEnums.
+func StyleStateEnumValues() []StyleStateEnum {
+	return []StyleStateEnum{
+		StyleStateNormal,
+		StyleStateHighlight,
+	}
+}
+
+// ParseStyleStateEnum parses s as a StyleStateEnum.
+func ParseStyleStateEnum(s string) (StyleStateEnum, error) {
+	e := StyleStateEnum(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("%w: StyleStateEnum %q", ErrInvalidEnumValue, s)
+	}
+	return e, nil
+}
+
+// IsValid returns true if e is a valid StyleStateEnum.
+func (e StyleStateEnum) IsValid() bool {
+	for _, value := range StyleStateEnumValues() {
+		if e == value {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns e as a string.
+func (e StyleStateEnum) String() string {
+	return string(e)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (e *StyleStateEnum) UnmarshalText(text []byte) error {
+	value, err := ParseStyleStateEnum(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
 // A UnitsEnum is a unitsEnumType.
 type UnitsEnum string
 
@@ -118,6 +552,49 @@ const (
 	UnitsInsetPixels UnitsEnum = "insetPixels"
 )
 
+// UnitsEnumValues returns all UnitsEnums.
+func UnitsEnumValues() []UnitsEnum {
+	return []UnitsEnum{
+		UnitsFraction,
+		UnitsPixels,
+		UnitsInsetPixels,
+	}
+}
+
+// ParseUnitsEnum parses s as an UnitsEnum.
+func ParseUnitsEnum(s string) (UnitsEnum, error) {
+	e := UnitsEnum(s)
+	if !e.IsValid() {
+		return "", fmt.Errorf("%w: UnitsEnum %q", ErrInvalidEnumValue, s)
+	}
+	return e, nil
+}
+
+// IsValid returns true if e is a valid UnitsEnum.
+func (e UnitsEnum) IsValid() bool {
+	for _, value := range UnitsEnumValues() {
+		if e == value {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns e as a string.
+func (e UnitsEnum) String() string {
+	return string(e)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (e *UnitsEnum) UnmarshalText(text []byte) error {
+	value, err := ParseUnitsEnum(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
 // Address returns a new address element.
 func Address(value string) *SimpleElement {
 	return newSEString("address", value)
@@ -138,6 +615,12 @@ func Begin(value time.Time) *SimpleElement {
 	return newSETime("begin", value)
 }
 
+// BeginKMLTime returns a new begin element with a value of
+// any precision.
+func BeginKMLTime(value KMLTime) *SimpleElement {
+	return newSEString("begin", value.String())
+}
+
 // BgColor returns a new bgColor element.
 func BgColor(value color.Color) *SimpleElement {
 	return newSEColor("bgColor", value)
@@ -193,11 +676,23 @@ func End(value time.Time) *SimpleElement {
 	return newSETime("end", value)
 }
 
+// EndKMLTime returns a new end element with a value of
+// any precision.
+func EndKMLTime(value KMLTime) *SimpleElement {
+	return newSEString("end", value.String())
+}
+
 // Expires returns a new expires element.
 func Expires(value time.Time) *SimpleElement {
 	return newSETime("expires", value)
 }
 
+// ExpiresKMLTime returns a new expires element with a value of
+// any precision.
+func ExpiresKMLTime(value KMLTime) *SimpleElement {
+	return newSEString("expires", value.String())
+}
+
 // Extrude returns a new extrude element.
 func Extrude(value bool) *SimpleElement {
 	return newSEBool("extrude", value)
@@ -263,6 +758,13 @@ func LinkName(value string) *SimpleElement {
 	return newSEString("linkName", value)
 }
 
+// LinkSnippet returns a new linkSnippet element.
+func LinkSnippet(maxLines int, value string) *SimpleElement {
+	return newSEAttr("linkSnippet", []xml.Attr{
+		{Name: xml.Name{Local: "maxLines"}, Value: strconv.Itoa(maxLines)},
+	}, value)
+}
+
 // ListItemType returns a new listItemType element.
 func ListItemType(value ListItemTypeEnum) *SimpleElement {
 	return newSEString("listItemType", string(value))
@@ -528,6 +1030,12 @@ func When(value time.Time) *SimpleElement {
 	return newSETime("when", value)
 }
 
+// WhenKMLTime returns a new when element with a value of
+// any precision.
+func WhenKMLTime(value KMLTime) *SimpleElement {
+	return newSEString("when", value.String())
+}
+
 // Width returns a new width element.
 func Width(value float64) *SimpleElement {
 	return newSEFloat("width", value)
@@ -553,11 +1061,31 @@ func LookAt(children ...Element) *CompoundElement {
 	return newCE("LookAt", children)
 }
 
+// SharedLookAt returns a new shared LookAt element.
+func SharedLookAt(id string, children ...Element) *SharedElement {
+	return newSharedE("LookAt", id, children)
+}
+
+// TargetLookAt returns a new LookAt element with a targetId.
+func TargetLookAt(targetID string, children ...Element) *CompoundElement {
+	return newTargetE("LookAt", targetID, children)
+}
+
 // Camera returns a new Camera element.
 func Camera(children ...Element) *CompoundElement {
 	return newCE("Camera", children)
 }
 
+// SharedCamera returns a new shared Camera element.
+func SharedCamera(id string, children ...Element) *SharedElement {
+	return newSharedE("Camera", id, children)
+}
+
+// TargetCamera returns a new Camera element with a targetId.
+func TargetCamera(targetID string, children ...Element) *CompoundElement {
+	return newTargetE("Camera", targetID, children)
+}
+
 // Metadata returns a new Metadata element.
 func Metadata(children ...Element) *CompoundElement {
 	return newCE("Metadata", children)
@@ -568,11 +1096,72 @@ func ExtendedData(children ...Element) *CompoundElement {
 	return newCE("ExtendedData", children)
 }
 
+// SchemaData returns a new SchemaData element.
+func SchemaData(schemaURL string, children ...Element) *CompoundElement {
+	return newCEAttr("SchemaData", []xml.Attr{
+		{Name: xml.Name{Local: "schemaUrl"}, Value: schemaURL},
+	}, children)
+}
+
+// SharedSchemaData returns a new shared SchemaData element.
+func SharedSchemaData(id, schemaURL string, children ...Element) *SharedElement {
+	return newSharedEAttr("SchemaData", id, []xml.Attr{
+		{Name: xml.Name{Local: "schemaUrl"}, Value: schemaURL},
+	}, children)
+}
+
+// TargetSchemaData returns a new SchemaData element with a targetId.
+func TargetSchemaData(targetID, schemaURL string, children ...Element) *CompoundElement {
+	return newCEAttr("SchemaData", []xml.Attr{
+		{Name: xml.Name{Local: "targetId"}, Value: targetID},
+		{Name: xml.Name{Local: "schemaUrl"}, Value: schemaURL},
+	}, children)
+}
+
+// SimpleData returns a new SimpleData element.
+func SimpleData(name, value string) *SimpleElement {
+	return newSEAttr("SimpleData", []xml.Attr{
+		{Name: xml.Name{Local: "name"}, Value: name},
+	}, value)
+}
+
+// DataWithName returns a new Data element.
+func DataWithName(name string, children ...Element) *CompoundElement {
+	return newCEAttr("Data", []xml.Attr{
+		{Name: xml.Name{Local: "name"}, Value: name},
+	}, children)
+}
+
+// SharedDataWithName returns a new shared Data element.
+func SharedDataWithName(id, name string, children ...Element) *SharedElement {
+	return newSharedEAttr("Data", id, []xml.Attr{
+		{Name: xml.Name{Local: "name"}, Value: name},
+	}, children)
+}
+
+// TargetDataWithName returns a new Data element with a targetId.
+func TargetDataWithName(targetID, name string, children ...Element) *CompoundElement {
+	return newCEAttr("Data", []xml.Attr{
+		{Name: xml.Name{Local: "targetId"}, Value: targetID},
+		{Name: xml.Name{Local: "name"}, Value: name},
+	}, children)
+}
+
 // Data returns a new Data element.
 func Data(children ...Element) *CompoundElement {
 	return newCE("Data", children)
 }
 
+// SharedData returns a new shared Data element.
+func SharedData(id string, children ...Element) *SharedElement {
+	return newSharedE("Data", id, children)
+}
+
+// TargetData returns a new Data element with a targetId.
+func TargetData(targetID string, children ...Element) *CompoundElement {
+	return newTargetE("Data", targetID, children)
+}
+
 // NetworkLinkControl returns a new NetworkLinkControl element.
 func NetworkLinkControl(children ...Element) *CompoundElement {
 	return newCE("NetworkLinkControl", children)
@@ -583,76 +1172,241 @@ func Document(children ...Element) *CompoundElement {
 	return newCE("Document", children)
 }
 
+// SharedDocument returns a new shared Document element.
+func SharedDocument(id string, children ...Element) *SharedElement {
+	return newSharedE("Document", id, children)
+}
+
+// TargetDocument returns a new Document element with a targetId.
+func TargetDocument(targetID string, children ...Element) *CompoundElement {
+	return newTargetE("Document", targetID, children)
+}
+
+// Schema returns a new Schema element.
+func Schema(id, name string, children ...Element) *SharedElement {
+	return newSharedEAttr("Schema", id, []xml.Attr{
+		{Name: xml.Name{Local: "name"}, Value: name},
+	}, children)
+}
+
+// SimpleField returns a new SimpleField element.
+func SimpleField(name, _type string, children ...Element) *CompoundElement {
+	return newCEAttr("SimpleField", []xml.Attr{
+		{Name: xml.Name{Local: "name"}, Value: name},
+		{Name: xml.Name{Local: "type"}, Value: _type},
+	}, children)
+}
+
 // Folder returns a new Folder element.
 func Folder(children ...Element) *CompoundElement {
 	return newCE("Folder", children)
 }
 
+// SharedFolder returns a new shared Folder element.
+func SharedFolder(id string, children ...Element) *SharedElement {
+	return newSharedE("Folder", id, children)
+}
+
+// TargetFolder returns a new Folder element with a targetId.
+func TargetFolder(targetID string, children ...Element) *CompoundElement {
+	return newTargetE("Folder", targetID, children)
+}
+
 // Placemark returns a new Placemark element.
 func Placemark(children ...Element) *CompoundElement {
 	return newCE("Placemark", children)
 }
 
+// SharedPlacemark returns a new shared Placemark element.
+func SharedPlacemark(id string, children ...Element) *SharedElement {
+	return newSharedE("Placemark", id, children)
+}
+
+// TargetPlacemark returns a new Placemark element with a targetId.
+func TargetPlacemark(targetID string, children ...Element) *CompoundElement {
+	return newTargetE("Placemark", targetID, children)
+}
+
 // NetworkLink returns a new NetworkLink element.
 func NetworkLink(children ...Element) *CompoundElement {
 	return newCE("NetworkLink", children)
 }
 
+// SharedNetworkLink returns a new shared NetworkLink element.
+func SharedNetworkLink(id string, children ...Element) *SharedElement {
+	return newSharedE("NetworkLink", id, children)
+}
+
+// TargetNetworkLink returns a new NetworkLink element with a targetId.
+func TargetNetworkLink(targetID string, children ...Element) *CompoundElement {
+	return newTargetE("NetworkLink", targetID, children)
+}
+
 // Region returns a new Region element.
 func Region(children ...Element) *CompoundElement {
 	return newCE("Region", children)
 }
 
+// SharedRegion returns a new shared Region element.
+func SharedRegion(id string, children ...Element) *SharedElement {
+	return newSharedE("Region", id, children)
+}
+
+// TargetRegion returns a new Region element with a targetId.
+func TargetRegion(targetID string, children ...Element) *CompoundElement {
+	return newTargetE("Region", targetID, children)
+}
+
 // LatLonAltBox returns a new LatLonAltBox element.
 func LatLonAltBox(children ...Element) *CompoundElement {
 	return newCE("LatLonAltBox", children)
 }
 
+// SharedLatLonAltBox returns a new shared LatLonAltBox element.
+func SharedLatLonAltBox(id string, children ...Element) *SharedElement {
+	return newSharedE("LatLonAltBox", id, children)
+}
+
+// TargetLatLonAltBox returns a new LatLonAltBox element with a targetId.
+func TargetLatLonAltBox(targetID string, children ...Element) *CompoundElement {
+	return newTargetE("LatLonAltBox", targetID, children)
+}
+
 // LOD returns a new Lod element.
 func LOD(children ...Element) *CompoundElement {
 	return newCE("Lod", children)
 }
 
+// SharedLOD returns a new shared Lod element.
+func SharedLOD(id string, children ...Element) *SharedElement {
+	return newSharedE("Lod", id, children)
+}
+
+// TargetLOD returns a new Lod element with a targetId.
+func TargetLOD(targetID string, children ...Element) *CompoundElement {
+	return newTargetE("Lod", targetID, children)
+}
+
 // Icon returns a new Icon element.
 func Icon(children ...Element) *CompoundElement {
 	return newCE("Icon", children)
 }
 
+// SharedIcon returns a new shared Icon element.
+func SharedIcon(id string, children ...Element) *SharedElement {
+	return newSharedE("Icon", id, children)
+}
+
+// TargetIcon returns a new Icon element with a targetId.
+func TargetIcon(targetID string, children ...Element) *CompoundElement {
+	return newTargetE("Icon", targetID, children)
+}
+
 // Link returns a new Link element.
 func Link(children ...Element) *CompoundElement {
 	return newCE("Link", children)
 }
 
+// SharedLink returns a new shared Link element.
+func SharedLink(id string, children ...Element) *SharedElement {
+	return newSharedE("Link", id, children)
+}
+
+// TargetLink returns a new Link element with a targetId.
+func TargetLink(targetID string, children ...Element) *CompoundElement {
+	return newTargetE("Link", targetID, children)
+}
+
 // URL returns a new Url element.
 func URL(children ...Element) *CompoundElement {
 	return newCE("Url", children)
 }
 
+// SharedURL returns a new shared Url element.
+func SharedURL(id string, children ...Element) *SharedElement {
+	return newSharedE("Url", id, children)
+}
+
+// TargetURL returns a new Url element with a targetId.
+func TargetURL(targetID string, children ...Element) *CompoundElement {
+	return newTargetE("Url", targetID, children)
+}
+
 // MultiGeometry returns a new MultiGeometry element.
 func MultiGeometry(children ...Element) *CompoundElement {
 	return newCE("MultiGeometry", children)
 }
 
+// SharedMultiGeometry returns a new shared MultiGeometry element.
+func SharedMultiGeometry(id string, children ...Element) *SharedElement {
+	return newSharedE("MultiGeometry", id, children)
+}
+
+// TargetMultiGeometry returns a new MultiGeometry element with a targetId.
+func TargetMultiGeometry(targetID string, children ...Element) *CompoundElement {
+	return newTargetE("MultiGeometry", targetID, children)
+}
+
 // Point returns a new Point element.
 func Point(children ...Element) *CompoundElement {
 	return newCE("Point", children)
 }
 
+// SharedPoint returns a new shared Point element.
+func SharedPoint(id string, children ...Element) *SharedElement {
+	return newSharedE("Point", id, children)
+}
+
+// TargetPoint returns a new Point element with a targetId.
+func TargetPoint(targetID string, children ...Element) *CompoundElement {
+	return newTargetE("Point", targetID, children)
+}
+
 // LineString returns a new LineString element.
 func LineString(children ...Element) *CompoundElement {
 	return newCE("LineString", children)
 }
 
+// SharedLineString returns a new shared LineString element.
+func SharedLineString(id string, children ...Element) *SharedElement {
+	return newSharedE("LineString", id, children)
+}
+
+// TargetLineString returns a new LineString element with a targetId.
+func TargetLineString(targetID string, children ...Element) *CompoundElement {
+	return newTargetE("LineString", targetID, children)
+}
+
 // LinearRing returns a new LinearRing element.
 func LinearRing(children ...Element) *CompoundElement {
 	return newCE("LinearRing", children)
 }
 
+// SharedLinearRing returns a new shared LinearRing element.
+func SharedLinearRing(id string, children ...Element) *SharedElement {
+	return newSharedE("LinearRing", id, children)
+}
+
+// TargetLinearRing returns a new LinearRing element with a targetId.
+func TargetLinearRing(targetID string, children ...Element) *CompoundElement {
+	return newTargetE("LinearRing", targetID, children)
+}
+
 // Polygon returns a new Polygon element.
 func Polygon(children ...Element) *CompoundElement {
 	return newCE("Polygon", children)
 }
 
+// SharedPolygon returns a new shared Polygon element.
+func SharedPolygon(id string, children ...Element) *SharedElement {
+	return newSharedE("Polygon", id, children)
+}
+
+// TargetPolygon returns a new Polygon element with a targetId.
+func TargetPolygon(targetID string, children ...Element) *CompoundElement {
+	return newTargetE("Polygon", targetID, children)
+}
+
 // OuterBoundaryIs returns a new outerBoundaryIs element.
 func OuterBoundaryIs(value Element) *CompoundElement {
 	return newSEElement("outerBoundaryIs", value)
@@ -668,116 +1422,361 @@ func Model(children ...Element) *CompoundElement {
 	return newCE("Model", children)
 }
 
+// SharedModel returns a new shared Model element.
+func SharedModel(id string, children ...Element) *SharedElement {
+	return newSharedE("Model", id, children)
+}
+
+// TargetModel returns a new Model element with a targetId.
+func TargetModel(targetID string, children ...Element) *CompoundElement {
+	return newTargetE("Model", targetID, children)
+}
+
 // Location returns a new Location element.
 func Location(children ...Element) *CompoundElement {
 	return newCE("Location", children)
 }
 
+// SharedLocation returns a new shared Location element.
+func SharedLocation(id string, children ...Element) *SharedElement {
+	return newSharedE("Location", id, children)
+}
+
+// TargetLocation returns a new Location element with a targetId.
+func TargetLocation(targetID string, children ...Element) *CompoundElement {
+	return newTargetE("Location", targetID, children)
+}
+
 // Orientation returns a new Orientation element.
 func Orientation(children ...Element) *CompoundElement {
 	return newCE("Orientation", children)
 }
 
+// SharedOrientation returns a new shared Orientation element.
+func SharedOrientation(id string, children ...Element) *SharedElement {
+	return newSharedE("Orientation", id, children)
+}
+
+// TargetOrientation returns a new Orientation element with a targetId.
+func TargetOrientation(targetID string, children ...Element) *CompoundElement {
+	return newTargetE("Orientation", targetID, children)
+}
+
+// ModelScale returns a new Scale element.
+func ModelScale(children ...Element) *CompoundElement {
+	return newCE("Scale", children)
+}
+
+// SharedModelScale returns a new shared Scale element.
+func SharedModelScale(id string, children ...Element) *SharedElement {
+	return newSharedE("Scale", id, children)
+}
+
+// TargetModelScale returns a new Scale element with a targetId.
+func TargetModelScale(targetID string, children ...Element) *CompoundElement {
+	return newTargetE("Scale", targetID, children)
+}
+
 // ResourceMap returns a new ResourceMap element.
 func ResourceMap(children ...Element) *CompoundElement {
 	return newCE("ResourceMap", children)
 }
 
+// SharedResourceMap returns a new shared ResourceMap element.
+func SharedResourceMap(id string, children ...Element) *SharedElement {
+	return newSharedE("ResourceMap", id, children)
+}
+
+// TargetResourceMap returns a new ResourceMap element with a targetId.
+func TargetResourceMap(targetID string, children ...Element) *CompoundElement {
+	return newTargetE("ResourceMap", targetID, children)
+}
+
 // Alias returns a new Alias element.
 func Alias(children ...Element) *CompoundElement {
 	return newCE("Alias", children)
 }
 
+// SharedAlias returns a new shared Alias element.
+func SharedAlias(id string, children ...Element) *SharedElement {
+	return newSharedE("Alias", id, children)
+}
+
+// TargetAlias returns a new Alias element with a targetId.
+func TargetAlias(targetID string, children ...Element) *CompoundElement {
+	return newTargetE("Alias", targetID, children)
+}
+
 // GroundOverlay returns a new GroundOverlay element.
 func GroundOverlay(children ...Element) *CompoundElement {
 	return newCE("GroundOverlay", children)
 }
 
+// SharedGroundOverlay returns a new shared GroundOverlay element.
+func SharedGroundOverlay(id string, children ...Element) *SharedElement {
+	return newSharedE("GroundOverlay", id, children)
+}
+
+// TargetGroundOverlay returns a new GroundOverlay element with a targetId.
+func TargetGroundOverlay(targetID string, children ...Element) *CompoundElement {
+	return newTargetE("GroundOverlay", targetID, children)
+}
+
 // LatLonBox returns a new LatLonBox element.
 func LatLonBox(children ...Element) *CompoundElement {
 	return newCE("LatLonBox", children)
 }
 
+// SharedLatLonBox returns a new shared LatLonBox element.
+func SharedLatLonBox(id string, children ...Element) *SharedElement {
+	return newSharedE("LatLonBox", id, children)
+}
+
+// TargetLatLonBox returns a new LatLonBox element with a targetId.
+func TargetLatLonBox(targetID string, children ...Element) *CompoundElement {
+	return newTargetE("LatLonBox", targetID, children)
+}
+
 // ScreenOverlay returns a new ScreenOverlay element.
 func ScreenOverlay(children ...Element) *CompoundElement {
 	return newCE("ScreenOverlay", children)
 }
 
+// SharedScreenOverlay returns a new shared ScreenOverlay element.
+func SharedScreenOverlay(id string, children ...Element) *SharedElement {
+	return newSharedE("ScreenOverlay", id, children)
+}
+
+// TargetScreenOverlay returns a new ScreenOverlay element with a targetId.
+func TargetScreenOverlay(targetID string, children ...Element) *CompoundElement {
+	return newTargetE("ScreenOverlay", targetID, children)
+}
+
 // PhotoOverlay returns a new PhotoOverlay element.
 func PhotoOverlay(children ...Element) *CompoundElement {
 	return newCE("PhotoOverlay", children)
 }
 
+// SharedPhotoOverlay returns a new shared PhotoOverlay element.
+func SharedPhotoOverlay(id string, children ...Element) *SharedElement {
+	return newSharedE("PhotoOverlay", id, children)
+}
+
+// TargetPhotoOverlay returns a new PhotoOverlay element with a targetId.
+func TargetPhotoOverlay(targetID string, children ...Element) *CompoundElement {
+	return newTargetE("PhotoOverlay", targetID, children)
+}
+
 // ViewVolume returns a new ViewVolume element.
 func ViewVolume(children ...Element) *CompoundElement {
 	return newCE("ViewVolume", children)
 }
 
+// SharedViewVolume returns a new shared ViewVolume element.
+func SharedViewVolume(id string, children ...Element) *SharedElement {
+	return newSharedE("ViewVolume", id, children)
+}
+
+// TargetViewVolume returns a new ViewVolume element with a targetId.
+func TargetViewVolume(targetID string, children ...Element) *CompoundElement {
+	return newTargetE("ViewVolume", targetID, children)
+}
+
 // ImagePyramid returns a new ImagePyramid element.
 func ImagePyramid(children ...Element) *CompoundElement {
 	return newCE("ImagePyramid", children)
 }
 
+// SharedImagePyramid returns a new shared ImagePyramid element.
+func SharedImagePyramid(id string, children ...Element) *SharedElement {
+	return newSharedE("ImagePyramid", id, children)
+}
+
+// TargetImagePyramid returns a new ImagePyramid element with a targetId.
+func TargetImagePyramid(targetID string, children ...Element) *CompoundElement {
+	return newTargetE("ImagePyramid", targetID, children)
+}
+
 // Style returns a new Style element.
 func Style(children ...Element) *CompoundElement {
 	return newCE("Style", children)
 }
 
+// SharedStyle returns a new shared Style element.
+func SharedStyle(id string, children ...Element) *SharedElement {
+	return newSharedE("Style", id, children)
+}
+
+// TargetStyle returns a new Style element with a targetId.
+func TargetStyle(targetID string, children ...Element) *CompoundElement {
+	return newTargetE("Style", targetID, children)
+}
+
 // StyleMap returns a new StyleMap element.
 func StyleMap(children ...Element) *CompoundElement {
 	return newCE("StyleMap", children)
 }
 
+// SharedStyleMap returns a new shared StyleMap element.
+func SharedStyleMap(id string, children ...Element) *SharedElement {
+	return newSharedE("StyleMap", id, children)
+}
+
+// TargetStyleMap returns a new StyleMap element with a targetId.
+func TargetStyleMap(targetID string, children ...Element) *CompoundElement {
+	return newTargetE("StyleMap", targetID, children)
+}
+
 // Pair returns a new Pair element.
 func Pair(children ...Element) *CompoundElement {
 	return newCE("Pair", children)
 }
 
+// SharedPair returns a new shared Pair element.
+func SharedPair(id string, children ...Element) *SharedElement {
+	return newSharedE("Pair", id, children)
+}
+
+// TargetPair returns a new Pair element with a targetId.
+func TargetPair(targetID string, children ...Element) *CompoundElement {
+	return newTargetE("Pair", targetID, children)
+}
+
 // IconStyle returns a new IconStyle element.
 func IconStyle(children ...Element) *CompoundElement {
 	return newCE("IconStyle", children)
 }
 
+// SharedIconStyle returns a new shared IconStyle element.
+func SharedIconStyle(id string, children ...Element) *SharedElement {
+	return newSharedE("IconStyle", id, children)
+}
+
+// TargetIconStyle returns a new IconStyle element with a targetId.
+func TargetIconStyle(targetID string, children ...Element) *CompoundElement {
+	return newTargetE("IconStyle", targetID, children)
+}
+
 // LabelStyle returns a new LabelStyle element.
 func LabelStyle(children ...Element) *CompoundElement {
 	return newCE("LabelStyle", children)
 }
 
+// SharedLabelStyle returns a new shared LabelStyle element.
+func SharedLabelStyle(id string, children ...Element) *SharedElement {
+	return newSharedE("LabelStyle", id, children)
+}
+
+// TargetLabelStyle returns a new LabelStyle element with a targetId.
+func TargetLabelStyle(targetID string, children ...Element) *CompoundElement {
+	return newTargetE("LabelStyle", targetID, children)
+}
+
 // LineStyle returns a new LineStyle element.
 func LineStyle(children ...Element) *CompoundElement {
 	return newCE("LineStyle", children)
 }
 
+// SharedLineStyle returns a new shared LineStyle element.
+func SharedLineStyle(id string, children ...Element) *SharedElement {
+	return newSharedE("LineStyle", id, children)
+}
+
+// TargetLineStyle returns a new LineStyle element with a targetId.
+func TargetLineStyle(targetID string, children ...Element) *CompoundElement {
+	return newTargetE("LineStyle", targetID, children)
+}
+
 // PolyStyle returns a new PolyStyle element.
 func PolyStyle(children ...Element) *CompoundElement {
 	return newCE("PolyStyle", children)
 }
 
+// SharedPolyStyle returns a new shared PolyStyle element.
+func SharedPolyStyle(id string, children ...Element) *SharedElement {
+	return newSharedE("PolyStyle", id, children)
+}
+
+// TargetPolyStyle returns a new PolyStyle element with a targetId.
+func TargetPolyStyle(targetID string, children ...Element) *CompoundElement {
+	return newTargetE("PolyStyle", targetID, children)
+}
+
 // BalloonStyle returns a new BalloonStyle element.
 func BalloonStyle(children ...Element) *CompoundElement {
 	return newCE("BalloonStyle", children)
 }
 
+// SharedBalloonStyle returns a new shared BalloonStyle element.
+func SharedBalloonStyle(id string, children ...Element) *SharedElement {
+	return newSharedE("BalloonStyle", id, children)
+}
+
+// TargetBalloonStyle returns a new BalloonStyle element with a targetId.
+func TargetBalloonStyle(targetID string, children ...Element) *CompoundElement {
+	return newTargetE("BalloonStyle", targetID, children)
+}
+
 // ListStyle returns a new ListStyle element.
 func ListStyle(children ...Element) *CompoundElement {
 	return newCE("ListStyle", children)
 }
 
+// SharedListStyle returns a new shared ListStyle element.
+func SharedListStyle(id string, children ...Element) *SharedElement {
+	return newSharedE("ListStyle", id, children)
+}
+
+// TargetListStyle returns a new ListStyle element with a targetId.
+func TargetListStyle(targetID string, children ...Element) *CompoundElement {
+	return newTargetE("ListStyle", targetID, children)
+}
+
 // ItemIcon returns a new ItemIcon element.
 func ItemIcon(children ...Element) *CompoundElement {
 	return newCE("ItemIcon", children)
 }
 
+// SharedItemIcon returns a new shared ItemIcon element.
+func SharedItemIcon(id string, children ...Element) *SharedElement {
+	return newSharedE("ItemIcon", id, children)
+}
+
+// TargetItemIcon returns a new ItemIcon element with a targetId.
+func TargetItemIcon(targetID string, children ...Element) *CompoundElement {
+	return newTargetE("ItemIcon", targetID, children)
+}
+
 // TimeStamp returns a new TimeStamp element.
 func TimeStamp(children ...Element) *CompoundElement {
 	return newCE("TimeStamp", children)
 }
 
+// SharedTimeStamp returns a new shared TimeStamp element.
+func SharedTimeStamp(id string, children ...Element) *SharedElement {
+	return newSharedE("TimeStamp", id, children)
+}
+
+// TargetTimeStamp returns a new TimeStamp element with a targetId.
+func TargetTimeStamp(targetID string, children ...Element) *CompoundElement {
+	return newTargetE("TimeStamp", targetID, children)
+}
+
 // TimeSpan returns a new TimeSpan element.
 func TimeSpan(children ...Element) *CompoundElement {
 	return newCE("TimeSpan", children)
 }
 
+// SharedTimeSpan returns a new shared TimeSpan element.
+func SharedTimeSpan(id string, children ...Element) *SharedElement {
+	return newSharedE("TimeSpan", id, children)
+}
+
+// TargetTimeSpan returns a new TimeSpan element with a targetId.
+func TargetTimeSpan(targetID string, children ...Element) *CompoundElement {
+	return newTargetE("TimeSpan", targetID, children)
+}
+
 // Update returns a new Update element.
 func Update(children ...Element) *CompoundElement {
 	return newCE("Update", children)
@@ -797,3 +1796,88 @@ func Delete(children ...Element) *CompoundElement {
 func Change(children ...Element) *CompoundElement {
 	return newCE("Change", children)
 }
+
+// simpleElementTypes maps simple element names to their types.
+var simpleElementTypes = map[string]simpleElementType{
+	"address":           {kind: simpleKindString},
+	"altitude":          {kind: simpleKindFloat},
+	"altitudeMode":      {kind: simpleKindString},
+	"begin":             {kind: simpleKindString},
+	"bgColor":           {kind: simpleKindColor},
+	"bottomFov":         {kind: simpleKindFloat},
+	"color":             {kind: simpleKindColor},
+	"colorMode":         {kind: simpleKindString},
+	"cookie":            {kind: simpleKindString},
+	"description":       {kind: simpleKindString},
+	"displayName":       {kind: simpleKindString},
+	"displayMode":       {kind: simpleKindString},
+	"drawOrder":         {kind: simpleKindInt},
+	"east":              {kind: simpleKindFloat},
+	"end":               {kind: simpleKindString},
+	"expires":           {kind: simpleKindString},
+	"extrude":           {kind: simpleKindBool},
+	"fill":              {kind: simpleKindBool},
+	"flyToView":         {kind: simpleKindBool},
+	"gridOrigin":        {kind: simpleKindString},
+	"heading":           {kind: simpleKindFloat},
+	"href":              {kind: simpleKindString},
+	"httpQuery":         {kind: simpleKindString},
+	"key":               {kind: simpleKindString},
+	"latitude":          {kind: simpleKindFloat},
+	"leftFov":           {kind: simpleKindFloat},
+	"linkDescription":   {kind: simpleKindString},
+	"linkName":          {kind: simpleKindString},
+	"listItemType":      {kind: simpleKindString},
+	"longitude":         {kind: simpleKindFloat},
+	"maxSnippetLines":   {kind: simpleKindInt},
+	"maxSessionLength":  {kind: simpleKindFloat},
+	"message":           {kind: simpleKindString},
+	"minAltitude":       {kind: simpleKindFloat},
+	"minFadeExtent":     {kind: simpleKindFloat},
+	"minLodPixels":      {kind: simpleKindFloat},
+	"minRefreshPeriod":  {kind: simpleKindFloat},
+	"maxAltitude":       {kind: simpleKindFloat},
+	"maxFadeExtent":     {kind: simpleKindFloat},
+	"maxLodPixels":      {kind: simpleKindFloat},
+	"maxHeight":         {kind: simpleKindInt},
+	"maxWidth":          {kind: simpleKindInt},
+	"name":              {kind: simpleKindString},
+	"near":              {kind: simpleKindFloat},
+	"north":             {kind: simpleKindFloat},
+	"open":              {kind: simpleKindBool},
+	"outline":           {kind: simpleKindBool},
+	"phoneNumber":       {kind: simpleKindString},
+	"range":             {kind: simpleKindFloat},
+	"refreshMode":       {kind: simpleKindString},
+	"refreshInterval":   {kind: simpleKindFloat},
+	"refreshVisibility": {kind: simpleKindBool},
+	"rightFov":          {kind: simpleKindFloat},
+	"roll":              {kind: simpleKindFloat},
+	"rotation":          {kind: simpleKindFloat},
+	"scale":             {kind: simpleKindFloat},
+	"shape":             {kind: simpleKindString},
+	"south":             {kind: simpleKindFloat},
+	"sourceHref":        {kind: simpleKindString},
+	"snippet":           {kind: simpleKindString},
+	"state":             {kind: simpleKindString},
+	"styleUrl":          {kind: simpleKindString},
+	"targetHref":        {kind: simpleKindString},
+	"tessellate":        {kind: simpleKindBool},
+	"text":              {kind: simpleKindString},
+	"textColor":         {kind: simpleKindColor},
+	"tileSize":          {kind: simpleKindInt},
+	"tilt":              {kind: simpleKindFloat},
+	"topFov":            {kind: simpleKindFloat},
+	"value":             {kind: simpleKindString},
+	"viewBoundScale":    {kind: simpleKindFloat},
+	"viewFormat":        {kind: simpleKindString},
+	"viewRefreshMode":   {kind: simpleKindString},
+	"viewRefreshTime":   {kind: simpleKindFloat},
+	"visibility":        {kind: simpleKindBool},
+	"west":              {kind: simpleKindFloat},
+	"when":              {kind: simpleKindString},
+	"width":             {kind: simpleKindFloat},
+	"x":                 {kind: simpleKindFloat},
+	"y":                 {kind: simpleKindFloat},
+	"z":                 {kind: simpleKindFloat},
+}
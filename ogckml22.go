@@ -46,6 +46,11 @@ type CoordinatesFlatElement struct {
 	offset, end, stride, dim int
 }
 
+// Coordinates returns ce's coordinates.
+func (ce *CoordinatesElement) Coordinates() []Coordinate {
+	return ce.coordinates
+}
+
 // MarshalXML marshals ce to e. start is ignored.
 func (ce *CoordinatesElement) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if err := e.EncodeToken(coordinatesStartElement); err != nil {
@@ -160,84 +165,25 @@ func CoordinatesFlat(flatCoords []float64, offset, end, stride, dim int) *Coordi
 	}
 }
 
-// LinkSnippet returns a new linkSnippet element.
-func LinkSnippet(maxLines int, value string) *SimpleElement {
+// CDATA returns a copy of se whose value is written as a CDATA section.
+func CDATA(se *SimpleElement) *SimpleElement {
 	return &SimpleElement{
-		StartElement: xml.StartElement{
-			Name: xml.Name{Local: "linkSnippet"},
-			Attr: []xml.Attr{
-				{Name: xml.Name{Local: "maxLines"}, Value: strconv.Itoa(maxLines)},
-			},
-		},
-		value: value,
-	}
-}
-
-// Schema returns a new Schema element.
-func Schema(id, name string, children ...Element) *SharedElement {
-	return &SharedElement{
-		CompoundElement: CompoundElement{
-			StartElement: xml.StartElement{
-				Name: xml.Name{Local: "Schema"},
-				Attr: []xml.Attr{
-					{Name: xml.Name{Local: "id"}, Value: id},
-					{Name: xml.Name{Local: "name"}, Value: name},
-				},
-			},
-			children: children,
-		},
-		id: id,
-	}
-}
-
-// SchemaData returns a new SchemaData element.
-func SchemaData(schemaURL string, children ...Element) *CompoundElement {
-	return &CompoundElement{
-		StartElement: xml.StartElement{
-			Name: xml.Name{Local: "SchemaData"},
-			Attr: []xml.Attr{
-				{Name: xml.Name{Local: "schemaUrl"}, Value: schemaURL},
-			},
-		},
-		children: children,
+		StartElement: se.StartElement,
+		value:        se.value,
+		cdata:        true,
 	}
 }
 
-// SharedStyle returns a new shared Style element.
-func SharedStyle(id string, children ...Element) *SharedElement {
-	return newSharedE("Style", id, children)
-}
-
-// SharedStyleMap returns a new shared StyleMap element.
-func SharedStyleMap(id string, children ...Element) *SharedElement {
-	return newSharedE("StyleMap", id, children)
+// DescriptionCDATA returns a new description element whose value is written
+// as a CDATA section.
+func DescriptionCDATA(value string) *SimpleElement {
+	return newSECDATA("description", value)
 }
 
-// SimpleData returns a new SimpleData element.
-func SimpleData(name, value string) *SimpleElement {
-	return &SimpleElement{
-		StartElement: xml.StartElement{
-			Name: xml.Name{Local: "SimpleData"},
-			Attr: []xml.Attr{
-				{Name: xml.Name{Local: "name"}, Value: name},
-			},
-		},
-		value: value,
-	}
-}
-
-// SimpleField returns a new SimpleField element.
-func SimpleField(name, _type string, children ...Element) *CompoundElement {
-	return &CompoundElement{
-		StartElement: xml.StartElement{
-			Name: xml.Name{Local: "SimpleField"},
-			Attr: []xml.Attr{
-				{Name: xml.Name{Local: "name"}, Value: name},
-				{Name: xml.Name{Local: "type"}, Value: _type},
-			},
-		},
-		children: children,
-	}
+// TextCDATA returns a new text element whose value is written as a CDATA
+// section.
+func TextCDATA(value string) *SimpleElement {
+	return newSECDATA("text", value)
 }
 
 // KML returns a new kml element.
@@ -0,0 +1,169 @@
+package kml
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidKML is returned when parsing a document that is not KML.
+var ErrInvalidKML = errors.New("kml: invalid KML")
+
+// xmlNamespace is the namespace bound to the xml prefix.
+const xmlNamespace = "http://www.w3.org/XML/1998/namespace"
+
+// A parseFrame is an element that is being parsed.
+type parseFrame struct {
+	start    xml.StartElement
+	children []Element
+	text     strings.Builder
+}
+
+// A parser parses KML documents.
+type parser struct {
+	prefixes map[string]string
+}
+
+// Parse parses a KML document from r. The returned tree uses the same names
+// as the trees returned by this package's constructors: elements in the KML
+// 2.2 and 2.3 namespaces are unqualified and elements in the gx namespace
+// have a gx: prefix. Elements in other namespaces keep the prefix that the
+// document declares for them.
+//
+// Elements with children, and elements that are compound in the KML schema,
+// are returned as CompoundElements, or as SharedElements if they have an id.
+// coordinates elements are returned as CoordinatesElements. All other
+// elements are returned as SimpleElements. Comments and CDATA sections are
+// not preserved.
+func Parse(r io.Reader) (Element, error) {
+	p := &parser{
+		prefixes: map[string]string{
+			xmlNamespace: "xml",
+		},
+	}
+	d := xml.NewDecoder(r)
+	var stack []*parseFrame
+	for {
+		token, err := d.Token()
+		switch {
+		case errors.Is(err, io.EOF):
+			return nil, fmt.Errorf("%w: no root element", ErrInvalidKML)
+		case err != nil:
+			return nil, err
+		}
+		switch token := token.(type) {
+		case xml.StartElement:
+			stack = append(stack, &parseFrame{
+				start: p.startElement(token, len(stack) == 0),
+			})
+		case xml.CharData:
+			if len(stack) > 0 {
+				stack[len(stack)-1].text.Write(token)
+			}
+		case xml.EndElement:
+			frame := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			e := frame.element()
+			if len(stack) == 0 {
+				return e, nil
+			}
+			parent := stack[len(stack)-1]
+			parent.children = append(parent.children, e)
+		}
+	}
+}
+
+// startElement returns start with its name and attributes converted to the
+// names used by this package. root is true if start is the root element.
+func (p *parser) startElement(start xml.StartElement, root bool) xml.StartElement {
+	var attrs []xml.Attr
+	for _, attr := range start.Attr {
+		switch {
+		case attr.Name.Space == "xmlns":
+			switch attr.Value {
+			case Namespace, Namespace23:
+			case GxNamespace:
+				attrs = append(attrs, xml.Attr{Name: xml.Name{Local: "xmlns:gx"}, Value: GxNamespace})
+			default:
+				p.prefixes[attr.Value] = attr.Name.Local
+				attrs = append(attrs, xml.Attr{Name: xml.Name{Local: "xmlns:" + attr.Name.Local}, Value: attr.Value})
+			}
+		case attr.Name.Space == "" && attr.Name.Local == "xmlns":
+		default:
+			attrs = append(attrs, xml.Attr{Name: p.name(attr.Name), Value: attr.Value})
+		}
+	}
+	name := p.name(start.Name)
+	if root && name.Local == "kml" {
+		name.Space = Namespace
+	}
+	return xml.StartElement{Name: name, Attr: attrs}
+}
+
+// name returns name converted to the names used by this package.
+func (p *parser) name(name xml.Name) xml.Name {
+	switch name.Space {
+	case "", Namespace, Namespace23:
+		return xml.Name{Local: name.Local}
+	case GxNamespace:
+		return xml.Name{Local: "gx:" + name.Local}
+	}
+	if prefix, ok := p.prefixes[name.Space]; ok {
+		return xml.Name{Local: prefix + ":" + name.Local}
+	}
+	return name
+}
+
+// element returns the Element parsed from f.
+func (f *parseFrame) element() Element {
+	name := f.start.Name
+	if name.Space == "" && name.Local == "coordinates" && len(f.children) == 0 {
+		if coordinates, ok := parseCoordinates(f.text.String()); ok {
+			return Coordinates(coordinates...)
+		}
+	}
+	if len(f.children) == 0 && (name.Space != "" || !schemaElements[name.Local].compound) {
+		return &SimpleElement{
+			StartElement: f.start,
+			value:        f.text.String(),
+		}
+	}
+	ce := CompoundElement{
+		StartElement: f.start,
+		children:     f.children,
+	}
+	if name.Space == "" {
+		if id := attrValue(f.start, "id"); id != "" {
+			return &SharedElement{
+				CompoundElement: ce,
+				id:              id,
+			}
+		}
+	}
+	return &ce
+}
+
+// parseCoordinates parses the value of a coordinates element.
+func parseCoordinates(s string) ([]Coordinate, bool) {
+	fields := strings.Fields(s)
+	coordinates := make([]Coordinate, 0, len(fields))
+	for _, field := range fields {
+		values := strings.Split(field, ",")
+		if len(values) < 2 || len(values) > 3 {
+			return nil, false
+		}
+		var c [3]float64
+		for i, value := range values {
+			f, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return nil, false
+			}
+			c[i] = f
+		}
+		coordinates = append(coordinates, Coordinate{Lon: c[0], Lat: c[1], Alt: c[2]})
+	}
+	return coordinates, true
+}
@@ -0,0 +1,133 @@
+package kml
+
+import (
+	"bytes"
+	"encoding/xml"
+	"image/color"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		element Element
+	}{
+		{
+			name: "placemark",
+			element: KML(
+				Placemark(
+					Name("Simple placemark"),
+					Description("Attached to the ground."),
+					Point(
+						Coordinates(Coordinate{Lon: -122.0822035425683, Lat: 37.42228990140251}),
+					),
+				),
+			),
+		},
+		{
+			name: "shared_style",
+			element: KML(
+				Document(
+					SharedStyle("red", LineStyle(Color(color.RGBA{R: 255, A: 255}), Width(2))),
+					Placemark(
+						StyleURL("#red"),
+						LineString(Tessellate(true), Coordinates(Coordinate{Lon: 1, Lat: 2, Alt: 3}, Coordinate{Lon: 4, Lat: 5})),
+					),
+					Folder(),
+				),
+			),
+		},
+		{
+			name: "gx",
+			element: GxKML(
+				Placemark(
+					GxTrack(
+						When(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)),
+						GxCoord(Coordinate{Lon: 1, Lat: 2, Alt: 3}),
+					),
+				),
+			),
+		},
+		{
+			name: "attributes",
+			element: KML(
+				Placemark(
+					ExtendedData(
+						DataWithName("speed", Value("12")),
+						SchemaData("#track", SimpleData("name", "value")),
+					),
+				),
+			),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			b := &bytes.Buffer{}
+			require.NoError(t, tc.element.Write(b))
+			actual, err := Parse(b)
+			require.NoError(t, err)
+			assert.Equal(t, []string(nil), Diff(tc.element, actual))
+			actualBuilder := &strings.Builder{}
+			require.NoError(t, actual.Write(actualBuilder))
+			expectedBuilder := &strings.Builder{}
+			require.NoError(t, tc.element.Write(expectedBuilder))
+			assert.Equal(t, expectedBuilder.String(), actualBuilder.String())
+		})
+	}
+}
+
+func TestParseNames(t *testing.T) {
+	actual, err := Parse(strings.NewReader(`<?xml version="1.0" encoding="UTF-8"?>
+<kml xmlns="http://www.opengis.net/kml/2.3" xmlns:ext="http://www.google.com/kml/ext/2.2" xmlns:atom="http://www.w3.org/2005/Atom">
+  <Document id="d">
+    <atom:author><atom:name>A</atom:name></atom:author>
+    <Placemark>
+      <ext:balloonVisibility>0</ext:balloonVisibility>
+      <Point><coordinates> 1,2,3
+      </coordinates></Point>
+    </Placemark>
+  </Document>
+</kml>`))
+	require.NoError(t, err)
+	expected := GxKML(
+		SharedDocument("d",
+			newCE("atom:author", []Element{
+				newSEString("atom:name", "A"),
+			}),
+			Placemark(
+				GxBalloonVisibility(false),
+				Point(Coordinates(Coordinate{Lon: 1, Lat: 2, Alt: 3})),
+			),
+		),
+	)
+	expected.Attr = append(expected.Attr, xml.Attr{Name: xml.Name{Local: "xmlns:atom"}, Value: "http://www.w3.org/2005/Atom"})
+	assert.Equal(t, []string(nil), Diff(expected, actual))
+	sharedDocument, ok := actual.(*CompoundElement).Children()[0].(*SharedElement)
+	require.True(t, ok)
+	assert.Equal(t, "#d", sharedDocument.URL())
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		s    string
+	}{
+		{
+			name: "empty",
+			s:    "",
+		},
+		{
+			name: "unclosed",
+			s:    "<kml><Document>",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Parse(strings.NewReader(tc.s))
+			assert.Error(t, err)
+		})
+	}
+}
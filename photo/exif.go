@@ -0,0 +1,333 @@
+package photo
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"image/jpeg"
+	"strings"
+	"time"
+)
+
+// Errors.
+var (
+	ErrNotJPEG     = errors.New("photo: not a JPEG")
+	ErrNoEXIF      = errors.New("photo: no EXIF data")
+	ErrInvalidEXIF = errors.New("photo: invalid EXIF data")
+)
+
+// TIFF tags.
+const (
+	tagOrientation           = 0x0112
+	tagExifIFD               = 0x8769
+	tagGPSIFD                = 0x8825
+	tagDateTimeOriginal      = 0x9003
+	tagFocalLength           = 0x920a
+	tagFocalPlaneXResolution = 0xa20e
+	tagFocalPlaneYResolution = 0xa20f
+	tagFocalPlaneUnit        = 0xa210
+	tagFocalLength35mm       = 0xa405
+	tagGPSLatitudeRef        = 0x0001
+	tagGPSLatitude           = 0x0002
+	tagGPSLongitudeRef       = 0x0003
+	tagGPSLongitude          = 0x0004
+	tagGPSAltitudeRef        = 0x0005
+	tagGPSAltitude           = 0x0006
+	tagGPSImgDirection       = 0x0011
+)
+
+// TIFF types.
+const (
+	typeByte      = 1
+	typeASCII     = 2
+	typeShort     = 3
+	typeLong      = 4
+	typeRational  = 5
+	typeUndefined = 7
+	typeSLong     = 9
+	typeSRational = 10
+)
+
+var typeSizes = map[uint16]int{
+	typeByte:      1,
+	typeASCII:     1,
+	typeShort:     2,
+	typeLong:      4,
+	typeRational:  8,
+	typeUndefined: 1,
+	typeSLong:     4,
+	typeSRational: 8,
+}
+
+// An EXIF contains the EXIF metadata of a JPEG that is relevant to
+// positioning it.
+type EXIF struct {
+	// Width and Height are the dimensions of the image as stored, in pixels.
+	Width, Height int
+	// Orientation is the EXIF orientation, from 1 to 8, or 0 if unknown.
+	Orientation int
+	// Time is the original date and time, in the camera's local time zone.
+	Time time.Time
+	// FocalLength is the focal length of the lens in millimeters.
+	FocalLength float64
+	// FocalLength35mm is the equivalent focal length for a 35mm film camera
+	// in millimeters.
+	FocalLength35mm float64
+	// FocalPlaneXResolution and FocalPlaneYResolution are the number of
+	// pixels per millimeter on the sensor.
+	FocalPlaneXResolution, FocalPlaneYResolution float64
+	// HasGPS is true if Lat and Lon are set.
+	HasGPS   bool
+	Lat, Lon float64
+	// HasAlt is true if Alt is set. Alt is in meters above sea level.
+	HasAlt bool
+	Alt    float64
+	// HasDirection is true if Direction is set. Direction is the direction
+	// in which the camera was pointing, in degrees.
+	HasDirection bool
+	Direction    float64
+}
+
+// An ifdEntry is an entry in a TIFF image file directory.
+type ifdEntry struct {
+	typ   uint16
+	count int
+	data  []byte
+}
+
+// A tiffReader reads TIFF structures.
+type tiffReader struct {
+	data  []byte
+	order binary.ByteOrder
+}
+
+// ReadEXIF reads the EXIF metadata from the JPEG in data.
+func ReadEXIF(data []byte) (*EXIF, error) {
+	config, err := jpeg.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrNotJPEG
+	}
+	tiff, err := exifSegment(data)
+	if err != nil {
+		return nil, err
+	}
+	r, err := newTIFFReader(tiff)
+	if err != nil {
+		return nil, err
+	}
+
+	e := &EXIF{
+		Width:  config.Width,
+		Height: config.Height,
+	}
+
+	ifd0, err := r.readIFD(int(r.order.Uint32(tiff[4:8])))
+	if err != nil {
+		return nil, err
+	}
+	if entry, ok := ifd0[tagOrientation]; ok {
+		e.Orientation = int(r.uint(entry, 0))
+	}
+
+	if entry, ok := ifd0[tagExifIFD]; ok {
+		exifIFD, err := r.readIFD(int(r.uint(entry, 0)))
+		if err != nil {
+			return nil, err
+		}
+		if entry, ok := exifIFD[tagDateTimeOriginal]; ok {
+			if t, err := time.Parse("2006:01:02 15:04:05", r.ascii(entry)); err == nil {
+				e.Time = t
+			}
+		}
+		if entry, ok := exifIFD[tagFocalLength]; ok {
+			e.FocalLength = r.rational(entry, 0)
+		}
+		if entry, ok := exifIFD[tagFocalLength35mm]; ok {
+			e.FocalLength35mm = float64(r.uint(entry, 0))
+		}
+		unit := 25.4 // Inches.
+		if entry, ok := exifIFD[tagFocalPlaneUnit]; ok && r.uint(entry, 0) == 3 {
+			unit = 10 // Centimeters.
+		}
+		if entry, ok := exifIFD[tagFocalPlaneXResolution]; ok {
+			e.FocalPlaneXResolution = r.rational(entry, 0) / unit
+		}
+		if entry, ok := exifIFD[tagFocalPlaneYResolution]; ok {
+			e.FocalPlaneYResolution = r.rational(entry, 0) / unit
+		}
+	}
+
+	if entry, ok := ifd0[tagGPSIFD]; ok {
+		gpsIFD, err := r.readIFD(int(r.uint(entry, 0)))
+		if err != nil {
+			return nil, err
+		}
+		lat, okLat := gpsIFD[tagGPSLatitude]
+		lon, okLon := gpsIFD[tagGPSLongitude]
+		if okLat && okLon && lat.count >= 3 && lon.count >= 3 {
+			e.HasGPS = true
+			e.Lat = r.degrees(lat)
+			if ref, ok := gpsIFD[tagGPSLatitudeRef]; ok && r.ascii(ref) == "S" {
+				e.Lat = -e.Lat
+			}
+			e.Lon = r.degrees(lon)
+			if ref, ok := gpsIFD[tagGPSLongitudeRef]; ok && r.ascii(ref) == "W" {
+				e.Lon = -e.Lon
+			}
+		}
+		if entry, ok := gpsIFD[tagGPSAltitude]; ok {
+			e.HasAlt = true
+			e.Alt = r.rational(entry, 0)
+			if ref, ok := gpsIFD[tagGPSAltitudeRef]; ok && r.uint(ref, 0) == 1 {
+				e.Alt = -e.Alt
+			}
+		}
+		if entry, ok := gpsIFD[tagGPSImgDirection]; ok {
+			e.HasDirection = true
+			e.Direction = r.rational(entry, 0)
+		}
+	}
+
+	return e, nil
+}
+
+// exifSegment returns the TIFF data in the EXIF APP1 segment of the JPEG in
+// data.
+func exifSegment(data []byte) ([]byte, error) {
+	if len(data) < 2 || data[0] != 0xff || data[1] != 0xd8 {
+		return nil, ErrNotJPEG
+	}
+	for i := 2; i+4 <= len(data); {
+		if data[i] != 0xff {
+			return nil, ErrNotJPEG
+		}
+		marker := data[i+1]
+		if marker == 0xd9 || marker == 0xda {
+			break
+		}
+		length := int(binary.BigEndian.Uint16(data[i+2 : i+4]))
+		if length < 2 || i+2+length > len(data) {
+			return nil, ErrNotJPEG
+		}
+		segment := data[i+4 : i+2+length]
+		if marker == 0xe1 && bytes.HasPrefix(segment, []byte("Exif\x00\x00")) {
+			return segment[6:], nil
+		}
+		i += 2 + length
+	}
+	return nil, ErrNoEXIF
+}
+
+// newTIFFReader returns a new tiffReader for data.
+func newTIFFReader(data []byte) (*tiffReader, error) {
+	if len(data) < 8 {
+		return nil, ErrInvalidEXIF
+	}
+	r := &tiffReader{
+		data: data,
+	}
+	switch string(data[:2]) {
+	case "II":
+		r.order = binary.LittleEndian
+	case "MM":
+		r.order = binary.BigEndian
+	default:
+		return nil, ErrInvalidEXIF
+	}
+	if r.order.Uint16(data[2:4]) != 42 {
+		return nil, ErrInvalidEXIF
+	}
+	return r, nil
+}
+
+// readIFD reads the image file directory at offset.
+func (r *tiffReader) readIFD(offset int) (map[uint16]ifdEntry, error) {
+	if offset < 0 || offset+2 > len(r.data) {
+		return nil, ErrInvalidEXIF
+	}
+	n := int(r.order.Uint16(r.data[offset:]))
+	if offset+2+12*n > len(r.data) {
+		return nil, ErrInvalidEXIF
+	}
+	entries := make(map[uint16]ifdEntry, n)
+	for i := 0; i < n; i++ {
+		b := r.data[offset+2+12*i : offset+2+12*(i+1)]
+		typ := r.order.Uint16(b[2:4])
+		size, ok := typeSizes[typ]
+		if !ok {
+			continue
+		}
+		count := int(r.order.Uint32(b[4:8]))
+		length := size * count
+		if count < 0 || length < 0 {
+			return nil, ErrInvalidEXIF
+		}
+		data := b[8:12]
+		if length > 4 {
+			valueOffset := int(r.order.Uint32(b[8:12]))
+			if valueOffset < 0 || valueOffset+length > len(r.data) {
+				return nil, ErrInvalidEXIF
+			}
+			data = r.data[valueOffset : valueOffset+length]
+		}
+		entries[r.order.Uint16(b[0:2])] = ifdEntry{
+			typ:   typ,
+			count: count,
+			data:  data[:length],
+		}
+	}
+	return entries, nil
+}
+
+// ascii returns the value of entry as a string.
+func (r *tiffReader) ascii(entry ifdEntry) string {
+	return strings.TrimRight(string(entry.data), "\x00 ")
+}
+
+// uint returns the ith value of entry as an unsigned integer.
+func (r *tiffReader) uint(entry ifdEntry, i int) uint32 {
+	if i >= entry.count {
+		return 0
+	}
+	switch entry.typ {
+	case typeByte, typeUndefined:
+		return uint32(entry.data[i])
+	case typeShort:
+		return uint32(r.order.Uint16(entry.data[2*i:]))
+	case typeLong, typeSLong:
+		return r.order.Uint32(entry.data[4*i:])
+	default:
+		return 0
+	}
+}
+
+// rational returns the ith value of entry as a float64.
+func (r *tiffReader) rational(entry ifdEntry, i int) float64 {
+	if i >= entry.count {
+		return 0
+	}
+	switch entry.typ {
+	case typeRational:
+		numerator := r.order.Uint32(entry.data[8*i:])
+		denominator := r.order.Uint32(entry.data[8*i+4:])
+		if denominator == 0 {
+			return 0
+		}
+		return float64(numerator) / float64(denominator)
+	case typeSRational:
+		numerator := int32(r.order.Uint32(entry.data[8*i:]))
+		denominator := int32(r.order.Uint32(entry.data[8*i+4:]))
+		if denominator == 0 {
+			return 0
+		}
+		return float64(numerator) / float64(denominator)
+	default:
+		return float64(r.uint(entry, i))
+	}
+}
+
+// degrees returns the value of entry, which contains degrees, minutes, and
+// seconds, in degrees.
+func (r *tiffReader) degrees(entry ifdEntry) float64 {
+	return r.rational(entry, 0) + r.rational(entry, 1)/60 + r.rational(entry, 2)/3600
+}
@@ -0,0 +1,204 @@
+// Package photo builds PhotoOverlays from JPEGs with EXIF metadata.
+//
+// See https://developers.google.com/kml/documentation/photos.
+package photo
+
+import (
+	"errors"
+	"io"
+	"math"
+	"path"
+
+	"github.com/twpayne/go-kml"
+	"github.com/twpayne/go-kml/kmz"
+)
+
+// Defaults.
+const (
+	// DefaultNear is the default distance from the camera to the image, in
+	// meters. It is small so that terrain does not hide the image.
+	DefaultNear = 10
+	// DefaultHeight is the default height of the camera above the ground, in
+	// meters, used when the EXIF metadata does not contain an altitude.
+	DefaultHeight = 2
+)
+
+// beforeView are the names of the children of features that come before the
+// AbstractView.
+var beforeView = map[string]bool{
+	"name":               true,
+	"visibility":         true,
+	"open":               true,
+	"atom:author":        true,
+	"atom:link":          true,
+	"address":            true,
+	"xal:AddressDetails": true,
+	"phoneNumber":        true,
+	"Snippet":            true,
+	"description":        true,
+}
+
+// diagonal35mm is the diagonal of a 35mm film frame, in millimeters.
+var diagonal35mm = math.Hypot(36, 24)
+
+// Errors.
+var (
+	ErrNoFocalLength = errors.New("photo: no focal length")
+	ErrNoGPS         = errors.New("photo: no GPS position")
+)
+
+// Options control how PhotoOverlays are built.
+type Options struct {
+	// Href is the href of the image.
+	Href string
+	// Near is the distance from the camera to the image, in meters. The
+	// default is DefaultNear.
+	Near float64
+	// Height is the height of the camera above the ground, in meters, used
+	// when the EXIF metadata does not contain an altitude. The default is
+	// DefaultHeight.
+	Height float64
+}
+
+// rotated returns true if the image must be rotated by 90 degrees to be
+// displayed.
+func (e *EXIF) rotated() bool {
+	return e.Orientation >= 5 && e.Orientation <= 8
+}
+
+// rotation returns the counter-clockwise rotation needed to display the
+// image, in degrees. Mirroring is ignored.
+func (e *EXIF) rotation() float64 {
+	switch e.Orientation {
+	case 3, 4:
+		return 180
+	case 5, 8:
+		return 90
+	case 6, 7:
+		return -90
+	default:
+		return 0
+	}
+}
+
+// FieldOfView returns the horizontal and vertical fields of view of the
+// displayed image, in degrees.
+func (e *EXIF) FieldOfView() (float64, float64, error) {
+	width, height := float64(e.Width), float64(e.Height)
+	var halfWidth, halfHeight float64
+	switch {
+	case e.FocalLength35mm > 0 && width > 0 && height > 0:
+		diagonal := math.Hypot(width, height)
+		halfWidth = diagonal35mm * width / diagonal / 2 / e.FocalLength35mm
+		halfHeight = diagonal35mm * height / diagonal / 2 / e.FocalLength35mm
+	case e.FocalLength > 0 && e.FocalPlaneXResolution > 0:
+		yResolution := e.FocalPlaneYResolution
+		if yResolution <= 0 {
+			yResolution = e.FocalPlaneXResolution
+		}
+		halfWidth = width / e.FocalPlaneXResolution / 2 / e.FocalLength
+		halfHeight = height / yResolution / 2 / e.FocalLength
+	default:
+		return 0, 0, ErrNoFocalLength
+	}
+	if e.rotated() {
+		halfWidth, halfHeight = halfHeight, halfWidth
+	}
+	return 2 * math.Atan(halfWidth) * 180 / math.Pi, 2 * math.Atan(halfHeight) * 180 / math.Pi, nil
+}
+
+// PhotoOverlay returns a new PhotoOverlay element containing children that
+// displays the image at options.Href from the position and direction in e.
+// The Camera and TimeStamp are inserted after the children that precede them
+// in the schema.
+func (e *EXIF) PhotoOverlay(options Options, children ...kml.Element) (*kml.CompoundElement, error) {
+	if !e.HasGPS {
+		return nil, ErrNoGPS
+	}
+	horizontalFOV, verticalFOV, err := e.FieldOfView()
+	if err != nil {
+		return nil, err
+	}
+	if options.Near == 0 {
+		options.Near = DefaultNear
+	}
+	if options.Height == 0 {
+		options.Height = DefaultHeight
+	}
+
+	altitude, altitudeMode := options.Height, kml.AltitudeModeRelativeToGround
+	if e.HasAlt {
+		altitude, altitudeMode = e.Alt, kml.AltitudeModeAbsolute
+	}
+	heading := 0.0
+	if e.HasDirection {
+		heading = e.Direction
+	}
+
+	n := 0
+	for n < len(children) {
+		start, _ := kml.StartElementOf(children[n])
+		if !beforeView[start.Name.Local] {
+			break
+		}
+		n++
+	}
+	overlayChildren := append([]kml.Element(nil), children[:n]...)
+	overlayChildren = append(overlayChildren, kml.Camera(
+		kml.Longitude(e.Lon),
+		kml.Latitude(e.Lat),
+		kml.Altitude(altitude),
+		kml.Heading(heading),
+		kml.Tilt(90),
+		kml.Roll(0),
+		kml.AltitudeMode(altitudeMode),
+	))
+	if !e.Time.IsZero() {
+		overlayChildren = append(overlayChildren, kml.TimeStamp(
+			kml.WhenKMLTime(kml.KMLTime{Time: e.Time, Precision: kml.TimePrecisionLocalDateTime}),
+		))
+	}
+	overlayChildren = append(overlayChildren, children[n:]...)
+	return kml.PhotoOverlay(append(overlayChildren,
+		kml.Icon(
+			kml.Href(options.Href),
+		),
+		kml.Rotation(e.rotation()),
+		kml.ViewVolume(
+			kml.LeftFOV(-horizontalFOV/2),
+			kml.RightFOV(horizontalFOV/2),
+			kml.BottomFOV(-verticalFOV/2),
+			kml.TopFOV(verticalFOV/2),
+			kml.Near(options.Near),
+		),
+		kml.Point(
+			kml.AltitudeMode(altitudeMode),
+			kml.Coordinates(kml.Coordinate{Lon: e.Lon, Lat: e.Lat, Alt: altitude}),
+		),
+		kml.Shape(kml.ShapeRectangle),
+	)...), nil
+}
+
+// PhotoOverlay returns a new PhotoOverlay element containing children that
+// displays the JPEG in data, positioned from its EXIF metadata.
+func PhotoOverlay(data []byte, options Options, children ...kml.Element) (*kml.CompoundElement, error) {
+	e, err := ReadEXIF(data)
+	if err != nil {
+		return nil, err
+	}
+	return e.PhotoOverlay(options, children...)
+}
+
+// WriteKMZ writes a KMZ archive to w containing a PhotoOverlay of the JPEG in
+// data and the JPEG itself as files/ followed by the base of filename.
+// options.Href is ignored.
+func WriteKMZ(w io.Writer, filename string, data []byte, options Options, children ...kml.Element) error {
+	options.Href = "files/" + path.Base(filename)
+	photoOverlay, err := PhotoOverlay(data, options, children...)
+	if err != nil {
+		return err
+	}
+	return kmz.Write(w, kml.KML(photoOverlay), map[string][]byte{
+		options.Href: data,
+	})
+}
@@ -0,0 +1,282 @@
+package photo
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/jpeg"
+	"io/ioutil"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/twpayne/go-kml"
+)
+
+type testEntry struct {
+	tag   uint16
+	typ   uint16
+	count int
+	data  []byte
+}
+
+func testShort(order binary.ByteOrder, tag, value uint16) testEntry {
+	data := make([]byte, 2)
+	order.PutUint16(data, value)
+	return testEntry{tag: tag, typ: typeShort, count: 1, data: data}
+}
+
+func testLong(order binary.ByteOrder, tag uint16, value uint32) testEntry {
+	data := make([]byte, 4)
+	order.PutUint32(data, value)
+	return testEntry{tag: tag, typ: typeLong, count: 1, data: data}
+}
+
+func testASCII(tag uint16, value string) testEntry {
+	return testEntry{tag: tag, typ: typeASCII, count: len(value) + 1, data: append([]byte(value), 0)}
+}
+
+func testByte(tag uint16, value byte) testEntry {
+	return testEntry{tag: tag, typ: typeByte, count: 1, data: []byte{value}}
+}
+
+func testRational(order binary.ByteOrder, tag uint16, values ...uint32) testEntry {
+	data := make([]byte, 4*len(values))
+	for i, value := range values {
+		order.PutUint32(data[4*i:], value)
+	}
+	return testEntry{tag: tag, typ: typeRational, count: len(values) / 2, data: data}
+}
+
+// appendTestIFD appends an IFD containing entries to tiff and returns the
+// result and the offset of the IFD.
+func appendTestIFD(tiff []byte, order binary.ByteOrder, entries []testEntry) ([]byte, uint32) {
+	offset := len(tiff)
+	dataOffset := offset + 2 + 12*len(entries) + 4
+	ifd := make([]byte, dataOffset-offset)
+	order.PutUint16(ifd, uint16(len(entries)))
+	var data []byte
+	for i, entry := range entries {
+		b := ifd[2+12*i:]
+		order.PutUint16(b[0:], entry.tag)
+		order.PutUint16(b[2:], entry.typ)
+		order.PutUint32(b[4:], uint32(entry.count))
+		if len(entry.data) <= 4 {
+			copy(b[8:12], entry.data)
+		} else {
+			order.PutUint32(b[8:], uint32(dataOffset+len(data)))
+			data = append(data, entry.data...)
+		}
+	}
+	return append(append(tiff, ifd...), data...), uint32(offset)
+}
+
+func testJPEG(t *testing.T, order binary.ByteOrder, ifd0, exifIFD, gpsIFD []testEntry) []byte {
+	tiff := make([]byte, 8)
+	if order == binary.LittleEndian {
+		copy(tiff, "II")
+	} else {
+		copy(tiff, "MM")
+	}
+	order.PutUint16(tiff[2:], 42)
+	if exifIFD != nil {
+		var offset uint32
+		tiff, offset = appendTestIFD(tiff, order, exifIFD)
+		ifd0 = append(ifd0, testLong(order, tagExifIFD, offset))
+	}
+	if gpsIFD != nil {
+		var offset uint32
+		tiff, offset = appendTestIFD(tiff, order, gpsIFD)
+		ifd0 = append(ifd0, testLong(order, tagGPSIFD, offset))
+	}
+	tiff, offset := appendTestIFD(tiff, order, ifd0)
+	order.PutUint32(tiff[4:], offset)
+
+	app1 := append([]byte("Exif\x00\x00"), tiff...)
+	segment := []byte{0xff, 0xe1, 0, 0}
+	binary.BigEndian.PutUint16(segment[2:], uint16(2+len(app1)))
+	segment = append(segment, app1...)
+
+	b := &bytes.Buffer{}
+	require.NoError(t, jpeg.Encode(b, image.NewGray(image.Rect(0, 0, 30, 20)), nil))
+	data := b.Bytes()
+	return append(append(append([]byte{}, data[:2]...), segment...), data[2:]...)
+}
+
+func TestReadEXIF(t *testing.T) {
+	for _, order := range []binary.ByteOrder{binary.BigEndian, binary.LittleEndian} {
+		t.Run(order.String(), func(t *testing.T) {
+			data := testJPEG(t, order,
+				[]testEntry{
+					testShort(order, tagOrientation, 6),
+				},
+				[]testEntry{
+					testASCII(tagDateTimeOriginal, "2026:07:16 10:56:03"),
+					testRational(order, tagFocalLength, 45, 10),
+					testShort(order, tagFocalLength35mm, 24),
+					testRational(order, tagFocalPlaneXResolution, 1000, 1),
+					testShort(order, tagFocalPlaneUnit, 3),
+				},
+				[]testEntry{
+					testASCII(tagGPSLatitudeRef, "N"),
+					testRational(order, tagGPSLatitude, 46, 1, 30, 1, 36, 1),
+					testASCII(tagGPSLongitudeRef, "W"),
+					testRational(order, tagGPSLongitude, 7, 1, 15, 1, 0, 1),
+					testByte(tagGPSAltitudeRef, 0),
+					testRational(order, tagGPSAltitude, 12345, 10),
+					testRational(order, tagGPSImgDirection, 90, 1),
+				},
+			)
+			e, err := ReadEXIF(data)
+			require.NoError(t, err)
+			assert.Equal(t, &EXIF{
+				Width:                 30,
+				Height:                20,
+				Orientation:           6,
+				Time:                  time.Date(2026, 7, 16, 10, 56, 3, 0, time.UTC),
+				FocalLength:           4.5,
+				FocalLength35mm:       24,
+				FocalPlaneXResolution: 100,
+				HasGPS:                true,
+				Lat:                   46.51,
+				Lon:                   -7.25,
+				HasAlt:                true,
+				Alt:                   1234.5,
+				HasDirection:          true,
+				Direction:             90,
+			}, e)
+		})
+	}
+}
+
+func TestReadEXIFErrors(t *testing.T) {
+	_, err := ReadEXIF([]byte("not a jpeg"))
+	assert.Equal(t, ErrNotJPEG, err)
+
+	b := &bytes.Buffer{}
+	require.NoError(t, jpeg.Encode(b, image.NewGray(image.Rect(0, 0, 1, 1)), nil))
+	_, err = ReadEXIF(b.Bytes())
+	assert.Equal(t, ErrNoEXIF, err)
+}
+
+func TestFieldOfView(t *testing.T) {
+	for i, tc := range []struct {
+		exif          EXIF
+		expectedErr   error
+		expectedHFOV  float64
+		expectedVFOV  float64
+		expectedDelta float64
+	}{
+		{
+			exif:         EXIF{Width: 3600, Height: 2400, FocalLength35mm: 18},
+			expectedHFOV: 90,
+			expectedVFOV: 2 * math.Atan(12.0/18) * 180 / math.Pi,
+		},
+		{
+			exif:         EXIF{Width: 3600, Height: 2400, Orientation: 6, FocalLength35mm: 18},
+			expectedHFOV: 2 * math.Atan(12.0/18) * 180 / math.Pi,
+			expectedVFOV: 90,
+		},
+		{
+			exif:         EXIF{Width: 4000, Height: 3000, FocalLength: 2, FocalPlaneXResolution: 1000},
+			expectedHFOV: 90,
+			expectedVFOV: 2 * math.Atan(0.75) * 180 / math.Pi,
+		},
+		{
+			exif:        EXIF{Width: 4000, Height: 3000},
+			expectedErr: ErrNoFocalLength,
+		},
+	} {
+		hfov, vfov, err := tc.exif.FieldOfView()
+		assert.Equal(t, tc.expectedErr, err, "test case %d", i)
+		assert.InDelta(t, tc.expectedHFOV, hfov, 1e-9, "test case %d", i)
+		assert.InDelta(t, tc.expectedVFOV, vfov, 1e-9, "test case %d", i)
+	}
+}
+
+func TestPhotoOverlay(t *testing.T) {
+	e := &EXIF{
+		Width:           3600,
+		Height:          2400,
+		Time:            time.Date(2026, 7, 16, 10, 56, 3, 0, time.UTC),
+		FocalLength35mm: 18,
+		HasGPS:          true,
+		Lat:             46.5,
+		Lon:             7.25,
+		HasDirection:    true,
+		Direction:       45,
+	}
+	actual, err := e.PhotoOverlay(Options{Href: "photo.jpg"}, kml.Name("photo"), kml.Description("d"), kml.StyleURL("#s"))
+	require.NoError(t, err)
+	vfov := 2 * math.Atan(12.0/18) * 180 / math.Pi
+	expected := kml.PhotoOverlay(
+		kml.Name("photo"),
+		kml.Description("d"),
+		kml.Camera(
+			kml.Longitude(7.25),
+			kml.Latitude(46.5),
+			kml.Altitude(DefaultHeight),
+			kml.Heading(45),
+			kml.Tilt(90),
+			kml.Roll(0),
+			kml.AltitudeMode(kml.AltitudeModeRelativeToGround),
+		),
+		kml.TimeStamp(
+			kml.WhenKMLTime(kml.KMLTime{Time: e.Time, Precision: kml.TimePrecisionLocalDateTime}),
+		),
+		kml.StyleURL("#s"),
+		kml.Icon(
+			kml.Href("photo.jpg"),
+		),
+		kml.Rotation(0),
+		kml.ViewVolume(
+			kml.LeftFOV(-45),
+			kml.RightFOV(45),
+			kml.BottomFOV(-vfov/2),
+			kml.TopFOV(vfov/2),
+			kml.Near(DefaultNear),
+		),
+		kml.Point(
+			kml.AltitudeMode(kml.AltitudeModeRelativeToGround),
+			kml.Coordinates(kml.Coordinate{Lon: 7.25, Lat: 46.5, Alt: DefaultHeight}),
+		),
+		kml.Shape(kml.ShapeRectangle),
+	)
+	assert.True(t, kml.Equal(expected, actual), "%v", kml.Diff(expected, actual))
+
+	_, err = (&EXIF{FocalLength35mm: 18}).PhotoOverlay(Options{})
+	assert.Equal(t, ErrNoGPS, err)
+}
+
+func TestWriteKMZ(t *testing.T) {
+	order := binary.BigEndian
+	data := testJPEG(t, order,
+		nil,
+		[]testEntry{
+			testShort(order, tagFocalLength35mm, 28),
+		},
+		[]testEntry{
+			testRational(order, tagGPSLatitude, 1, 1, 0, 1, 0, 1),
+			testRational(order, tagGPSLongitude, 2, 1, 0, 1, 0, 1),
+		},
+	)
+	b := &bytes.Buffer{}
+	require.NoError(t, WriteKMZ(b, "dir/photo.jpg", data, Options{}))
+
+	zr, err := zip.NewReader(bytes.NewReader(b.Bytes()), int64(b.Len()))
+	require.NoError(t, err)
+	require.Len(t, zr.File, 2)
+	assert.Equal(t, "doc.kml", zr.File[0].Name)
+	assert.Equal(t, "files/photo.jpg", zr.File[1].Name)
+
+	rc, err := zr.File[0].Open()
+	require.NoError(t, err)
+	defer rc.Close()
+	doc, err := ioutil.ReadAll(rc)
+	require.NoError(t, err)
+	assert.Contains(t, string(doc), "<href>files/photo.jpg</href>")
+}
@@ -0,0 +1,152 @@
+package kml
+
+import (
+	"encoding/xml"
+	"io"
+	"math"
+	"sort"
+	"strings"
+)
+
+// Namespace23 is the KML 2.3 namespace.
+const Namespace23 = "http://www.opengis.net/kml/2.3"
+
+// A Profile is a version of KML.
+type Profile int
+
+// Profiles.
+const (
+	// ProfileKML22 is KML 2.2 with Google extensions in the gx namespace.
+	ProfileKML22 Profile = iota
+	// ProfileKML23 is OGC KML 2.3 (OGC 12-007r2).
+	ProfileKML23
+)
+
+// ToProfile returns a copy of e, which must use ProfileKML22, that uses
+// profile. For ProfileKML23, the root kml element is moved to Namespace23
+// and Google extensions that were promoted to the core namespace, for
+// example gx:Track, are renamed, and children are reordered to match the KML
+// 2.3 schema, for example Orientation after coordinates in Point. Google
+// extensions without a KML 2.3
+// equivalent are left in the gx namespace, and the gx namespace declaration
+// is removed if no Google extensions remain.
+func ToProfile(e Element, profile Profile) Element {
+	if profile != ProfileKML23 {
+		return e
+	}
+	p := &profiler{}
+	result := p.kml23(e)
+	if !p.gx {
+		if ce := compoundElement(result); ce != nil && ce.Name.Local == "kml" {
+			attr := make([]xml.Attr, 0, len(ce.Attr))
+			for _, a := range ce.Attr {
+				if a.Name.Local != "xmlns:gx" {
+					attr = append(attr, a)
+				}
+			}
+			ce.Attr = attr
+		}
+	}
+	return result
+}
+
+// A profiler converts elements between profiles.
+type profiler struct {
+	gx bool
+}
+
+// kml23StartElement returns start converted to KML 2.3.
+func (p *profiler) kml23StartElement(start xml.StartElement) xml.StartElement {
+	result := xml.StartElement{
+		Name: start.Name,
+		Attr: append([]xml.Attr(nil), start.Attr...),
+	}
+	if name, ok := kml23Names[start.Name.Local]; ok {
+		result.Name.Local = name
+	} else if strings.HasPrefix(start.Name.Local, "gx:") {
+		p.gx = true
+	}
+	if result.Name.Space == Namespace {
+		result.Name.Space = Namespace23
+	}
+	return result
+}
+
+// kml23 returns e converted to KML 2.3.
+func (p *profiler) kml23(e Element) Element {
+	switch e := e.(type) {
+	case *SimpleElement:
+		return &SimpleElement{
+			StartElement: p.kml23StartElement(e.StartElement),
+			value:        e.value,
+			cdata:        e.cdata,
+		}
+	case *CompoundElement:
+		return p.kml23Compound(e)
+	case *SharedElement:
+		return &SharedElement{
+			CompoundElement: *p.kml23Compound(&e.CompoundElement),
+			id:              e.id,
+		}
+	default:
+		return e
+	}
+}
+
+// kml23Compound returns ce converted to KML 2.3.
+func (p *profiler) kml23Compound(ce *CompoundElement) *CompoundElement {
+	start := p.kml23StartElement(ce.StartElement)
+	children := make([]Element, 0, len(ce.children))
+	for _, child := range ce.children {
+		children = append(children, p.kml23(child))
+	}
+	if positions, ok := kml23Children[start.Name.Local]; ok {
+		sort.SliceStable(children, func(i, j int) bool {
+			return kml23Position(positions, children[i]) < kml23Position(positions, children[j])
+		})
+	}
+	return &CompoundElement{
+		StartElement: start,
+		children:     children,
+	}
+}
+
+// kml23Position returns the position of child in positions. Children that are
+// not in the schema are placed last.
+func kml23Position(positions map[string]int, child Element) int {
+	if position, ok := positions[elementName(child)]; ok {
+		return position
+	}
+	return math.MaxInt32
+}
+
+// An Encoder writes elements to an output stream using a profile.
+type Encoder struct {
+	w       io.Writer
+	prefix  string
+	indent  string
+	profile Profile
+}
+
+// NewEncoder returns a new Encoder that writes to w using ProfileKML22.
+func NewEncoder(w io.Writer) *Encoder {
+	return &Encoder{
+		w: w,
+	}
+}
+
+// Indent sets the encoder to indent each element with prefix and indent.
+func (enc *Encoder) Indent(prefix, indent string) {
+	enc.prefix = prefix
+	enc.indent = indent
+}
+
+// SetProfile sets the profile used by the encoder.
+func (enc *Encoder) SetProfile(profile Profile) {
+	enc.profile = profile
+}
+
+// Encode writes an XML header and e, converted to the encoder's profile.
+func (enc *Encoder) Encode(e Element) error {
+	return write(enc.w, enc.prefix, enc.indent, ToProfile(e, enc.profile))
+}
@@ -0,0 +1,146 @@
+package kml
+
+import (
+	"bytes"
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToProfile(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		element  Element
+		profile  Profile
+		expected string
+	}{
+		{
+			name: "kml22",
+			element: GxKML(
+				Placemark(
+					GxTrack(
+						When(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)),
+						GxCoord(Coordinate{Lon: 1, Lat: 2, Alt: 3}),
+					),
+				),
+			),
+			profile: ProfileKML22,
+			expected: `<?xml version="1.0" encoding="UTF-8"?>` + "\n" +
+				`<kml xmlns="http://www.opengis.net/kml/2.2" xmlns:gx="http://www.google.com/kml/ext/2.2">` +
+				`<Placemark><gx:Track><when>2026-01-02T03:04:05Z</when><gx:coord>1 2 3</gx:coord></gx:Track></Placemark>` +
+				`</kml>`,
+		},
+		{
+			name: "kml23_track",
+			element: GxKML(
+				SharedPlacemark("p1",
+					GxBalloonVisibility(true),
+					GxTrack(
+						GxAltitudeMode(GxAltitudeModeClampToSeaFloor),
+						When(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)),
+						GxCoord(Coordinate{Lon: 1, Lat: 2, Alt: 3}),
+						GxAngles(GxAngle{Heading: 4, Tilt: 5, Roll: 6}),
+					),
+				),
+			),
+			profile: ProfileKML23,
+			expected: `<?xml version="1.0" encoding="UTF-8"?>` + "\n" +
+				`<kml xmlns="http://www.opengis.net/kml/2.3">` +
+				`<Placemark id="p1"><balloonVisibility>1</balloonVisibility><Track>` +
+				`<altitudeMode>clampToSeaFloor</altitudeMode><when>2026-01-02T03:04:05Z</when>` +
+				`<coord>1 2 3</coord><angles>4 5 6</angles>` +
+				`</Track></Placemark>` +
+				`</kml>`,
+		},
+		{
+			name: "kml23_remaining_extensions",
+			element: GxKML(
+				Document(
+					Style(
+						LineStyle(
+							GxPhysicalWidth(2),
+						),
+					),
+					GxTour(
+						Name("tour"),
+						GxPlaylist(
+							GxWait(GxDuration(1)),
+						),
+					),
+				),
+			),
+			profile: ProfileKML23,
+			expected: `<?xml version="1.0" encoding="UTF-8"?>` + "\n" +
+				`<kml xmlns="http://www.opengis.net/kml/2.3" xmlns:gx="http://www.google.com/kml/ext/2.2">` +
+				`<Document><Style><LineStyle><gx:physicalWidth>2</gx:physicalWidth></LineStyle></Style>` +
+				`<Tour><name>tour</name><Playlist><Wait><duration>1</duration></Wait></Playlist></Tour></Document>` +
+				`</kml>`,
+		},
+		{
+			name: "kml23_order",
+			element: GxKML(
+				Placemark(
+					Point(
+						Coordinates(Coordinate{Lon: 1, Lat: 2}),
+						GxAltitudeOffset(3),
+						Extrude(true),
+					),
+					Region(
+						LOD(
+							&SimpleElement{StartElement: xml.StartElement{Name: xml.Name{Local: "Scale"}}, value: "2"},
+							MinLODPixels(128),
+						),
+					),
+				),
+			),
+			profile: ProfileKML23,
+			expected: `<?xml version="1.0" encoding="UTF-8"?>` + "\n" +
+				`<kml xmlns="http://www.opengis.net/kml/2.3">` +
+				`<Placemark><Region><Lod><minLodPixels>128</minLodPixels><Scale>2</Scale></Lod></Region>` +
+				`<Point><altitudeOffset>3</altitudeOffset><extrude>1</extrude><coordinates>1,2</coordinates></Point></Placemark>` +
+				`</kml>`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			b := &bytes.Buffer{}
+			require.NoError(t, ToProfile(tc.element, tc.profile).Write(b))
+			assert.Equal(t, tc.expected, b.String())
+		})
+	}
+}
+
+func TestToProfileDoesNotModify(t *testing.T) {
+	e := GxKML(GxTrack(GxCoord(Coordinate{Lon: 1, Lat: 2})))
+	before := &bytes.Buffer{}
+	require.NoError(t, e.Write(before))
+	ToProfile(e, ProfileKML23)
+	after := &bytes.Buffer{}
+	require.NoError(t, e.Write(after))
+	assert.Equal(t, before.String(), after.String())
+}
+
+func TestEncoder(t *testing.T) {
+	e := GxKML(Placemark(GxBalloonVisibility(false)))
+
+	b := &bytes.Buffer{}
+	require.NoError(t, NewEncoder(b).Encode(e))
+	assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>`+"\n"+
+		`<kml xmlns="http://www.opengis.net/kml/2.2" xmlns:gx="http://www.google.com/kml/ext/2.2">`+
+		`<Placemark><gx:balloonVisibility>0</gx:balloonVisibility></Placemark>`+
+		`</kml>`, b.String())
+
+	b.Reset()
+	enc := NewEncoder(b)
+	enc.Indent("", "  ")
+	enc.SetProfile(ProfileKML23)
+	require.NoError(t, enc.Encode(e))
+	assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>`+"\n"+
+		`<kml xmlns="http://www.opengis.net/kml/2.3">`+"\n"+
+		`  <Placemark>`+"\n"+
+		`    <balloonVisibility>0</balloonVisibility>`+"\n"+
+		`  </Placemark>`+"\n"+
+		`</kml>`, b.String())
+}
@@ -0,0 +1,1336 @@
+// Code generated by github.com/twpayne/go-kml/internal/generate. DO NOT EDIT.
+
+package kml
+
+// schemaElements maps the names of the concrete elements in the KML schema to
+// their descriptions.
+var schemaElements = map[string]schemaElement{
+	"Alias": {
+		compound: true,
+		children: []string{
+			"sourceHref",
+			"targetHref",
+		},
+	},
+	"BalloonStyle": {
+		compound: true,
+		children: []string{
+			"bgColor",
+			"color",
+			"displayMode",
+			"text",
+			"textColor",
+		},
+		defaults: map[string]string{
+			"bgColor":     "ffffffff",
+			"color":       "ffffffff",
+			"displayMode": "default",
+			"textColor":   "ff000000",
+		},
+	},
+	"Camera": {
+		compound: true,
+		children: []string{
+			"altitude",
+			"altitudeMode",
+			"gx:TimeSpan",
+			"gx:TimeStamp",
+			"gx:ViewerOptions",
+			"gx:altitudeMode",
+			"gx:horizFov",
+			"heading",
+			"latitude",
+			"longitude",
+			"roll",
+			"tilt",
+		},
+		defaults: map[string]string{
+			"altitude":     "0.0",
+			"altitudeMode": "clampToGround",
+			"heading":      "0.0",
+			"latitude":     "0.0",
+			"longitude":    "0.0",
+			"roll":         "0.0",
+			"tilt":         "0.0",
+		},
+	},
+	"Change": {
+		compound: true,
+		children: []string{
+			"Alias",
+			"BalloonStyle",
+			"Camera",
+			"Data",
+			"Document",
+			"Folder",
+			"GroundOverlay",
+			"Icon",
+			"IconStyle",
+			"ImagePyramid",
+			"ItemIcon",
+			"LabelStyle",
+			"LatLonAltBox",
+			"LatLonBox",
+			"LineString",
+			"LineStyle",
+			"LinearRing",
+			"Link",
+			"ListStyle",
+			"Location",
+			"Lod",
+			"LookAt",
+			"Model",
+			"MultiGeometry",
+			"NetworkLink",
+			"Orientation",
+			"Pair",
+			"PhotoOverlay",
+			"Placemark",
+			"Point",
+			"PolyStyle",
+			"Polygon",
+			"Region",
+			"ResourceMap",
+			"Scale",
+			"SchemaData",
+			"ScreenOverlay",
+			"Style",
+			"StyleMap",
+			"TimeSpan",
+			"TimeStamp",
+			"Url",
+			"ViewVolume",
+			"gx:AnimatedUpdate",
+			"gx:FlyTo",
+			"gx:LatLonQuad",
+			"gx:MultiTrack",
+			"gx:Playlist",
+			"gx:SoundCue",
+			"gx:TimeSpan",
+			"gx:TimeStamp",
+			"gx:Tour",
+			"gx:TourControl",
+			"gx:Track",
+			"gx:ViewerOptions",
+			"gx:Wait",
+		},
+	},
+	"Create": {
+		compound: true,
+		children: []string{
+			"Document",
+			"Folder",
+		},
+	},
+	"Data": {
+		compound: true,
+		children: []string{
+			"displayName",
+			"value",
+		},
+	},
+	"Delete": {
+		compound: true,
+		children: []string{
+			"Document",
+			"Folder",
+			"GroundOverlay",
+			"NetworkLink",
+			"PhotoOverlay",
+			"Placemark",
+			"ScreenOverlay",
+			"gx:Tour",
+		},
+	},
+	"Document": {
+		compound: true,
+		children: []string{
+			"Camera",
+			"Document",
+			"ExtendedData",
+			"Folder",
+			"GroundOverlay",
+			"LookAt",
+			"Metadata",
+			"NetworkLink",
+			"PhotoOverlay",
+			"Placemark",
+			"Region",
+			"Schema",
+			"ScreenOverlay",
+			"Snippet",
+			"Style",
+			"StyleMap",
+			"TimeSpan",
+			"TimeStamp",
+			"address",
+			"description",
+			"gx:Tour",
+			"gx:balloonVisibility",
+			"gx:rank",
+			"name",
+			"open",
+			"phoneNumber",
+			"snippet",
+			"styleUrl",
+			"visibility",
+		},
+		defaults: map[string]string{
+			"gx:balloonVisibility": "true",
+			"gx:rank":              "0.0",
+			"open":                 "0",
+			"visibility":           "1",
+		},
+	},
+	"ExtendedData": {
+		compound: true,
+		children: []string{
+			"Data",
+			"SchemaData",
+		},
+	},
+	"Folder": {
+		compound: true,
+		children: []string{
+			"Camera",
+			"Document",
+			"ExtendedData",
+			"Folder",
+			"GroundOverlay",
+			"LookAt",
+			"Metadata",
+			"NetworkLink",
+			"PhotoOverlay",
+			"Placemark",
+			"Region",
+			"ScreenOverlay",
+			"Snippet",
+			"Style",
+			"StyleMap",
+			"TimeSpan",
+			"TimeStamp",
+			"address",
+			"description",
+			"gx:Tour",
+			"gx:balloonVisibility",
+			"gx:rank",
+			"name",
+			"open",
+			"phoneNumber",
+			"snippet",
+			"styleUrl",
+			"visibility",
+		},
+		defaults: map[string]string{
+			"gx:balloonVisibility": "true",
+			"gx:rank":              "0.0",
+			"open":                 "0",
+			"visibility":           "1",
+		},
+	},
+	"GroundOverlay": {
+		compound: true,
+		children: []string{
+			"Camera",
+			"ExtendedData",
+			"Icon",
+			"LatLonBox",
+			"LookAt",
+			"Metadata",
+			"Region",
+			"Snippet",
+			"Style",
+			"StyleMap",
+			"TimeSpan",
+			"TimeStamp",
+			"address",
+			"altitude",
+			"altitudeMode",
+			"color",
+			"description",
+			"drawOrder",
+			"gx:LatLonQuad",
+			"gx:altitudeMode",
+			"gx:balloonVisibility",
+			"gx:rank",
+			"name",
+			"open",
+			"phoneNumber",
+			"snippet",
+			"styleUrl",
+			"visibility",
+		},
+		defaults: map[string]string{
+			"altitude":             "0.0",
+			"altitudeMode":         "clampToGround",
+			"color":                "ffffffff",
+			"drawOrder":            "0",
+			"gx:balloonVisibility": "true",
+			"gx:rank":              "0.0",
+			"open":                 "0",
+			"visibility":           "1",
+		},
+	},
+	"Icon": {
+		compound: true,
+		children: []string{
+			"gx:h",
+			"gx:w",
+			"gx:x",
+			"gx:y",
+			"href",
+			"httpQuery",
+			"refreshInterval",
+			"refreshMode",
+			"viewBoundScale",
+			"viewFormat",
+			"viewRefreshMode",
+			"viewRefreshTime",
+		},
+		defaults: map[string]string{
+			"gx:h":            "-1",
+			"gx:w":            "-1",
+			"gx:x":            "0",
+			"gx:y":            "0",
+			"refreshInterval": "4.0",
+			"refreshMode":     "onChange",
+			"viewBoundScale":  "1.0",
+			"viewRefreshMode": "never",
+			"viewRefreshTime": "4.0",
+		},
+	},
+	"IconStyle": {
+		compound: true,
+		children: []string{
+			"Icon",
+			"color",
+			"colorMode",
+			"gx:labelVisibility",
+			"heading",
+			"hotSpot",
+			"scale",
+		},
+		defaults: map[string]string{
+			"color":              "ffffffff",
+			"colorMode":          "normal",
+			"gx:labelVisibility": "0",
+			"heading":            "0.0",
+			"scale":              "1.0",
+		},
+	},
+	"ImagePyramid": {
+		compound: true,
+		children: []string{
+			"gridOrigin",
+			"maxHeight",
+			"maxWidth",
+			"tileSize",
+		},
+		defaults: map[string]string{
+			"gridOrigin": "lowerLeft",
+			"maxHeight":  "0",
+			"maxWidth":   "0",
+			"tileSize":   "256",
+		},
+	},
+	"ItemIcon": {
+		compound: true,
+		children: []string{
+			"href",
+			"state",
+		},
+	},
+	"LabelStyle": {
+		compound: true,
+		children: []string{
+			"color",
+			"colorMode",
+			"gx:labelVisibility",
+			"scale",
+		},
+		defaults: map[string]string{
+			"color":              "ffffffff",
+			"colorMode":          "normal",
+			"gx:labelVisibility": "0",
+			"scale":              "1.0",
+		},
+	},
+	"LatLonAltBox": {
+		compound: true,
+		children: []string{
+			"altitudeMode",
+			"east",
+			"gx:altitudeMode",
+			"maxAltitude",
+			"minAltitude",
+			"north",
+			"south",
+			"west",
+		},
+		defaults: map[string]string{
+			"altitudeMode": "clampToGround",
+			"east":         "180.0",
+			"maxAltitude":  "0.0",
+			"minAltitude":  "0.0",
+			"west":         "-180.0",
+		},
+	},
+	"LatLonBox": {
+		compound: true,
+		children: []string{
+			"east",
+			"north",
+			"rotation",
+			"south",
+			"west",
+		},
+		defaults: map[string]string{
+			"east":     "180.0",
+			"rotation": "0.0",
+			"west":     "-180.0",
+		},
+	},
+	"LineString": {
+		compound: true,
+		children: []string{
+			"altitudeMode",
+			"coordinates",
+			"extrude",
+			"gx:altitudeMode",
+			"gx:altitudeOffset",
+			"gx:drawOrder",
+			"tessellate",
+		},
+		defaults: map[string]string{
+			"altitudeMode": "clampToGround",
+			"extrude":      "0",
+			"gx:drawOrder": "0",
+			"tessellate":   "0",
+		},
+	},
+	"LineStyle": {
+		compound: true,
+		children: []string{
+			"color",
+			"colorMode",
+			"gx:labelVisibility",
+			"gx:outerColor",
+			"gx:outerWidth",
+			"gx:physicalWidth",
+			"width",
+		},
+		defaults: map[string]string{
+			"color":              "ffffffff",
+			"colorMode":          "normal",
+			"gx:labelVisibility": "0",
+			"gx:outerColor":      "ffffffff",
+			"gx:outerWidth":      "0.0",
+			"gx:physicalWidth":   "0.0",
+			"width":              "1.0",
+		},
+	},
+	"LinearRing": {
+		compound: true,
+		children: []string{
+			"altitudeMode",
+			"coordinates",
+			"extrude",
+			"gx:altitudeMode",
+			"gx:altitudeOffset",
+			"gx:drawOrder",
+			"tessellate",
+		},
+		defaults: map[string]string{
+			"altitudeMode": "clampToGround",
+			"extrude":      "0",
+			"gx:drawOrder": "0",
+			"tessellate":   "0",
+		},
+	},
+	"Link": {
+		compound: true,
+		children: []string{
+			"gx:h",
+			"gx:w",
+			"gx:x",
+			"gx:y",
+			"href",
+			"httpQuery",
+			"refreshInterval",
+			"refreshMode",
+			"viewBoundScale",
+			"viewFormat",
+			"viewRefreshMode",
+			"viewRefreshTime",
+		},
+		defaults: map[string]string{
+			"gx:h":            "-1",
+			"gx:w":            "-1",
+			"gx:x":            "0",
+			"gx:y":            "0",
+			"refreshInterval": "4.0",
+			"refreshMode":     "onChange",
+			"viewBoundScale":  "1.0",
+			"viewRefreshMode": "never",
+			"viewRefreshTime": "4.0",
+		},
+	},
+	"ListStyle": {
+		compound: true,
+		children: []string{
+			"ItemIcon",
+			"bgColor",
+			"listItemType",
+			"maxSnippetLines",
+		},
+		defaults: map[string]string{
+			"bgColor":         "ffffffff",
+			"listItemType":    "check",
+			"maxSnippetLines": "2",
+		},
+	},
+	"Location": {
+		compound: true,
+		children: []string{
+			"altitude",
+			"latitude",
+			"longitude",
+		},
+		defaults: map[string]string{
+			"altitude":  "0.0",
+			"latitude":  "0.0",
+			"longitude": "0.0",
+		},
+	},
+	"Lod": {
+		compound: true,
+		children: []string{
+			"maxFadeExtent",
+			"maxLodPixels",
+			"minFadeExtent",
+			"minLodPixels",
+		},
+		defaults: map[string]string{
+			"maxFadeExtent": "0.0",
+			"maxLodPixels":  "-1.0",
+			"minFadeExtent": "0.0",
+			"minLodPixels":  "0.0",
+		},
+	},
+	"LookAt": {
+		compound: true,
+		children: []string{
+			"altitude",
+			"altitudeMode",
+			"gx:TimeSpan",
+			"gx:TimeStamp",
+			"gx:ViewerOptions",
+			"gx:altitudeMode",
+			"gx:horizFov",
+			"heading",
+			"latitude",
+			"longitude",
+			"range",
+			"tilt",
+		},
+		defaults: map[string]string{
+			"altitude":     "0.0",
+			"altitudeMode": "clampToGround",
+			"heading":      "0.0",
+			"latitude":     "0.0",
+			"longitude":    "0.0",
+			"range":        "0.0",
+			"tilt":         "0.0",
+		},
+	},
+	"Metadata": {
+		compound: true,
+		children: []string{},
+	},
+	"Model": {
+		compound: true,
+		children: []string{
+			"Link",
+			"Location",
+			"Orientation",
+			"ResourceMap",
+			"Scale",
+			"altitudeMode",
+			"gx:altitudeMode",
+			"gx:altitudeOffset",
+			"gx:drawOrder",
+		},
+		defaults: map[string]string{
+			"altitudeMode": "clampToGround",
+			"gx:drawOrder": "0",
+		},
+	},
+	"MultiGeometry": {
+		compound: true,
+		children: []string{
+			"LineString",
+			"LinearRing",
+			"Model",
+			"MultiGeometry",
+			"Point",
+			"Polygon",
+			"gx:MultiTrack",
+			"gx:Track",
+			"gx:altitudeOffset",
+			"gx:drawOrder",
+		},
+		defaults: map[string]string{
+			"gx:drawOrder": "0",
+		},
+	},
+	"NetworkLink": {
+		compound: true,
+		children: []string{
+			"Camera",
+			"ExtendedData",
+			"Link",
+			"LookAt",
+			"Metadata",
+			"Region",
+			"Snippet",
+			"Style",
+			"StyleMap",
+			"TimeSpan",
+			"TimeStamp",
+			"Url",
+			"address",
+			"description",
+			"flyToView",
+			"gx:balloonVisibility",
+			"gx:rank",
+			"name",
+			"open",
+			"phoneNumber",
+			"refreshVisibility",
+			"snippet",
+			"styleUrl",
+			"visibility",
+		},
+		defaults: map[string]string{
+			"flyToView":            "0",
+			"gx:balloonVisibility": "true",
+			"gx:rank":              "0.0",
+			"open":                 "0",
+			"refreshVisibility":    "0",
+			"visibility":           "1",
+		},
+	},
+	"NetworkLinkControl": {
+		compound: true,
+		children: []string{
+			"Camera",
+			"LookAt",
+			"Update",
+			"cookie",
+			"expires",
+			"linkDescription",
+			"linkName",
+			"linkSnippet",
+			"maxSessionLength",
+			"message",
+			"minRefreshPeriod",
+		},
+		defaults: map[string]string{
+			"maxSessionLength": "-1.0",
+			"minRefreshPeriod": "0.0",
+		},
+	},
+	"Orientation": {
+		compound: true,
+		children: []string{
+			"heading",
+			"roll",
+			"tilt",
+		},
+		defaults: map[string]string{
+			"heading": "0.0",
+			"roll":    "0.0",
+			"tilt":    "0.0",
+		},
+	},
+	"Pair": {
+		compound: true,
+		children: []string{
+			"Style",
+			"StyleMap",
+			"key",
+			"styleUrl",
+		},
+	},
+	"PhotoOverlay": {
+		compound: true,
+		children: []string{
+			"Camera",
+			"ExtendedData",
+			"Icon",
+			"ImagePyramid",
+			"LookAt",
+			"Metadata",
+			"Point",
+			"Region",
+			"Snippet",
+			"Style",
+			"StyleMap",
+			"TimeSpan",
+			"TimeStamp",
+			"ViewVolume",
+			"address",
+			"color",
+			"description",
+			"drawOrder",
+			"gx:balloonVisibility",
+			"gx:rank",
+			"name",
+			"open",
+			"phoneNumber",
+			"rotation",
+			"shape",
+			"snippet",
+			"styleUrl",
+			"visibility",
+		},
+		defaults: map[string]string{
+			"color":                "ffffffff",
+			"drawOrder":            "0",
+			"gx:balloonVisibility": "true",
+			"gx:rank":              "0.0",
+			"open":                 "0",
+			"rotation":             "0.0",
+			"shape":                "rectangle",
+			"visibility":           "1",
+		},
+	},
+	"Placemark": {
+		compound: true,
+		children: []string{
+			"Camera",
+			"ExtendedData",
+			"LineString",
+			"LinearRing",
+			"LookAt",
+			"Metadata",
+			"Model",
+			"MultiGeometry",
+			"Point",
+			"Polygon",
+			"Region",
+			"Snippet",
+			"Style",
+			"StyleMap",
+			"TimeSpan",
+			"TimeStamp",
+			"address",
+			"description",
+			"gx:MultiTrack",
+			"gx:Track",
+			"gx:balloonVisibility",
+			"gx:rank",
+			"name",
+			"open",
+			"phoneNumber",
+			"snippet",
+			"styleUrl",
+			"visibility",
+		},
+		defaults: map[string]string{
+			"gx:balloonVisibility": "true",
+			"gx:rank":              "0.0",
+			"open":                 "0",
+			"visibility":           "1",
+		},
+	},
+	"Point": {
+		compound: true,
+		children: []string{
+			"altitudeMode",
+			"coordinates",
+			"extrude",
+			"gx:altitudeMode",
+			"gx:altitudeOffset",
+			"gx:drawOrder",
+		},
+		defaults: map[string]string{
+			"altitudeMode": "clampToGround",
+			"extrude":      "0",
+			"gx:drawOrder": "0",
+		},
+	},
+	"PolyStyle": {
+		compound: true,
+		children: []string{
+			"color",
+			"colorMode",
+			"fill",
+			"gx:labelVisibility",
+			"outline",
+		},
+		defaults: map[string]string{
+			"color":              "ffffffff",
+			"colorMode":          "normal",
+			"fill":               "1",
+			"gx:labelVisibility": "0",
+			"outline":            "1",
+		},
+	},
+	"Polygon": {
+		compound: true,
+		children: []string{
+			"altitudeMode",
+			"extrude",
+			"gx:altitudeMode",
+			"gx:altitudeOffset",
+			"gx:drawOrder",
+			"innerBoundaryIs",
+			"outerBoundaryIs",
+			"tessellate",
+		},
+		defaults: map[string]string{
+			"altitudeMode": "clampToGround",
+			"extrude":      "0",
+			"gx:drawOrder": "0",
+			"tessellate":   "0",
+		},
+	},
+	"Region": {
+		compound: true,
+		children: []string{
+			"LatLonAltBox",
+			"Lod",
+		},
+	},
+	"ResourceMap": {
+		compound: true,
+		children: []string{
+			"Alias",
+		},
+	},
+	"Scale": {
+		compound: true,
+		children: []string{
+			"x",
+			"y",
+			"z",
+		},
+		defaults: map[string]string{
+			"x": "1.0",
+			"y": "1.0",
+			"z": "1.0",
+		},
+	},
+	"Schema": {
+		compound: true,
+		children: []string{
+			"SimpleField",
+			"gx:SimpleArrayField",
+		},
+	},
+	"SchemaData": {
+		compound: true,
+		children: []string{
+			"SimpleData",
+			"gx:SimpleArrayData",
+		},
+	},
+	"ScreenOverlay": {
+		compound: true,
+		children: []string{
+			"Camera",
+			"ExtendedData",
+			"Icon",
+			"LookAt",
+			"Metadata",
+			"Region",
+			"Snippet",
+			"Style",
+			"StyleMap",
+			"TimeSpan",
+			"TimeStamp",
+			"address",
+			"color",
+			"description",
+			"drawOrder",
+			"gx:balloonVisibility",
+			"gx:rank",
+			"name",
+			"open",
+			"overlayXY",
+			"phoneNumber",
+			"rotation",
+			"rotationXY",
+			"screenXY",
+			"size",
+			"snippet",
+			"styleUrl",
+			"visibility",
+		},
+		defaults: map[string]string{
+			"color":                "ffffffff",
+			"drawOrder":            "0",
+			"gx:balloonVisibility": "true",
+			"gx:rank":              "0.0",
+			"open":                 "0",
+			"rotation":             "0.0",
+			"visibility":           "1",
+		},
+	},
+	"SimpleData": {},
+	"SimpleField": {
+		compound: true,
+		children: []string{
+			"displayName",
+		},
+	},
+	"Snippet": {},
+	"Style": {
+		compound: true,
+		children: []string{
+			"BalloonStyle",
+			"IconStyle",
+			"LabelStyle",
+			"LineStyle",
+			"ListStyle",
+			"PolyStyle",
+		},
+	},
+	"StyleMap": {
+		compound: true,
+		children: []string{
+			"Pair",
+		},
+	},
+	"TimeSpan": {
+		compound: true,
+		children: []string{
+			"begin",
+			"end",
+		},
+	},
+	"TimeStamp": {
+		compound: true,
+		children: []string{
+			"when",
+		},
+	},
+	"Update": {
+		compound: true,
+		children: []string{
+			"Change",
+			"Create",
+			"Delete",
+			"targetHref",
+		},
+	},
+	"Url": {
+		compound: true,
+		children: []string{
+			"gx:h",
+			"gx:w",
+			"gx:x",
+			"gx:y",
+			"href",
+			"httpQuery",
+			"refreshInterval",
+			"refreshMode",
+			"viewBoundScale",
+			"viewFormat",
+			"viewRefreshMode",
+			"viewRefreshTime",
+		},
+		defaults: map[string]string{
+			"gx:h":            "-1",
+			"gx:w":            "-1",
+			"gx:x":            "0",
+			"gx:y":            "0",
+			"refreshInterval": "4.0",
+			"refreshMode":     "onChange",
+			"viewBoundScale":  "1.0",
+			"viewRefreshMode": "never",
+			"viewRefreshTime": "4.0",
+		},
+	},
+	"ViewVolume": {
+		compound: true,
+		children: []string{
+			"bottomFov",
+			"leftFov",
+			"near",
+			"rightFov",
+			"topFov",
+		},
+		defaults: map[string]string{
+			"bottomFov": "0.0",
+			"leftFov":   "0.0",
+			"near":      "0.0",
+			"rightFov":  "0.0",
+			"topFov":    "0.0",
+		},
+	},
+	"address":  {},
+	"altitude": {},
+	"altitudeMode": {
+		valid: func(s string) bool {
+			return AltitudeModeEnum(s).IsValid()
+		},
+	},
+	"begin":     {},
+	"bgColor":   {},
+	"bottomFov": {},
+	"color":     {},
+	"colorMode": {
+		valid: func(s string) bool {
+			return ColorModeEnum(s).IsValid()
+		},
+	},
+	"cookie":      {},
+	"coordinates": {},
+	"description": {},
+	"displayMode": {
+		valid: func(s string) bool {
+			return DisplayModeEnum(s).IsValid()
+		},
+	},
+	"displayName": {},
+	"drawOrder":   {},
+	"east":        {},
+	"end":         {},
+	"expires":     {},
+	"extrude":     {},
+	"fill":        {},
+	"flyToView":   {},
+	"gridOrigin": {
+		valid: func(s string) bool {
+			return GridOriginEnum(s).IsValid()
+		},
+	},
+	"gx:AbstractTourPrimitive": {
+		compound: true,
+		children: []string{},
+	},
+	"gx:AnimatedUpdate": {
+		compound: true,
+		children: []string{
+			"Update",
+			"gx:delayedStart",
+			"gx:duration",
+		},
+		defaults: map[string]string{
+			"gx:delayedStart": "0.0",
+			"gx:duration":     "0.0",
+		},
+	},
+	"gx:FlyTo": {
+		compound: true,
+		children: []string{
+			"Camera",
+			"LookAt",
+			"gx:duration",
+			"gx:flyToMode",
+		},
+		defaults: map[string]string{
+			"gx:duration":  "0.0",
+			"gx:flyToMode": "bounce",
+		},
+	},
+	"gx:LatLonQuad": {
+		compound: true,
+		children: []string{
+			"coordinates",
+		},
+	},
+	"gx:MultiTrack": {
+		compound: true,
+		children: []string{
+			"altitudeMode",
+			"gx:Track",
+			"gx:altitudeMode",
+			"gx:altitudeOffset",
+			"gx:drawOrder",
+			"gx:interpolate",
+		},
+		defaults: map[string]string{
+			"altitudeMode":   "clampToGround",
+			"gx:drawOrder":   "0",
+			"gx:interpolate": "false",
+		},
+	},
+	"gx:Playlist": {
+		compound: true,
+		children: []string{
+			"gx:AnimatedUpdate",
+			"gx:FlyTo",
+			"gx:SoundCue",
+			"gx:TourControl",
+			"gx:Wait",
+		},
+	},
+	"gx:SimpleArrayData": {
+		compound: true,
+		children: []string{
+			"gx:value",
+		},
+	},
+	"gx:SimpleArrayField": {
+		compound: true,
+		children: []string{
+			"displayName",
+		},
+	},
+	"gx:SoundCue": {
+		compound: true,
+		children: []string{
+			"gx:delayedStart",
+			"href",
+		},
+		defaults: map[string]string{
+			"gx:delayedStart": "0.0",
+		},
+	},
+	"gx:TimeSpan": {
+		compound: true,
+		children: []string{
+			"begin",
+			"end",
+		},
+	},
+	"gx:TimeStamp": {
+		compound: true,
+		children: []string{
+			"when",
+		},
+	},
+	"gx:Tour": {
+		compound: true,
+		children: []string{
+			"Camera",
+			"ExtendedData",
+			"LookAt",
+			"Metadata",
+			"Region",
+			"Snippet",
+			"Style",
+			"StyleMap",
+			"TimeSpan",
+			"TimeStamp",
+			"address",
+			"description",
+			"gx:Playlist",
+			"gx:balloonVisibility",
+			"gx:rank",
+			"name",
+			"open",
+			"phoneNumber",
+			"snippet",
+			"styleUrl",
+			"visibility",
+		},
+		defaults: map[string]string{
+			"gx:balloonVisibility": "true",
+			"gx:rank":              "0.0",
+			"open":                 "0",
+			"visibility":           "1",
+		},
+	},
+	"gx:TourControl": {
+		compound: true,
+		children: []string{
+			"gx:playMode",
+		},
+		defaults: map[string]string{
+			"gx:playMode": "pause",
+		},
+	},
+	"gx:Track": {
+		compound: true,
+		children: []string{
+			"ExtendedData",
+			"Model",
+			"altitudeMode",
+			"extrude",
+			"gx:altitudeMode",
+			"gx:altitudeOffset",
+			"gx:angles",
+			"gx:coord",
+			"gx:drawOrder",
+			"tessellate",
+			"when",
+		},
+		defaults: map[string]string{
+			"altitudeMode": "clampToGround",
+			"extrude":      "0",
+			"gx:drawOrder": "0",
+			"tessellate":   "0",
+		},
+	},
+	"gx:ViewerOptions": {
+		compound: true,
+		children: []string{
+			"gx:option",
+		},
+	},
+	"gx:Wait": {
+		compound: true,
+		children: []string{
+			"gx:duration",
+		},
+		defaults: map[string]string{
+			"gx:duration": "0.0",
+		},
+	},
+	"gx:altitudeMode": {
+		valid: func(s string) bool {
+			return GxAltitudeModeEnum(s).IsValid()
+		},
+	},
+	"gx:altitudeOffset":    {},
+	"gx:angles":            {},
+	"gx:balloonVisibility": {},
+	"gx:coord":             {},
+	"gx:delayedStart":      {},
+	"gx:drawOrder":         {},
+	"gx:duration":          {},
+	"gx:flyToMode": {
+		valid: func(s string) bool {
+			return GxFlyToModeEnum(s).IsValid()
+		},
+	},
+	"gx:h":               {},
+	"gx:horizFov":        {},
+	"gx:interpolate":     {},
+	"gx:labelVisibility": {},
+	"gx:option":          {},
+	"gx:outerColor":      {},
+	"gx:outerWidth":      {},
+	"gx:physicalWidth":   {},
+	"gx:playMode": {
+		valid: func(s string) bool {
+			return GxPlayModeEnum(s).IsValid()
+		},
+	},
+	"gx:rank":   {},
+	"gx:value":  {},
+	"gx:w":      {},
+	"gx:x":      {},
+	"gx:y":      {},
+	"heading":   {},
+	"hotSpot":   {},
+	"href":      {},
+	"httpQuery": {},
+	"innerBoundaryIs": {
+		compound: true,
+		children: []string{
+			"LinearRing",
+		},
+	},
+	"key": {
+		valid: func(s string) bool {
+			return StyleStateEnum(s).IsValid()
+		},
+	},
+	"kml": {
+		compound: true,
+		children: []string{
+			"Document",
+			"Folder",
+			"GroundOverlay",
+			"NetworkLink",
+			"NetworkLinkControl",
+			"PhotoOverlay",
+			"Placemark",
+			"ScreenOverlay",
+			"gx:Tour",
+		},
+	},
+	"latitude":        {},
+	"leftFov":         {},
+	"linkDescription": {},
+	"linkName":        {},
+	"linkSnippet":     {},
+	"listItemType": {
+		valid: func(s string) bool {
+			return ListItemTypeEnum(s).IsValid()
+		},
+	},
+	"longitude":        {},
+	"maxAltitude":      {},
+	"maxFadeExtent":    {},
+	"maxHeight":        {},
+	"maxLodPixels":     {},
+	"maxSessionLength": {},
+	"maxSnippetLines":  {},
+	"maxWidth":         {},
+	"message":          {},
+	"minAltitude":      {},
+	"minFadeExtent":    {},
+	"minLodPixels":     {},
+	"minRefreshPeriod": {},
+	"name":             {},
+	"near":             {},
+	"north":            {},
+	"open":             {},
+	"outerBoundaryIs": {
+		compound: true,
+		children: []string{
+			"LinearRing",
+		},
+	},
+	"outline":         {},
+	"overlayXY":       {},
+	"phoneNumber":     {},
+	"range":           {},
+	"refreshInterval": {},
+	"refreshMode": {
+		valid: func(s string) bool {
+			return RefreshModeEnum(s).IsValid()
+		},
+	},
+	"refreshVisibility": {},
+	"rightFov":          {},
+	"roll":              {},
+	"rotation":          {},
+	"rotationXY":        {},
+	"scale":             {},
+	"screenXY":          {},
+	"shape": {
+		valid: func(s string) bool {
+			return ShapeEnum(s).IsValid()
+		},
+	},
+	"size":       {},
+	"snippet":    {},
+	"sourceHref": {},
+	"south":      {},
+	"state": {
+		valid: func(s string) bool {
+			return validList(s, func(s string) bool { return ItemIconStateEnum(s).IsValid() })
+		},
+	},
+	"styleUrl":       {},
+	"targetHref":     {},
+	"tessellate":     {},
+	"text":           {},
+	"textColor":      {},
+	"tileSize":       {},
+	"tilt":           {},
+	"topFov":         {},
+	"value":          {},
+	"viewBoundScale": {},
+	"viewFormat":     {},
+	"viewRefreshMode": {
+		valid: func(s string) bool {
+			return ViewRefreshModeEnum(s).IsValid()
+		},
+	},
+	"viewRefreshTime": {},
+	"visibility":      {},
+	"west":            {},
+	"when":            {},
+	"width":           {},
+	"x":               {},
+	"y":               {},
+	"z":               {},
+}
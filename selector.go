@@ -0,0 +1,306 @@
+package kml
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidSelector is returned when parsing an invalid selector.
+var ErrInvalidSelector = errors.New("kml: invalid selector")
+
+// A Match is an element matched by a selector.
+type Match struct {
+	Path    []Element // The ancestors of Element, root first.
+	Element Element
+}
+
+// A selectorPredicate is a condition on a child's value or an attribute.
+type selectorPredicate struct {
+	attr     bool
+	name     string
+	value    string
+	hasValue bool
+}
+
+// A selectorStep matches a single element.
+type selectorStep struct {
+	name       string
+	id         string
+	predicates []selectorPredicate
+	child      bool
+}
+
+// A selector is a sequence of steps, outermost first.
+type selector []selectorStep
+
+// Select returns the first element in root, in document order, that matches
+// selector, or nil if there is no such element. See SelectAll for the
+// selector syntax.
+func Select(root Element, selector string) (*Match, error) {
+	sel, err := parseSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+	var match *Match
+	errFound := errors.New("found")
+	if err := Walk(root, func(path []Element, e Element) error {
+		if sel.matches(path, e) {
+			match = &Match{Path: path, Element: e}
+			return errFound
+		}
+		return nil
+	}); err != nil && err != errFound {
+		return nil, err
+	}
+	return match, nil
+}
+
+// SelectAll returns all the elements in root, in document order, that match
+// selector.
+//
+// A selector is a sequence of element selectors separated by combinators. A
+// space matches descendants and > matches children. An element selector is
+// an element name, for example Placemark or gx:Track, or * for any element,
+// optionally followed by #id to match the element's id and by any number of
+// predicates in square brackets. [name] matches elements that have a child
+// called name and [name=value] matches elements with a child called name
+// whose trimmed value is value. [@name] and [@name=value] match attributes.
+// Values may be quoted with single or double quotes. For example:
+//
+//	Folder[name=Turnpoints] > Placemark
+//	Placemark[styleUrl="#red"]
+//	SimpleData[@name=speed]
+//	Document > Style#red LineStyle
+func SelectAll(root Element, selector string) ([]Match, error) {
+	sel, err := parseSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+	var matches []Match
+	if err := Walk(root, func(path []Element, e Element) error {
+		if sel.matches(path, e) {
+			matches = append(matches, Match{Path: path, Element: e})
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return matches, nil
+}
+
+// parseSelector parses s.
+func parseSelector(s string) (selector, error) {
+	p := &selectorParser{s: s}
+	var sel selector
+	p.skipSpace()
+	for p.pos < len(p.s) {
+		child := false
+		if len(sel) > 0 {
+			spaces := p.skipSpace()
+			if p.pos == len(p.s) {
+				break
+			}
+			if p.peek() == '>' {
+				p.pos++
+				p.skipSpace()
+				child = true
+			} else if !spaces {
+				return nil, p.errorf("expected combinator")
+			}
+		}
+		step, err := p.step()
+		if err != nil {
+			return nil, err
+		}
+		step.child = child
+		sel = append(sel, step)
+	}
+	if len(sel) == 0 {
+		return nil, fmt.Errorf("%w: empty selector", ErrInvalidSelector)
+	}
+	return sel, nil
+}
+
+// A selectorParser parses selectors.
+type selectorParser struct {
+	s   string
+	pos int
+}
+
+// errorf returns an error at the current position.
+func (p *selectorParser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: %q: offset %d: %s", ErrInvalidSelector, p.s, p.pos, fmt.Sprintf(format, args...))
+}
+
+// peek returns the next byte, or zero at the end of the selector.
+func (p *selectorParser) peek() byte {
+	if p.pos < len(p.s) {
+		return p.s[p.pos]
+	}
+	return 0
+}
+
+// skipSpace skips whitespace and returns true if any was skipped.
+func (p *selectorParser) skipSpace() bool {
+	start := p.pos
+	for p.pos < len(p.s) && strings.IndexByte(" \t\n\r", p.s[p.pos]) >= 0 {
+		p.pos++
+	}
+	return p.pos > start
+}
+
+// name parses a name.
+func (p *selectorParser) name() string {
+	start := p.pos
+	for p.pos < len(p.s) {
+		c := p.s[p.pos]
+		if !('a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' || strings.IndexByte(":_-.", c) >= 0) {
+			break
+		}
+		p.pos++
+	}
+	return p.s[start:p.pos]
+}
+
+// value parses a possibly-quoted value.
+func (p *selectorParser) value() (string, error) {
+	if quote := p.peek(); quote == '"' || quote == '\'' {
+		end := strings.IndexByte(p.s[p.pos+1:], quote)
+		if end < 0 {
+			return "", p.errorf("unterminated string")
+		}
+		value := p.s[p.pos+1 : p.pos+1+end]
+		p.pos += end + 2
+		return value, nil
+	}
+	end := strings.IndexByte(p.s[p.pos:], ']')
+	if end < 0 {
+		return "", p.errorf("expected ]")
+	}
+	value := strings.TrimSpace(p.s[p.pos : p.pos+end])
+	p.pos += end
+	return value, nil
+}
+
+// step parses an element selector.
+func (p *selectorParser) step() (selectorStep, error) {
+	var step selectorStep
+	anyName := p.peek() == '*'
+	if anyName {
+		p.pos++
+	} else {
+		step.name = p.name()
+	}
+	if p.peek() == '#' {
+		p.pos++
+		if step.id = p.name(); step.id == "" {
+			return selectorStep{}, p.errorf("expected id")
+		}
+	}
+	for p.peek() == '[' {
+		p.pos++
+		p.skipSpace()
+		var predicate selectorPredicate
+		if p.peek() == '@' {
+			p.pos++
+			predicate.attr = true
+		}
+		if predicate.name = p.name(); predicate.name == "" {
+			return selectorStep{}, p.errorf("expected name")
+		}
+		p.skipSpace()
+		if p.peek() == '=' {
+			p.pos++
+			p.skipSpace()
+			value, err := p.value()
+			if err != nil {
+				return selectorStep{}, err
+			}
+			predicate.value = value
+			predicate.hasValue = true
+			p.skipSpace()
+		}
+		if p.peek() != ']' {
+			return selectorStep{}, p.errorf("expected ]")
+		}
+		p.pos++
+		step.predicates = append(step.predicates, predicate)
+	}
+	if !anyName && step.name == "" && step.id == "" && len(step.predicates) == 0 {
+		return selectorStep{}, p.errorf("expected element selector")
+	}
+	return step, nil
+}
+
+// matches returns true if e, whose ancestors are path, matches sel.
+func (sel selector) matches(path []Element, e Element) bool {
+	return sel.matchesAt(len(sel)-1, path, e)
+}
+
+// matchesAt returns true if e, whose ancestors are path, matches the steps of
+// sel up to and including i.
+func (sel selector) matchesAt(i int, path []Element, e Element) bool {
+	if !sel[i].matches(e) {
+		return false
+	}
+	if i == 0 {
+		return true
+	}
+	if sel[i].child {
+		return len(path) > 0 && sel.matchesAt(i-1, path[:len(path)-1], path[len(path)-1])
+	}
+	for j := len(path) - 1; j >= 0; j-- {
+		if sel.matchesAt(i-1, path[:j], path[j]) {
+			return true
+		}
+	}
+	return false
+}
+
+// matches returns true if e matches step.
+func (step *selectorStep) matches(e Element) bool {
+	if step.name != "" && elementName(e) != step.name {
+		return false
+	}
+	start, ok := StartElementOf(e)
+	if step.id != "" && (!ok || attrValue(start, "id") != step.id) {
+		return false
+	}
+	for _, predicate := range step.predicates {
+		if !predicate.matches(e, start) {
+			return false
+		}
+	}
+	return true
+}
+
+// matches returns true if e, whose start element is start, matches
+// predicate.
+func (predicate *selectorPredicate) matches(e Element, start xml.StartElement) bool {
+	if predicate.attr {
+		for _, attr := range start.Attr {
+			if attr.Name.Local == predicate.name && (!predicate.hasValue || attr.Value == predicate.value) {
+				return true
+			}
+		}
+		return false
+	}
+	ce := compoundElement(e)
+	if ce == nil {
+		return false
+	}
+	for _, child := range ce.children {
+		if elementName(child) != predicate.name {
+			continue
+		}
+		if !predicate.hasValue {
+			return true
+		}
+		if se, ok := child.(*SimpleElement); ok && strings.TrimSpace(se.value) == predicate.value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,154 @@
+package kml
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectAll(t *testing.T) {
+	root := KML(
+		Document(
+			SharedStyle("red", LineStyle(Width(2))),
+			Folder(
+				Name("Turnpoints"),
+				Placemark(Name("TP1"), StyleURL("#red")),
+				Placemark(Name("TP2")),
+				Folder(
+					Name("Nested"),
+					Placemark(Name("TP3"), StyleURL("#red")),
+				),
+			),
+			Folder(
+				Name("Waypoints"),
+				Placemark(
+					Name("WP1"),
+					ExtendedData(
+						SchemaData("#track",
+							SimpleData("speed", "12"),
+							SimpleData("heading", "90"),
+						),
+					),
+				),
+			),
+		),
+	)
+
+	for _, tc := range []struct {
+		selector      string
+		expected      []string
+		expectedPaths []string
+	}{
+		{
+			selector: "Placemark",
+			expected: []string{"TP1", "TP2", "TP3", "WP1"},
+		},
+		{
+			selector:      "Folder[name=Turnpoints] > Placemark",
+			expected:      []string{"TP1", "TP2"},
+			expectedPaths: []string{"kml/Document/Folder", "kml/Document/Folder"},
+		},
+		{
+			selector: "Folder[name=Turnpoints] Placemark",
+			expected: []string{"TP1", "TP2", "TP3"},
+		},
+		{
+			selector: `Placemark[styleUrl="#red"]`,
+			expected: []string{"TP1", "TP3"},
+		},
+		{
+			selector: "Document > Folder > Placemark[ name = 'TP2' ]",
+			expected: []string{"TP2"},
+		},
+		{
+			selector: "Placemark[ExtendedData]",
+			expected: []string{"WP1"},
+		},
+		{
+			selector: "SimpleData[@name=speed]",
+			expected: []string{"12"},
+		},
+		{
+			selector: "*#red",
+			expected: []string{"Style"},
+		},
+		{
+			selector: "#red > LineStyle > width",
+			expected: []string{"2"},
+		},
+		{
+			selector: "kml>Document>Style#red",
+			expected: []string{"Style"},
+		},
+		{
+			selector: "Folder > Folder > Folder",
+		},
+	} {
+		t.Run(tc.selector, func(t *testing.T) {
+			matches, err := SelectAll(root, tc.selector)
+			require.NoError(t, err)
+			var actual, actualPaths []string
+			for _, match := range matches {
+				actual = append(actual, matchLabel(match.Element))
+				names := make([]string, 0, len(match.Path))
+				for _, e := range match.Path {
+					names = append(names, elementName(e))
+				}
+				actualPaths = append(actualPaths, strings.Join(names, "/"))
+			}
+			assert.Equal(t, tc.expected, actual)
+			if tc.expectedPaths != nil {
+				assert.Equal(t, tc.expectedPaths, actualPaths)
+			}
+		})
+	}
+
+	match, err := Select(root, "Folder[name=Turnpoints] Placemark")
+	require.NoError(t, err)
+	require.NotNil(t, match)
+	assert.Equal(t, "TP1", matchLabel(match.Element))
+	assert.Len(t, match.Path, 3)
+
+	match, err = Select(root, "NetworkLink")
+	require.NoError(t, err)
+	assert.Nil(t, match)
+}
+
+func TestSelectorErrors(t *testing.T) {
+	for _, selector := range []string{
+		"",
+		"Placemark >",
+		"Placemark[name",
+		"Placemark[name='TP1]",
+		"Placemark[=TP1]",
+		"Placemark#",
+		"> Placemark",
+		"Placemark Folder$",
+	} {
+		t.Run(selector, func(t *testing.T) {
+			_, err := SelectAll(Placemark(), selector)
+			assert.True(t, errors.Is(err, ErrInvalidSelector))
+		})
+	}
+}
+
+// matchLabel returns the name child of e, if e has one, the value of e, if e
+// is simple, or the name of e.
+func matchLabel(e Element) string {
+	switch e := e.(type) {
+	case *SimpleElement:
+		return e.value
+	default:
+		if ce := compoundElement(e); ce != nil {
+			for _, child := range ce.children {
+				if se, ok := child.(*SimpleElement); ok && se.Name.Local == "name" {
+					return se.value
+				}
+			}
+		}
+		return elementName(e)
+	}
+}
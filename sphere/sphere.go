@@ -13,6 +13,10 @@ const (
 	radians = math.Pi / 180
 )
 
+// minFramingExtent is the minimum extent framed by FramingDistance, as an
+// angle in radians, about 640 m on the FAI sphere.
+const minFramingExtent = 1e-4
+
 // A T is a sphere of radius R.
 type T struct {
 	R float64
@@ -75,3 +79,53 @@ func (t T) InitialBearingTo(c1, c2 kml.Coordinate) float64 {
 	x := math.Cos(lat1)*math.Sin(lat2) - math.Sin(lat1)*math.Cos(lat2)*math.Cos(deltaLon)
 	return math.Atan2(y, x) * degrees
 }
+
+// FramingDistance returns the distance from which b fits entirely in a field
+// of view of fov degrees when viewed from directly above its center. Small
+// bounds, for example those of a single point, are framed as if they had a
+// minimum extent. It returns zero if b is empty.
+func (t T) FramingDistance(b kml.Bounds, fov float64) float64 {
+	if b.IsEmpty() {
+		return 0
+	}
+	center := b.Center()
+	width := t.HaversineDistance(kml.Coordinate{Lon: b.West, Lat: center.Lat}, kml.Coordinate{Lon: b.East, Lat: center.Lat})
+	height := t.HaversineDistance(kml.Coordinate{Lon: center.Lon, Lat: b.South}, kml.Coordinate{Lon: center.Lon, Lat: b.North})
+	extent := math.Max(math.Max(width, height), t.R*minFramingExtent)
+	return extent/2/math.Tan(fov*radians/2) + math.Max(b.MaxAlt, 0)
+}
+
+// LookAt returns a LookAt element that frames b in a field of view of fov
+// degrees, looking straight down on its center. If b is empty then the
+// LookAt contains only children.
+func (t T) LookAt(b kml.Bounds, fov float64, children ...kml.Element) *kml.CompoundElement {
+	if b.IsEmpty() {
+		return kml.LookAt(children...)
+	}
+	center := b.Center()
+	return kml.LookAt(append([]kml.Element{
+		kml.Longitude(center.Lon),
+		kml.Latitude(center.Lat),
+		kml.Heading(0),
+		kml.Tilt(0),
+		kml.Range(t.FramingDistance(b, fov)),
+	}, children...)...)
+}
+
+// Camera returns a Camera element that frames b in a field of view of fov
+// degrees, positioned directly above its center. If b is empty then the
+// Camera contains only children.
+func (t T) Camera(b kml.Bounds, fov float64, children ...kml.Element) *kml.CompoundElement {
+	if b.IsEmpty() {
+		return kml.Camera(children...)
+	}
+	center := b.Center()
+	return kml.Camera(append([]kml.Element{
+		kml.Longitude(center.Lon),
+		kml.Latitude(center.Lat),
+		kml.Altitude(t.FramingDistance(b, fov)),
+		kml.Heading(0),
+		kml.Tilt(0),
+		kml.AltitudeMode(kml.AltitudeModeRelativeToGround),
+	}, children...)...)
+}
@@ -1,10 +1,13 @@
 package sphere
 
 import (
+	"encoding/xml"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/twpayne/go-kml"
 )
@@ -163,3 +166,55 @@ func TestInitialBearingTo(t *testing.T) {
 		})
 	}
 }
+
+func TestFramingDistance(t *testing.T) {
+	b := kml.Bounds{West: -0.01, South: -0.005, East: 0.01, North: 0.005}
+	width := FAI.HaversineDistance(kml.Coordinate{Lon: b.West}, kml.Coordinate{Lon: b.East})
+	assert.InDelta(t, width/2, FAI.FramingDistance(b, 90), 1e-6)
+	b.MaxAlt = 100
+	assert.InDelta(t, width/2+100, FAI.FramingDistance(b, 90), 1e-6)
+	assert.InDelta(t, 318.55, FAI.FramingDistance(kml.NewBounds().Extend(kml.Coordinate{Lon: 1, Lat: 2}), 90), 1e-6)
+	assert.Equal(t, 0.0, FAI.FramingDistance(kml.NewBounds(), 90))
+}
+
+func TestLookAt(t *testing.T) {
+	b := kml.BoundsOf(kml.Placemark(
+		kml.LineString(
+			kml.Coordinates(kml.Coordinate{Lon: 1, Lat: 2}, kml.Coordinate{Lon: 3, Lat: 4}),
+		),
+	))
+	sb := &strings.Builder{}
+	require.NoError(t, xml.NewEncoder(sb).Encode(Unit.LookAt(b, 90)))
+	assert.Equal(t, `<LookAt>`+
+		`<longitude>2</longitude>`+
+		`<latitude>3</latitude>`+
+		`<heading>0</heading>`+
+		`<tilt>0</tilt>`+
+		`<range>0.017453292519943295</range>`+
+		`</LookAt>`, sb.String())
+}
+
+func TestLookAtPoint(t *testing.T) {
+	b := kml.NewBounds().Extend(kml.Coordinate{Lon: 1, Lat: 2})
+	sb := &strings.Builder{}
+	require.NoError(t, xml.NewEncoder(sb).Encode(FAI.LookAt(b, 90)))
+	assert.Equal(t, `<LookAt>`+
+		`<longitude>1</longitude>`+
+		`<latitude>2</latitude>`+
+		`<heading>0</heading>`+
+		`<tilt>0</tilt>`+
+		`<range>318.55</range>`+
+		`</LookAt>`, sb.String())
+}
+
+func TestEmptyBounds(t *testing.T) {
+	for _, e := range []kml.Element{
+		FAI.LookAt(kml.NewBounds(), 60, kml.Heading(10)),
+		FAI.Camera(kml.NewBounds(), 60, kml.Heading(10)),
+	} {
+		sb := &strings.Builder{}
+		require.NoError(t, xml.NewEncoder(sb).Encode(e))
+		assert.NotContains(t, sb.String(), "NaN")
+		assert.Contains(t, sb.String(), "<heading>10</heading>")
+	}
+}
@@ -0,0 +1,502 @@
+// Package split splits KML documents into parts that fit within the feature
+// count and file size limits of consumers such as Google My Maps.
+package split
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/twpayne/go-kml"
+	"github.com/twpayne/go-kml/kmz"
+)
+
+// Default filenames.
+const (
+	DefaultPrefix  = "part"
+	MasterFilename = "doc.kml"
+)
+
+// ErrTooLarge is returned when a single feature does not fit in a part.
+var ErrTooLarge = errors.New("split: feature too large")
+
+var (
+	// containerNames are the names of the features that contain other
+	// features. Containers are split across parts.
+	containerNames = map[string]bool{
+		"Document": true,
+		"Folder":   true,
+	}
+	// featureNames are the names of the features that are never split.
+	featureNames = map[string]bool{
+		"GroundOverlay": true,
+		"NetworkLink":   true,
+		"PhotoOverlay":  true,
+		"Placemark":     true,
+		"ScreenOverlay": true,
+		"gx:Tour":       true,
+	}
+	// sharedNames are the names of the shared elements that are replicated
+	// in each part that references them.
+	sharedNames = map[string]bool{
+		"Schema":   true,
+		"Style":    true,
+		"StyleMap": true,
+	}
+)
+
+// Options control how a document is split. Zero limits are unlimited.
+type Options struct {
+	// MaxFeatures is the maximum number of features in each part. Folders
+	// and Documents are not counted.
+	MaxFeatures int
+	// MaxBytes is the maximum size in bytes of each part's KML when written
+	// with Write. When parts are written as KMZ archives this bounds the
+	// uncompressed size.
+	MaxBytes int
+	// MaxDepth is the maximum depth of nested Folders and Documents in each
+	// part. The contents of deeper containers are moved into their ancestor
+	// at MaxDepth.
+	MaxDepth int
+	// Prefix is the prefix of each part's filename. The default is
+	// DefaultPrefix.
+	Prefix string
+	// KMZ writes each part as a KMZ archive instead of as a KML file.
+	KMZ bool
+	// Master adds a master document containing a NetworkLink to each part.
+	Master bool
+}
+
+// A Part is a single part of a split document.
+type Part struct {
+	Filename string
+	Root     kml.Element
+	// Features is the number of features in Root, excluding Folders and
+	// Documents.
+	Features int
+	// Size is the size of Root in bytes when written with Write.
+	Size int
+}
+
+// A Split is a document split into parts.
+type Split struct {
+	Parts []*Part
+	// Master is the master document, or nil if no master document was
+	// requested.
+	Master kml.Element
+	kmz    bool
+}
+
+// A container is a Folder or Document in the source document.
+type container struct {
+	start  xml.StartElement
+	header []kml.Element
+	refs   []string
+	size   int
+}
+
+// An item is a feature and the containers that it is in. Empty containers
+// are represented by an item with a nil feature.
+type item struct {
+	path    []*container
+	feature kml.Element
+	refs    []string
+	size    int
+}
+
+// A part is a part being built.
+type part struct {
+	items    []*item
+	shared   map[string]bool
+	path     []*container
+	features int
+	size     int
+}
+
+// A splitter splits a document.
+type splitter struct {
+	options    Options
+	doc        *container
+	items      []*item
+	sharedIDs  []string
+	shared     map[string]kml.Element
+	sharedRefs map[string][]string
+	sharedSize map[string]int
+	newRoot    func(kml.Element) *kml.CompoundElement
+	baseSize   int
+}
+
+// New splits root into parts according to options. root may be a kml
+// element, a Document, or any other feature.
+//
+// The structure of Folders and Documents is preserved in each part, with
+// their non-feature children, such as their names, replicated in every part
+// that contains some of their features. Shared Style, StyleMap, and Schema
+// elements are moved to each part's Document and only included in the parts
+// that reference them. Parts contain copies of the elements of root, so they
+// can be modified independently.
+func New(root kml.Element, options Options) (*Split, error) {
+	if options.Prefix == "" {
+		options.Prefix = DefaultPrefix
+	}
+	s := &splitter{
+		options:    options,
+		shared:     make(map[string]kml.Element),
+		sharedRefs: make(map[string][]string),
+		sharedSize: make(map[string]int),
+		newRoot:    kml.KML,
+	}
+	if usesGx(root) {
+		s.newRoot = kml.GxKML
+	}
+
+	docStart, docChildren := document(root)
+	s.doc = &container{start: docStart}
+	s.visit(s.doc, nil, docChildren)
+	s.baseSize = len(xml.Header) + size(s.newRoot(s.shell(s.doc)))
+
+	parts, err := s.split()
+	if err != nil {
+		return nil, err
+	}
+
+	ext := ".kml"
+	if options.KMZ {
+		ext = ".kmz"
+	}
+	result := &Split{
+		Parts: make([]*Part, 0, len(parts)),
+		kmz:   options.KMZ,
+	}
+	links := make([]kml.Element, 0, len(parts))
+	for i, p := range parts {
+		filename := fmt.Sprintf("%s-%03d%s", options.Prefix, i+1, ext)
+		partRoot := s.root(p)
+		result.Parts = append(result.Parts, &Part{
+			Filename: filename,
+			Root:     partRoot,
+			Features: p.features,
+			Size:     len(xml.Header) + size(partRoot),
+		})
+		links = append(links, kml.NetworkLink(
+			kml.Name(filename),
+			kml.Link(kml.Href(filename)),
+		))
+	}
+	if options.Master {
+		var masterChildren []kml.Element
+		for _, e := range s.doc.header {
+			if elementName(e) == "name" {
+				masterChildren = append(masterChildren, kml.Clone(e))
+			}
+		}
+		result.Master = kml.KML(kml.Document(append(masterChildren, links...)...))
+	}
+	return result, nil
+}
+
+// Filenames returns the filenames of s's parts followed by the filename of
+// its master document, if any.
+func (s *Split) Filenames() []string {
+	filenames := make([]string, 0, len(s.Parts)+1)
+	for _, p := range s.Parts {
+		filenames = append(filenames, p.Filename)
+	}
+	if s.Master != nil {
+		filenames = append(filenames, MasterFilename)
+	}
+	return filenames
+}
+
+// WriteDir writes every part of s and its master document, if any, to dir.
+func (s *Split) WriteDir(dir string) error {
+	for _, p := range s.Parts {
+		if err := writeFile(filepath.Join(dir, p.Filename), p.Root, s.kmz); err != nil {
+			return err
+		}
+	}
+	if s.Master != nil {
+		return writeFile(filepath.Join(dir, MasterFilename), s.Master, false)
+	}
+	return nil
+}
+
+// visit adds the children of c, which is at path, to s.
+func (s *splitter) visit(c *container, path []*container, children []kml.Element) {
+	empty := true
+	for _, child := range children {
+		start, _ := kml.StartElementOf(child)
+		name := start.Name.Local
+		switch id := kml.AttrValue(child, "id"); {
+		case sharedNames[name] && id != "":
+			if _, ok := s.shared[id]; !ok {
+				s.sharedIDs = append(s.sharedIDs, id)
+				s.shared[id] = child
+				s.sharedRefs[id] = refs(child)
+				s.sharedSize[id] = size(child)
+			}
+		case containerNames[name]:
+			childContainer := &container{start: start}
+			s.visit(childContainer, append(path[:len(path):len(path)], childContainer), kml.ChildrenOf(child))
+			empty = false
+		case featureNames[name]:
+			s.items = append(s.items, s.newItem(path, child))
+			empty = false
+		default:
+			c.header = append(c.header, child)
+		}
+	}
+	if empty && len(path) != 0 {
+		s.items = append(s.items, s.newItem(path, nil))
+	}
+	c.refs = refs(c.header...)
+	c.size = size(s.shell(c))
+}
+
+// newItem returns a new item for feature at path.
+func (s *splitter) newItem(path []*container, feature kml.Element) *item {
+	if s.options.MaxDepth > 0 && len(path) > s.options.MaxDepth {
+		path = path[:s.options.MaxDepth]
+	}
+	it := &item{
+		path:    path,
+		feature: feature,
+	}
+	if feature != nil {
+		it.refs = refs(feature)
+		it.size = size(feature)
+	}
+	return it
+}
+
+// split assigns s's items to parts.
+func (s *splitter) split() ([]*part, error) {
+	newPart := func() *part {
+		p := &part{
+			shared: make(map[string]bool),
+			size:   s.baseSize,
+		}
+		s.addShared(p, s.doc.refs)
+		return p
+	}
+	p := newPart()
+	parts := []*part{p}
+	for _, it := range s.items {
+		features, size := s.cost(p, it)
+		if len(p.items) != 0 && s.exceeds(p.features+features, p.size+size) {
+			p = newPart()
+			parts = append(parts, p)
+			features, size = s.cost(p, it)
+		}
+		if s.options.MaxBytes > 0 && p.size+size > s.options.MaxBytes {
+			return nil, fmt.Errorf("%w: %d bytes exceeds limit of %d bytes", ErrTooLarge, p.size+size, s.options.MaxBytes)
+		}
+		n := commonPrefix(p.path, it.path)
+		for _, c := range it.path[n:] {
+			s.addShared(p, c.refs)
+		}
+		s.addShared(p, it.refs)
+		p.path = it.path
+		p.items = append(p.items, it)
+		p.features += features
+		p.size += size
+	}
+	return parts, nil
+}
+
+// cost returns the number of features and bytes that adding it to p would
+// add.
+func (s *splitter) cost(p *part, it *item) (int, int) {
+	features := 0
+	if it.feature != nil {
+		features = 1
+	}
+	size := it.size
+	seen := make(map[string]bool)
+	var sharedSize func([]string)
+	sharedSize = func(ids []string) {
+		for _, id := range ids {
+			if _, ok := s.shared[id]; !ok || p.shared[id] || seen[id] {
+				continue
+			}
+			seen[id] = true
+			size += s.sharedSize[id]
+			sharedSize(s.sharedRefs[id])
+		}
+	}
+	for _, c := range it.path[commonPrefix(p.path, it.path):] {
+		size += c.size
+		sharedSize(c.refs)
+	}
+	sharedSize(it.refs)
+	return features, size
+}
+
+// exceeds returns true if features or size exceed s's limits.
+func (s *splitter) exceeds(features, size int) bool {
+	return s.options.MaxFeatures > 0 && features > s.options.MaxFeatures ||
+		s.options.MaxBytes > 0 && size > s.options.MaxBytes
+}
+
+// addShared adds the shared elements with ids, and the shared elements that
+// they reference, to p.
+func (s *splitter) addShared(p *part, ids []string) {
+	for _, id := range ids {
+		if _, ok := s.shared[id]; !ok || p.shared[id] {
+			continue
+		}
+		p.shared[id] = true
+		s.addShared(p, s.sharedRefs[id])
+	}
+}
+
+// root returns the kml element for p.
+func (s *splitter) root(p *part) kml.Element {
+	doc := s.shell(s.doc)
+	for _, id := range s.sharedIDs {
+		if p.shared[id] {
+			doc.Add(kml.Clone(s.shared[id]))
+		}
+	}
+	var path []*container
+	var parents []*kml.CompoundElement
+	for _, it := range p.items {
+		n := commonPrefix(path, it.path)
+		path, parents = path[:n], parents[:n]
+		for _, c := range it.path[n:] {
+			parent := doc
+			if len(parents) != 0 {
+				parent = parents[len(parents)-1]
+			}
+			shell := s.shell(c)
+			parent.Add(shell)
+			path = append(path, c)
+			parents = append(parents, shell)
+		}
+		if it.feature != nil {
+			parent := doc
+			if len(parents) != 0 {
+				parent = parents[len(parents)-1]
+			}
+			parent.Add(kml.Clone(it.feature))
+		}
+	}
+	return s.newRoot(doc)
+}
+
+// shell returns a new element for c containing copies of its header.
+func (s *splitter) shell(c *container) *kml.CompoundElement {
+	shell := &kml.CompoundElement{
+		StartElement: xml.StartElement{
+			Name: c.start.Name,
+			Attr: append([]xml.Attr(nil), c.start.Attr...),
+		},
+	}
+	for _, e := range c.header {
+		shell.Add(kml.Clone(e))
+	}
+	return shell
+}
+
+// document returns the start element and children of root's Document.
+func document(root kml.Element) (xml.StartElement, []kml.Element) {
+	docStart := xml.StartElement{Name: xml.Name{Local: "Document"}}
+	start, _ := kml.StartElementOf(root)
+	switch start.Name.Local {
+	case "kml":
+		var docChildren []kml.Element
+		for _, child := range kml.ChildrenOf(root) {
+			switch elementName(child) {
+			case "Document":
+				childStart, _ := kml.StartElementOf(child)
+				return childStart, kml.ChildrenOf(child)
+			case "NetworkLinkControl":
+			default:
+				docChildren = append(docChildren, child)
+			}
+		}
+		return docStart, docChildren
+	case "Document":
+		return start, kml.ChildrenOf(root)
+	default:
+		return docStart, []kml.Element{root}
+	}
+}
+
+// usesGx returns true if root uses gx: extensions.
+func usesGx(root kml.Element) bool {
+	if kml.AttrValue(root, "xmlns:gx") != "" {
+		return true
+	}
+	errFound := errors.New("found")
+	return kml.Walk(root, func(path []kml.Element, e kml.Element) error {
+		if strings.HasPrefix(elementName(e), "gx:") {
+			return errFound
+		}
+		return nil
+	}) == errFound
+}
+
+// refs returns the ids of the shared elements referenced in es.
+func refs(es ...kml.Element) []string {
+	var ids []string
+	for _, e := range es {
+		_ = kml.Walk(e, func(path []kml.Element, e kml.Element) error {
+			if se, ok := e.(*kml.SimpleElement); ok && se.Name.Local == "styleUrl" {
+				if value := strings.TrimSpace(se.Value()); strings.HasPrefix(value, "#") {
+					ids = append(ids, value[1:])
+				}
+			}
+			if schemaURL := kml.AttrValue(e, "schemaUrl"); strings.HasPrefix(schemaURL, "#") {
+				ids = append(ids, schemaURL[1:])
+			}
+			return nil
+		})
+	}
+	return ids
+}
+
+// size returns the size of e in bytes when encoded without indentation.
+func size(e kml.Element) int {
+	data, err := xml.Marshal(e)
+	if err != nil {
+		return 0
+	}
+	return len(data)
+}
+
+// commonPrefix returns the length of the common prefix of a and b.
+func commonPrefix(a, b []*container) int {
+	n := 0
+	for n < len(a) && n < len(b) && a[n] == b[n] {
+		n++
+	}
+	return n
+}
+
+// writeFile writes e to filename, as a KMZ archive if kmzFile is true.
+func writeFile(filename string, e kml.Element, kmzFile bool) error {
+	f, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	if kmzFile {
+		err = kmz.Write(f, e, nil)
+	} else {
+		err = e.Write(f)
+	}
+	if err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// elementName returns the name of e.
+func elementName(e kml.Element) string {
+	start, _ := kml.StartElementOf(e)
+	return start.Name.Local
+}
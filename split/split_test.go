@@ -0,0 +1,200 @@
+package split
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/twpayne/go-kml"
+)
+
+func newPlacemark(name, styleURL string) kml.Element {
+	children := []kml.Element{kml.Name(name)}
+	if styleURL != "" {
+		children = append(children, kml.StyleURL(styleURL))
+	}
+	children = append(children, kml.Point(kml.Coordinates(kml.Coordinate{Lon: 1, Lat: 2})))
+	return kml.Placemark(children...)
+}
+
+func marshalString(t *testing.T, e kml.Element) string {
+	t.Helper()
+	data, err := xml.Marshal(e)
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestNew(t *testing.T) {
+	root := kml.KML(
+		kml.Document(
+			kml.Name("doc"),
+			kml.SharedStyle("normal", kml.LineStyle(kml.Width(1))),
+			kml.SharedStyle("highlight", kml.LineStyle(kml.Width(2))),
+			kml.SharedStyleMap("map",
+				kml.Pair(kml.Key(kml.StyleStateNormal), kml.StyleURL("#normal")),
+				kml.Pair(kml.Key(kml.StyleStateHighlight), kml.StyleURL("#highlight")),
+			),
+			kml.SharedStyle("unused"),
+			newPlacemark("a", "#normal"),
+			kml.Folder(
+				kml.Name("folder"),
+				newPlacemark("b", ""),
+				newPlacemark("c", "#map"),
+			),
+		),
+	)
+	s, err := New(root, Options{MaxFeatures: 2, Master: true})
+	require.NoError(t, err)
+	require.Len(t, s.Parts, 2)
+
+	assert.Equal(t, "part-001.kml", s.Parts[0].Filename)
+	assert.Equal(t, 2, s.Parts[0].Features)
+	assert.Equal(t, marshalString(t, kml.KML(
+		kml.Document(
+			kml.Name("doc"),
+			kml.SharedStyle("normal", kml.LineStyle(kml.Width(1))),
+			newPlacemark("a", "#normal"),
+			kml.Folder(
+				kml.Name("folder"),
+				newPlacemark("b", ""),
+			),
+		),
+	)), marshalString(t, s.Parts[0].Root))
+
+	assert.Equal(t, "part-002.kml", s.Parts[1].Filename)
+	assert.Equal(t, 1, s.Parts[1].Features)
+	assert.Equal(t, marshalString(t, kml.KML(
+		kml.Document(
+			kml.Name("doc"),
+			kml.SharedStyle("normal", kml.LineStyle(kml.Width(1))),
+			kml.SharedStyle("highlight", kml.LineStyle(kml.Width(2))),
+			kml.SharedStyleMap("map",
+				kml.Pair(kml.Key(kml.StyleStateNormal), kml.StyleURL("#normal")),
+				kml.Pair(kml.Key(kml.StyleStateHighlight), kml.StyleURL("#highlight")),
+			),
+			kml.Folder(
+				kml.Name("folder"),
+				newPlacemark("c", "#map"),
+			),
+		),
+	)), marshalString(t, s.Parts[1].Root))
+
+	assert.Equal(t, marshalString(t, kml.KML(
+		kml.Document(
+			kml.Name("doc"),
+			kml.NetworkLink(kml.Name("part-001.kml"), kml.Link(kml.Href("part-001.kml"))),
+			kml.NetworkLink(kml.Name("part-002.kml"), kml.Link(kml.Href("part-002.kml"))),
+		),
+	)), marshalString(t, s.Master))
+	assert.Equal(t, []string{"part-001.kml", "part-002.kml", "doc.kml"}, s.Filenames())
+}
+
+func TestNewCopiesFeatures(t *testing.T) {
+	placemark := kml.Placemark(kml.Name("a"))
+	root := kml.Document(placemark)
+	s, err := New(root, Options{})
+	require.NoError(t, err)
+	require.Len(t, s.Parts, 1)
+	part := kml.ChildrenOf(kml.ChildrenOf(s.Parts[0].Root)[0])[0].(*kml.CompoundElement)
+	part.Add(kml.Description("b"))
+	assert.Equal(t, marshalString(t, kml.Placemark(kml.Name("a"))), marshalString(t, placemark))
+}
+
+func TestMaxBytes(t *testing.T) {
+	var features []kml.Element
+	for i := 0; i < 20; i++ {
+		features = append(features, newPlacemark(strconv.Itoa(i), "#s"))
+	}
+	root := kml.GxKML(
+		kml.Document(
+			append([]kml.Element{
+				kml.SharedStyle("s", kml.IconStyle(kml.Scale(2))),
+			}, features...)...,
+		),
+	)
+	const maxBytes = 1024
+	s, err := New(root, Options{MaxBytes: maxBytes})
+	require.NoError(t, err)
+	assert.True(t, len(s.Parts) > 1)
+	total := 0
+	for _, p := range s.Parts {
+		b := &bytes.Buffer{}
+		require.NoError(t, p.Root.Write(b))
+		assert.Equal(t, b.Len(), p.Size)
+		assert.True(t, p.Size <= maxBytes)
+		assert.True(t, strings.Contains(b.String(), `xmlns:gx=`))
+		assert.True(t, strings.Contains(b.String(), `<Style id="s">`))
+		total += p.Features
+	}
+	assert.Equal(t, len(features), total)
+
+	_, err = New(root, Options{MaxBytes: 200})
+	assert.True(t, errors.Is(err, ErrTooLarge))
+}
+
+func TestMaxDepth(t *testing.T) {
+	root := kml.Document(
+		kml.Folder(
+			kml.Name("1"),
+			newPlacemark("a", ""),
+			kml.Folder(
+				kml.Name("2"),
+				newPlacemark("b", ""),
+				kml.Folder(
+					kml.Name("3"),
+					newPlacemark("c", ""),
+				),
+			),
+			kml.Folder(
+				kml.Name("empty"),
+			),
+			newPlacemark("d", ""),
+		),
+	)
+	s, err := New(root, Options{MaxDepth: 1})
+	require.NoError(t, err)
+	require.Len(t, s.Parts, 1)
+	assert.Equal(t, marshalString(t, kml.KML(
+		kml.Document(
+			kml.Folder(
+				kml.Name("1"),
+				newPlacemark("a", ""),
+				newPlacemark("b", ""),
+				newPlacemark("c", ""),
+				newPlacemark("d", ""),
+			),
+		),
+	)), marshalString(t, s.Parts[0].Root))
+
+	s, err = New(root, Options{})
+	require.NoError(t, err)
+	require.Len(t, s.Parts, 1)
+	assert.Equal(t, marshalString(t, kml.KML(root)), marshalString(t, s.Parts[0].Root))
+}
+
+func TestWriteDir(t *testing.T) {
+	dir, err := ioutil.TempDir("", "split")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	s, err := New(newPlacemark("a", ""), Options{Prefix: "p", KMZ: true, Master: true})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"p-001.kmz", "doc.kml"}, s.Filenames())
+	require.NoError(t, s.WriteDir(dir))
+	for _, filename := range s.Filenames() {
+		_, err := os.Stat(filepath.Join(dir, filename))
+		assert.NoError(t, err)
+	}
+	data, err := ioutil.ReadFile(filepath.Join(dir, "p-001.kmz"))
+	require.NoError(t, err)
+	assert.True(t, bytes.HasPrefix(data, []byte("PK")))
+}
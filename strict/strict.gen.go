@@ -0,0 +1,4251 @@
+// Code generated by github.com/twpayne/go-kml/internal/generate. DO NOT EDIT.
+
+package strict
+
+import (
+	"image/color"
+	"time"
+
+	"github.com/twpayne/go-kml"
+)
+
+// An AddressElement is an address element.
+type AddressElement struct {
+	element
+}
+
+func (AddressElement) isDocumentChild()      {}
+func (AddressElement) isFolderChild()        {}
+func (AddressElement) isGroundOverlayChild() {}
+func (AddressElement) isGxTourChild()        {}
+func (AddressElement) isNetworkLinkChild()   {}
+func (AddressElement) isPhotoOverlayChild()  {}
+func (AddressElement) isPlacemarkChild()     {}
+func (AddressElement) isScreenOverlayChild() {}
+
+// Address returns a new address element.
+func Address(value string) AddressElement {
+	return AddressElement{element{kml.Address(value)}}
+}
+
+// An AltitudeElement is an altitude element.
+type AltitudeElement struct {
+	element
+}
+
+func (AltitudeElement) isCameraChild()        {}
+func (AltitudeElement) isGroundOverlayChild() {}
+func (AltitudeElement) isLocationChild()      {}
+func (AltitudeElement) isLookAtChild()        {}
+
+// Altitude returns a new altitude element.
+func Altitude(value float64) AltitudeElement {
+	return AltitudeElement{element{kml.Altitude(value)}}
+}
+
+// An AltitudeModeElement is an altitudeMode element.
+type AltitudeModeElement struct {
+	element
+}
+
+func (AltitudeModeElement) isCameraChild()        {}
+func (AltitudeModeElement) isGroundOverlayChild() {}
+func (AltitudeModeElement) isGxMultiTrackChild()  {}
+func (AltitudeModeElement) isGxTrackChild()       {}
+func (AltitudeModeElement) isLatLonAltBoxChild()  {}
+func (AltitudeModeElement) isLineStringChild()    {}
+func (AltitudeModeElement) isLinearRingChild()    {}
+func (AltitudeModeElement) isLookAtChild()        {}
+func (AltitudeModeElement) isModelChild()         {}
+func (AltitudeModeElement) isPointChild()         {}
+func (AltitudeModeElement) isPolygonChild()       {}
+
+// AltitudeMode returns a new altitudeMode element.
+func AltitudeMode(value kml.AltitudeModeEnum) AltitudeModeElement {
+	return AltitudeModeElement{element{kml.AltitudeMode(value)}}
+}
+
+// A BeginElement is a begin element.
+type BeginElement struct {
+	element
+}
+
+func (BeginElement) isGxTimeSpanChild() {}
+func (BeginElement) isTimeSpanChild()   {}
+
+// Begin returns a new begin element.
+func Begin(value time.Time) BeginElement {
+	return BeginElement{element{kml.Begin(value)}}
+}
+
+// BeginKMLTime returns a new begin element with a value of
+// any precision.
+func BeginKMLTime(value kml.KMLTime) BeginElement {
+	return BeginElement{element{kml.BeginKMLTime(value)}}
+}
+
+// A BgColorElement is a bgColor element.
+type BgColorElement struct {
+	element
+}
+
+func (BgColorElement) isBalloonStyleChild() {}
+func (BgColorElement) isListStyleChild()    {}
+
+// BgColor returns a new bgColor element.
+func BgColor(value color.Color) BgColorElement {
+	return BgColorElement{element{kml.BgColor(value)}}
+}
+
+// A BottomFOVElement is a bottomFov element.
+type BottomFOVElement struct {
+	element
+}
+
+func (BottomFOVElement) isViewVolumeChild() {}
+
+// BottomFOV returns a new bottomFov element.
+func BottomFOV(value float64) BottomFOVElement {
+	return BottomFOVElement{element{kml.BottomFOV(value)}}
+}
+
+// A ColorElement is a color element.
+type ColorElement struct {
+	element
+}
+
+func (ColorElement) isBalloonStyleChild()  {}
+func (ColorElement) isGroundOverlayChild() {}
+func (ColorElement) isIconStyleChild()     {}
+func (ColorElement) isLabelStyleChild()    {}
+func (ColorElement) isLineStyleChild()     {}
+func (ColorElement) isPhotoOverlayChild()  {}
+func (ColorElement) isPolyStyleChild()     {}
+func (ColorElement) isScreenOverlayChild() {}
+
+// Color returns a new color element.
+func Color(value color.Color) ColorElement {
+	return ColorElement{element{kml.Color(value)}}
+}
+
+// A ColorModeElement is a colorMode element.
+type ColorModeElement struct {
+	element
+}
+
+func (ColorModeElement) isIconStyleChild()  {}
+func (ColorModeElement) isLabelStyleChild() {}
+func (ColorModeElement) isLineStyleChild()  {}
+func (ColorModeElement) isPolyStyleChild()  {}
+
+// ColorMode returns a new colorMode element.
+func ColorMode(value kml.ColorModeEnum) ColorModeElement {
+	return ColorModeElement{element{kml.ColorMode(value)}}
+}
+
+// A CookieElement is a cookie element.
+type CookieElement struct {
+	element
+}
+
+func (CookieElement) isNetworkLinkControlChild() {}
+
+// Cookie returns a new cookie element.
+func Cookie(value string) CookieElement {
+	return CookieElement{element{kml.Cookie(value)}}
+}
+
+// A CoordinatesElement is a coordinates element.
+type CoordinatesElement struct {
+	element
+}
+
+func (CoordinatesElement) isGxLatLonQuadChild() {}
+func (CoordinatesElement) isLineStringChild()   {}
+func (CoordinatesElement) isLinearRingChild()   {}
+func (CoordinatesElement) isPointChild()        {}
+
+// A DescriptionElement is a description element.
+type DescriptionElement struct {
+	element
+}
+
+func (DescriptionElement) isDocumentChild()      {}
+func (DescriptionElement) isFolderChild()        {}
+func (DescriptionElement) isGroundOverlayChild() {}
+func (DescriptionElement) isGxTourChild()        {}
+func (DescriptionElement) isNetworkLinkChild()   {}
+func (DescriptionElement) isPhotoOverlayChild()  {}
+func (DescriptionElement) isPlacemarkChild()     {}
+func (DescriptionElement) isScreenOverlayChild() {}
+
+// Description returns a new description element.
+func Description(value string) DescriptionElement {
+	return DescriptionElement{element{kml.Description(value)}}
+}
+
+// A DisplayNameElement is a displayName element.
+type DisplayNameElement struct {
+	element
+}
+
+func (DisplayNameElement) isDataChild()               {}
+func (DisplayNameElement) isGxSimpleArrayFieldChild() {}
+func (DisplayNameElement) isSimpleFieldChild()        {}
+
+// DisplayName returns a new displayName element.
+func DisplayName(value string) DisplayNameElement {
+	return DisplayNameElement{element{kml.DisplayName(value)}}
+}
+
+// A DisplayModeElement is a displayMode element.
+type DisplayModeElement struct {
+	element
+}
+
+func (DisplayModeElement) isBalloonStyleChild() {}
+
+// DisplayMode returns a new displayMode element.
+func DisplayMode(value kml.DisplayModeEnum) DisplayModeElement {
+	return DisplayModeElement{element{kml.DisplayMode(value)}}
+}
+
+// A DrawOrderElement is a drawOrder element.
+type DrawOrderElement struct {
+	element
+}
+
+func (DrawOrderElement) isGroundOverlayChild() {}
+func (DrawOrderElement) isPhotoOverlayChild()  {}
+func (DrawOrderElement) isScreenOverlayChild() {}
+
+// DrawOrder returns a new drawOrder element.
+func DrawOrder(value int) DrawOrderElement {
+	return DrawOrderElement{element{kml.DrawOrder(value)}}
+}
+
+// An EastElement is an east element.
+type EastElement struct {
+	element
+}
+
+func (EastElement) isLatLonAltBoxChild() {}
+func (EastElement) isLatLonBoxChild()    {}
+
+// East returns a new east element.
+func East(value float64) EastElement {
+	return EastElement{element{kml.East(value)}}
+}
+
+// An EndElement is an end element.
+type EndElement struct {
+	element
+}
+
+func (EndElement) isGxTimeSpanChild() {}
+func (EndElement) isTimeSpanChild()   {}
+
+// End returns a new end element.
+func End(value time.Time) EndElement {
+	return EndElement{element{kml.End(value)}}
+}
+
+// EndKMLTime returns a new end element with a value of
+// any precision.
+func EndKMLTime(value kml.KMLTime) EndElement {
+	return EndElement{element{kml.EndKMLTime(value)}}
+}
+
+// An ExpiresElement is an expires element.
+type ExpiresElement struct {
+	element
+}
+
+func (ExpiresElement) isNetworkLinkControlChild() {}
+
+// Expires returns a new expires element.
+func Expires(value time.Time) ExpiresElement {
+	return ExpiresElement{element{kml.Expires(value)}}
+}
+
+// ExpiresKMLTime returns a new expires element with a value of
+// any precision.
+func ExpiresKMLTime(value kml.KMLTime) ExpiresElement {
+	return ExpiresElement{element{kml.ExpiresKMLTime(value)}}
+}
+
+// An ExtrudeElement is an extrude element.
+type ExtrudeElement struct {
+	element
+}
+
+func (ExtrudeElement) isGxTrackChild()    {}
+func (ExtrudeElement) isLineStringChild() {}
+func (ExtrudeElement) isLinearRingChild() {}
+func (ExtrudeElement) isPointChild()      {}
+func (ExtrudeElement) isPolygonChild()    {}
+
+// Extrude returns a new extrude element.
+func Extrude(value bool) ExtrudeElement {
+	return ExtrudeElement{element{kml.Extrude(value)}}
+}
+
+// A FillElement is a fill element.
+type FillElement struct {
+	element
+}
+
+func (FillElement) isPolyStyleChild() {}
+
+// Fill returns a new fill element.
+func Fill(value bool) FillElement {
+	return FillElement{element{kml.Fill(value)}}
+}
+
+// A FlyToViewElement is a flyToView element.
+type FlyToViewElement struct {
+	element
+}
+
+func (FlyToViewElement) isNetworkLinkChild() {}
+
+// FlyToView returns a new flyToView element.
+func FlyToView(value bool) FlyToViewElement {
+	return FlyToViewElement{element{kml.FlyToView(value)}}
+}
+
+// A GridOriginElement is a gridOrigin element.
+type GridOriginElement struct {
+	element
+}
+
+func (GridOriginElement) isImagePyramidChild() {}
+
+// GridOrigin returns a new gridOrigin element.
+func GridOrigin(value kml.GridOriginEnum) GridOriginElement {
+	return GridOriginElement{element{kml.GridOrigin(value)}}
+}
+
+// A HeadingElement is a heading element.
+type HeadingElement struct {
+	element
+}
+
+func (HeadingElement) isCameraChild()      {}
+func (HeadingElement) isIconStyleChild()   {}
+func (HeadingElement) isLookAtChild()      {}
+func (HeadingElement) isOrientationChild() {}
+
+// Heading returns a new heading element.
+func Heading(value float64) HeadingElement {
+	return HeadingElement{element{kml.Heading(value)}}
+}
+
+// A HrefElement is a href element.
+type HrefElement struct {
+	element
+}
+
+func (HrefElement) isGxSoundCueChild() {}
+func (HrefElement) isIconChild()       {}
+func (HrefElement) isItemIconChild()   {}
+func (HrefElement) isLinkChild()       {}
+func (HrefElement) isURLChild()        {}
+
+// Href returns a new href element.
+func Href(value string) HrefElement {
+	return HrefElement{element{kml.Href(value)}}
+}
+
+// A HTTPQueryElement is a httpQuery element.
+type HTTPQueryElement struct {
+	element
+}
+
+func (HTTPQueryElement) isIconChild() {}
+func (HTTPQueryElement) isLinkChild() {}
+func (HTTPQueryElement) isURLChild()  {}
+
+// HTTPQuery returns a new httpQuery element.
+func HTTPQuery(value string) HTTPQueryElement {
+	return HTTPQueryElement{element{kml.HTTPQuery(value)}}
+}
+
+// A HotSpotElement is a hotSpot element.
+type HotSpotElement struct {
+	element
+}
+
+func (HotSpotElement) isIconStyleChild() {}
+
+// HotSpot returns a new hotSpot element.
+func HotSpot(value kml.Vec2) HotSpotElement {
+	return HotSpotElement{element{kml.HotSpot(value)}}
+}
+
+// A KeyElement is a key element.
+type KeyElement struct {
+	element
+}
+
+func (KeyElement) isPairChild() {}
+
+// Key returns a new key element.
+func Key(value kml.StyleStateEnum) KeyElement {
+	return KeyElement{element{kml.Key(value)}}
+}
+
+// A LatitudeElement is a latitude element.
+type LatitudeElement struct {
+	element
+}
+
+func (LatitudeElement) isCameraChild()   {}
+func (LatitudeElement) isLocationChild() {}
+func (LatitudeElement) isLookAtChild()   {}
+
+// Latitude returns a new latitude element.
+func Latitude(value float64) LatitudeElement {
+	return LatitudeElement{element{kml.Latitude(value)}}
+}
+
+// A LeftFOVElement is a leftFov element.
+type LeftFOVElement struct {
+	element
+}
+
+func (LeftFOVElement) isViewVolumeChild() {}
+
+// LeftFOV returns a new leftFov element.
+func LeftFOV(value float64) LeftFOVElement {
+	return LeftFOVElement{element{kml.LeftFOV(value)}}
+}
+
+// A LinkDescriptionElement is a linkDescription element.
+type LinkDescriptionElement struct {
+	element
+}
+
+func (LinkDescriptionElement) isNetworkLinkControlChild() {}
+
+// LinkDescription returns a new linkDescription element.
+func LinkDescription(value string) LinkDescriptionElement {
+	return LinkDescriptionElement{element{kml.LinkDescription(value)}}
+}
+
+// A LinkNameElement is a linkName element.
+type LinkNameElement struct {
+	element
+}
+
+func (LinkNameElement) isNetworkLinkControlChild() {}
+
+// LinkName returns a new linkName element.
+func LinkName(value string) LinkNameElement {
+	return LinkNameElement{element{kml.LinkName(value)}}
+}
+
+// A LinkSnippetElement is a linkSnippet element.
+type LinkSnippetElement struct {
+	element
+}
+
+func (LinkSnippetElement) isNetworkLinkControlChild() {}
+
+// LinkSnippet returns a new linkSnippet element.
+func LinkSnippet(maxLines int, value string) LinkSnippetElement {
+	return LinkSnippetElement{element{kml.LinkSnippet(maxLines, value)}}
+}
+
+// A ListItemTypeElement is a listItemType element.
+type ListItemTypeElement struct {
+	element
+}
+
+func (ListItemTypeElement) isListStyleChild() {}
+
+// ListItemType returns a new listItemType element.
+func ListItemType(value kml.ListItemTypeEnum) ListItemTypeElement {
+	return ListItemTypeElement{element{kml.ListItemType(value)}}
+}
+
+// A LongitudeElement is a longitude element.
+type LongitudeElement struct {
+	element
+}
+
+func (LongitudeElement) isCameraChild()   {}
+func (LongitudeElement) isLocationChild() {}
+func (LongitudeElement) isLookAtChild()   {}
+
+// Longitude returns a new longitude element.
+func Longitude(value float64) LongitudeElement {
+	return LongitudeElement{element{kml.Longitude(value)}}
+}
+
+// A MaxSnippetLinesElement is a maxSnippetLines element.
+type MaxSnippetLinesElement struct {
+	element
+}
+
+func (MaxSnippetLinesElement) isListStyleChild() {}
+
+// MaxSnippetLines returns a new maxSnippetLines element.
+func MaxSnippetLines(value int) MaxSnippetLinesElement {
+	return MaxSnippetLinesElement{element{kml.MaxSnippetLines(value)}}
+}
+
+// A MaxSessionLengthElement is a maxSessionLength element.
+type MaxSessionLengthElement struct {
+	element
+}
+
+func (MaxSessionLengthElement) isNetworkLinkControlChild() {}
+
+// MaxSessionLength returns a new maxSessionLength element.
+func MaxSessionLength(value float64) MaxSessionLengthElement {
+	return MaxSessionLengthElement{element{kml.MaxSessionLength(value)}}
+}
+
+// A MessageElement is a message element.
+type MessageElement struct {
+	element
+}
+
+func (MessageElement) isNetworkLinkControlChild() {}
+
+// Message returns a new message element.
+func Message(value string) MessageElement {
+	return MessageElement{element{kml.Message(value)}}
+}
+
+// A MinAltitudeElement is a minAltitude element.
+type MinAltitudeElement struct {
+	element
+}
+
+func (MinAltitudeElement) isLatLonAltBoxChild() {}
+
+// MinAltitude returns a new minAltitude element.
+func MinAltitude(value float64) MinAltitudeElement {
+	return MinAltitudeElement{element{kml.MinAltitude(value)}}
+}
+
+// A MinFadeExtentElement is a minFadeExtent element.
+type MinFadeExtentElement struct {
+	element
+}
+
+func (MinFadeExtentElement) isLODChild() {}
+
+// MinFadeExtent returns a new minFadeExtent element.
+func MinFadeExtent(value float64) MinFadeExtentElement {
+	return MinFadeExtentElement{element{kml.MinFadeExtent(value)}}
+}
+
+// A MinLODPixelsElement is a minLodPixels element.
+type MinLODPixelsElement struct {
+	element
+}
+
+func (MinLODPixelsElement) isLODChild() {}
+
+// MinLODPixels returns a new minLodPixels element.
+func MinLODPixels(value float64) MinLODPixelsElement {
+	return MinLODPixelsElement{element{kml.MinLODPixels(value)}}
+}
+
+// A MinRefreshPeriodElement is a minRefreshPeriod element.
+type MinRefreshPeriodElement struct {
+	element
+}
+
+func (MinRefreshPeriodElement) isNetworkLinkControlChild() {}
+
+// MinRefreshPeriod returns a new minRefreshPeriod element.
+func MinRefreshPeriod(value float64) MinRefreshPeriodElement {
+	return MinRefreshPeriodElement{element{kml.MinRefreshPeriod(value)}}
+}
+
+// A MaxAltitudeElement is a maxAltitude element.
+type MaxAltitudeElement struct {
+	element
+}
+
+func (MaxAltitudeElement) isLatLonAltBoxChild() {}
+
+// MaxAltitude returns a new maxAltitude element.
+func MaxAltitude(value float64) MaxAltitudeElement {
+	return MaxAltitudeElement{element{kml.MaxAltitude(value)}}
+}
+
+// A MaxFadeExtentElement is a maxFadeExtent element.
+type MaxFadeExtentElement struct {
+	element
+}
+
+func (MaxFadeExtentElement) isLODChild() {}
+
+// MaxFadeExtent returns a new maxFadeExtent element.
+func MaxFadeExtent(value float64) MaxFadeExtentElement {
+	return MaxFadeExtentElement{element{kml.MaxFadeExtent(value)}}
+}
+
+// A MaxLODPixelsElement is a maxLodPixels element.
+type MaxLODPixelsElement struct {
+	element
+}
+
+func (MaxLODPixelsElement) isLODChild() {}
+
+// MaxLODPixels returns a new maxLodPixels element.
+func MaxLODPixels(value float64) MaxLODPixelsElement {
+	return MaxLODPixelsElement{element{kml.MaxLODPixels(value)}}
+}
+
+// A MaxHeightElement is a maxHeight element.
+type MaxHeightElement struct {
+	element
+}
+
+func (MaxHeightElement) isImagePyramidChild() {}
+
+// MaxHeight returns a new maxHeight element.
+func MaxHeight(value int) MaxHeightElement {
+	return MaxHeightElement{element{kml.MaxHeight(value)}}
+}
+
+// A MaxWidthElement is a maxWidth element.
+type MaxWidthElement struct {
+	element
+}
+
+func (MaxWidthElement) isImagePyramidChild() {}
+
+// MaxWidth returns a new maxWidth element.
+func MaxWidth(value int) MaxWidthElement {
+	return MaxWidthElement{element{kml.MaxWidth(value)}}
+}
+
+// A NameElement is a name element.
+type NameElement struct {
+	element
+}
+
+func (NameElement) isDocumentChild()      {}
+func (NameElement) isFolderChild()        {}
+func (NameElement) isGroundOverlayChild() {}
+func (NameElement) isGxTourChild()        {}
+func (NameElement) isNetworkLinkChild()   {}
+func (NameElement) isPhotoOverlayChild()  {}
+func (NameElement) isPlacemarkChild()     {}
+func (NameElement) isScreenOverlayChild() {}
+
+// Name returns a new name element.
+func Name(value string) NameElement {
+	return NameElement{element{kml.Name(value)}}
+}
+
+// A NearElement is a near element.
+type NearElement struct {
+	element
+}
+
+func (NearElement) isViewVolumeChild() {}
+
+// Near returns a new near element.
+func Near(value float64) NearElement {
+	return NearElement{element{kml.Near(value)}}
+}
+
+// A NorthElement is a north element.
+type NorthElement struct {
+	element
+}
+
+func (NorthElement) isLatLonAltBoxChild() {}
+func (NorthElement) isLatLonBoxChild()    {}
+
+// North returns a new north element.
+func North(value float64) NorthElement {
+	return NorthElement{element{kml.North(value)}}
+}
+
+// An OpenElement is an open element.
+type OpenElement struct {
+	element
+}
+
+func (OpenElement) isDocumentChild()      {}
+func (OpenElement) isFolderChild()        {}
+func (OpenElement) isGroundOverlayChild() {}
+func (OpenElement) isGxTourChild()        {}
+func (OpenElement) isNetworkLinkChild()   {}
+func (OpenElement) isPhotoOverlayChild()  {}
+func (OpenElement) isPlacemarkChild()     {}
+func (OpenElement) isScreenOverlayChild() {}
+
+// Open returns a new open element.
+func Open(value bool) OpenElement {
+	return OpenElement{element{kml.Open(value)}}
+}
+
+// An OutlineElement is an outline element.
+type OutlineElement struct {
+	element
+}
+
+func (OutlineElement) isPolyStyleChild() {}
+
+// Outline returns a new outline element.
+func Outline(value bool) OutlineElement {
+	return OutlineElement{element{kml.Outline(value)}}
+}
+
+// An OverlayXYElement is an overlayXY element.
+type OverlayXYElement struct {
+	element
+}
+
+func (OverlayXYElement) isScreenOverlayChild() {}
+
+// OverlayXY returns a new overlayXY element.
+func OverlayXY(value kml.Vec2) OverlayXYElement {
+	return OverlayXYElement{element{kml.OverlayXY(value)}}
+}
+
+// A PhoneNumberElement is a phoneNumber element.
+type PhoneNumberElement struct {
+	element
+}
+
+func (PhoneNumberElement) isDocumentChild()      {}
+func (PhoneNumberElement) isFolderChild()        {}
+func (PhoneNumberElement) isGroundOverlayChild() {}
+func (PhoneNumberElement) isGxTourChild()        {}
+func (PhoneNumberElement) isNetworkLinkChild()   {}
+func (PhoneNumberElement) isPhotoOverlayChild()  {}
+func (PhoneNumberElement) isPlacemarkChild()     {}
+func (PhoneNumberElement) isScreenOverlayChild() {}
+
+// PhoneNumber returns a new phoneNumber element.
+func PhoneNumber(value string) PhoneNumberElement {
+	return PhoneNumberElement{element{kml.PhoneNumber(value)}}
+}
+
+// A RangeElement is a range element.
+type RangeElement struct {
+	element
+}
+
+func (RangeElement) isLookAtChild() {}
+
+// Range returns a new range element.
+func Range(value float64) RangeElement {
+	return RangeElement{element{kml.Range(value)}}
+}
+
+// A RefreshModeElement is a refreshMode element.
+type RefreshModeElement struct {
+	element
+}
+
+func (RefreshModeElement) isIconChild() {}
+func (RefreshModeElement) isLinkChild() {}
+func (RefreshModeElement) isURLChild()  {}
+
+// RefreshMode returns a new refreshMode element.
+func RefreshMode(value kml.RefreshModeEnum) RefreshModeElement {
+	return RefreshModeElement{element{kml.RefreshMode(value)}}
+}
+
+// A RefreshIntervalElement is a refreshInterval element.
+type RefreshIntervalElement struct {
+	element
+}
+
+func (RefreshIntervalElement) isIconChild() {}
+func (RefreshIntervalElement) isLinkChild() {}
+func (RefreshIntervalElement) isURLChild()  {}
+
+// RefreshInterval returns a new refreshInterval element.
+func RefreshInterval(value float64) RefreshIntervalElement {
+	return RefreshIntervalElement{element{kml.RefreshInterval(value)}}
+}
+
+// A RefreshVisibilityElement is a refreshVisibility element.
+type RefreshVisibilityElement struct {
+	element
+}
+
+func (RefreshVisibilityElement) isNetworkLinkChild() {}
+
+// RefreshVisibility returns a new refreshVisibility element.
+func RefreshVisibility(value bool) RefreshVisibilityElement {
+	return RefreshVisibilityElement{element{kml.RefreshVisibility(value)}}
+}
+
+// A RightFOVElement is a rightFov element.
+type RightFOVElement struct {
+	element
+}
+
+func (RightFOVElement) isViewVolumeChild() {}
+
+// RightFOV returns a new rightFov element.
+func RightFOV(value float64) RightFOVElement {
+	return RightFOVElement{element{kml.RightFOV(value)}}
+}
+
+// A RollElement is a roll element.
+type RollElement struct {
+	element
+}
+
+func (RollElement) isCameraChild()      {}
+func (RollElement) isOrientationChild() {}
+
+// Roll returns a new roll element.
+func Roll(value float64) RollElement {
+	return RollElement{element{kml.Roll(value)}}
+}
+
+// A RotationElement is a rotation element.
+type RotationElement struct {
+	element
+}
+
+func (RotationElement) isLatLonBoxChild()     {}
+func (RotationElement) isPhotoOverlayChild()  {}
+func (RotationElement) isScreenOverlayChild() {}
+
+// Rotation returns a new rotation element.
+func Rotation(value float64) RotationElement {
+	return RotationElement{element{kml.Rotation(value)}}
+}
+
+// A RotationXYElement is a rotationXY element.
+type RotationXYElement struct {
+	element
+}
+
+func (RotationXYElement) isScreenOverlayChild() {}
+
+// RotationXY returns a new rotationXY element.
+func RotationXY(value kml.Vec2) RotationXYElement {
+	return RotationXYElement{element{kml.RotationXY(value)}}
+}
+
+// A ScaleElement is a scale element.
+type ScaleElement struct {
+	element
+}
+
+func (ScaleElement) isIconStyleChild()  {}
+func (ScaleElement) isLabelStyleChild() {}
+
+// Scale returns a new scale element.
+func Scale(value float64) ScaleElement {
+	return ScaleElement{element{kml.Scale(value)}}
+}
+
+// A ScreenXYElement is a screenXY element.
+type ScreenXYElement struct {
+	element
+}
+
+func (ScreenXYElement) isScreenOverlayChild() {}
+
+// ScreenXY returns a new screenXY element.
+func ScreenXY(value kml.Vec2) ScreenXYElement {
+	return ScreenXYElement{element{kml.ScreenXY(value)}}
+}
+
+// A ShapeElement is a shape element.
+type ShapeElement struct {
+	element
+}
+
+func (ShapeElement) isPhotoOverlayChild() {}
+
+// Shape returns a new shape element.
+func Shape(value kml.ShapeEnum) ShapeElement {
+	return ShapeElement{element{kml.Shape(value)}}
+}
+
+// A SizeElement is a size element.
+type SizeElement struct {
+	element
+}
+
+func (SizeElement) isScreenOverlayChild() {}
+
+// Size returns a new size element.
+func Size(value kml.Vec2) SizeElement {
+	return SizeElement{element{kml.Size(value)}}
+}
+
+// A SouthElement is a south element.
+type SouthElement struct {
+	element
+}
+
+func (SouthElement) isLatLonAltBoxChild() {}
+func (SouthElement) isLatLonBoxChild()    {}
+
+// South returns a new south element.
+func South(value float64) SouthElement {
+	return SouthElement{element{kml.South(value)}}
+}
+
+// A SourceHrefElement is a sourceHref element.
+type SourceHrefElement struct {
+	element
+}
+
+func (SourceHrefElement) isAliasChild() {}
+
+// SourceHref returns a new sourceHref element.
+func SourceHref(value string) SourceHrefElement {
+	return SourceHrefElement{element{kml.SourceHref(value)}}
+}
+
+// A SnippetElement is a snippet element.
+type SnippetElement struct {
+	element
+}
+
+func (SnippetElement) isDocumentChild()      {}
+func (SnippetElement) isFolderChild()        {}
+func (SnippetElement) isGroundOverlayChild() {}
+func (SnippetElement) isGxTourChild()        {}
+func (SnippetElement) isNetworkLinkChild()   {}
+func (SnippetElement) isPhotoOverlayChild()  {}
+func (SnippetElement) isPlacemarkChild()     {}
+func (SnippetElement) isScreenOverlayChild() {}
+
+// Snippet returns a new snippet element.
+func Snippet(value string) SnippetElement {
+	return SnippetElement{element{kml.Snippet(value)}}
+}
+
+// A StateElement is a state element.
+type StateElement struct {
+	element
+}
+
+func (StateElement) isItemIconChild() {}
+
+// State returns a new state element.
+func State(value kml.ItemIconStateEnum) StateElement {
+	return StateElement{element{kml.State(value)}}
+}
+
+// A StyleURLElement is a styleUrl element.
+type StyleURLElement struct {
+	element
+}
+
+func (StyleURLElement) isDocumentChild()      {}
+func (StyleURLElement) isFolderChild()        {}
+func (StyleURLElement) isGroundOverlayChild() {}
+func (StyleURLElement) isGxTourChild()        {}
+func (StyleURLElement) isNetworkLinkChild()   {}
+func (StyleURLElement) isPairChild()          {}
+func (StyleURLElement) isPhotoOverlayChild()  {}
+func (StyleURLElement) isPlacemarkChild()     {}
+func (StyleURLElement) isScreenOverlayChild() {}
+
+// StyleURL returns a new styleUrl element.
+func StyleURL(value string) StyleURLElement {
+	return StyleURLElement{element{kml.StyleURL(value)}}
+}
+
+// A TargetHrefElement is a targetHref element.
+type TargetHrefElement struct {
+	element
+}
+
+func (TargetHrefElement) isAliasChild()  {}
+func (TargetHrefElement) isUpdateChild() {}
+
+// TargetHref returns a new targetHref element.
+func TargetHref(value string) TargetHrefElement {
+	return TargetHrefElement{element{kml.TargetHref(value)}}
+}
+
+// A TessellateElement is a tessellate element.
+type TessellateElement struct {
+	element
+}
+
+func (TessellateElement) isGxTrackChild()    {}
+func (TessellateElement) isLineStringChild() {}
+func (TessellateElement) isLinearRingChild() {}
+func (TessellateElement) isPolygonChild()    {}
+
+// Tessellate returns a new tessellate element.
+func Tessellate(value bool) TessellateElement {
+	return TessellateElement{element{kml.Tessellate(value)}}
+}
+
+// A TextElement is a text element.
+type TextElement struct {
+	element
+}
+
+func (TextElement) isBalloonStyleChild() {}
+
+// Text returns a new text element.
+func Text(value string) TextElement {
+	return TextElement{element{kml.Text(value)}}
+}
+
+// A TextColorElement is a textColor element.
+type TextColorElement struct {
+	element
+}
+
+func (TextColorElement) isBalloonStyleChild() {}
+
+// TextColor returns a new textColor element.
+func TextColor(value color.Color) TextColorElement {
+	return TextColorElement{element{kml.TextColor(value)}}
+}
+
+// A TileSizeElement is a tileSize element.
+type TileSizeElement struct {
+	element
+}
+
+func (TileSizeElement) isImagePyramidChild() {}
+
+// TileSize returns a new tileSize element.
+func TileSize(value int) TileSizeElement {
+	return TileSizeElement{element{kml.TileSize(value)}}
+}
+
+// A TiltElement is a tilt element.
+type TiltElement struct {
+	element
+}
+
+func (TiltElement) isCameraChild()      {}
+func (TiltElement) isLookAtChild()      {}
+func (TiltElement) isOrientationChild() {}
+
+// Tilt returns a new tilt element.
+func Tilt(value float64) TiltElement {
+	return TiltElement{element{kml.Tilt(value)}}
+}
+
+// A TopFOVElement is a topFov element.
+type TopFOVElement struct {
+	element
+}
+
+func (TopFOVElement) isViewVolumeChild() {}
+
+// TopFOV returns a new topFov element.
+func TopFOV(value float64) TopFOVElement {
+	return TopFOVElement{element{kml.TopFOV(value)}}
+}
+
+// A ValueElement is a value element.
+type ValueElement struct {
+	element
+}
+
+func (ValueElement) isDataChild() {}
+
+// Value returns a new value element.
+func Value(value string) ValueElement {
+	return ValueElement{element{kml.Value(value)}}
+}
+
+// A ViewBoundScaleElement is a viewBoundScale element.
+type ViewBoundScaleElement struct {
+	element
+}
+
+func (ViewBoundScaleElement) isIconChild() {}
+func (ViewBoundScaleElement) isLinkChild() {}
+func (ViewBoundScaleElement) isURLChild()  {}
+
+// ViewBoundScale returns a new viewBoundScale element.
+func ViewBoundScale(value float64) ViewBoundScaleElement {
+	return ViewBoundScaleElement{element{kml.ViewBoundScale(value)}}
+}
+
+// A ViewFormatElement is a viewFormat element.
+type ViewFormatElement struct {
+	element
+}
+
+func (ViewFormatElement) isIconChild() {}
+func (ViewFormatElement) isLinkChild() {}
+func (ViewFormatElement) isURLChild()  {}
+
+// ViewFormat returns a new viewFormat element.
+func ViewFormat(value string) ViewFormatElement {
+	return ViewFormatElement{element{kml.ViewFormat(value)}}
+}
+
+// A ViewRefreshModeElement is a viewRefreshMode element.
+type ViewRefreshModeElement struct {
+	element
+}
+
+func (ViewRefreshModeElement) isIconChild() {}
+func (ViewRefreshModeElement) isLinkChild() {}
+func (ViewRefreshModeElement) isURLChild()  {}
+
+// ViewRefreshMode returns a new viewRefreshMode element.
+func ViewRefreshMode(value kml.ViewRefreshModeEnum) ViewRefreshModeElement {
+	return ViewRefreshModeElement{element{kml.ViewRefreshMode(value)}}
+}
+
+// A ViewRefreshTimeElement is a viewRefreshTime element.
+type ViewRefreshTimeElement struct {
+	element
+}
+
+func (ViewRefreshTimeElement) isIconChild() {}
+func (ViewRefreshTimeElement) isLinkChild() {}
+func (ViewRefreshTimeElement) isURLChild()  {}
+
+// ViewRefreshTime returns a new viewRefreshTime element.
+func ViewRefreshTime(value float64) ViewRefreshTimeElement {
+	return ViewRefreshTimeElement{element{kml.ViewRefreshTime(value)}}
+}
+
+// A VisibilityElement is a visibility element.
+type VisibilityElement struct {
+	element
+}
+
+func (VisibilityElement) isDocumentChild()      {}
+func (VisibilityElement) isFolderChild()        {}
+func (VisibilityElement) isGroundOverlayChild() {}
+func (VisibilityElement) isGxTourChild()        {}
+func (VisibilityElement) isNetworkLinkChild()   {}
+func (VisibilityElement) isPhotoOverlayChild()  {}
+func (VisibilityElement) isPlacemarkChild()     {}
+func (VisibilityElement) isScreenOverlayChild() {}
+
+// Visibility returns a new visibility element.
+func Visibility(value bool) VisibilityElement {
+	return VisibilityElement{element{kml.Visibility(value)}}
+}
+
+// A WestElement is a west element.
+type WestElement struct {
+	element
+}
+
+func (WestElement) isLatLonAltBoxChild() {}
+func (WestElement) isLatLonBoxChild()    {}
+
+// West returns a new west element.
+func West(value float64) WestElement {
+	return WestElement{element{kml.West(value)}}
+}
+
+// A WhenElement is a when element.
+type WhenElement struct {
+	element
+}
+
+func (WhenElement) isGxTimeStampChild() {}
+func (WhenElement) isGxTrackChild()     {}
+func (WhenElement) isTimeStampChild()   {}
+
+// When returns a new when element.
+func When(value time.Time) WhenElement {
+	return WhenElement{element{kml.When(value)}}
+}
+
+// WhenKMLTime returns a new when element with a value of
+// any precision.
+func WhenKMLTime(value kml.KMLTime) WhenElement {
+	return WhenElement{element{kml.WhenKMLTime(value)}}
+}
+
+// A WidthElement is a width element.
+type WidthElement struct {
+	element
+}
+
+func (WidthElement) isLineStyleChild() {}
+
+// Width returns a new width element.
+func Width(value float64) WidthElement {
+	return WidthElement{element{kml.Width(value)}}
+}
+
+// A XElement is a x element.
+type XElement struct {
+	element
+}
+
+func (XElement) isModelScaleChild() {}
+
+// X returns a new x element.
+func X(value float64) XElement {
+	return XElement{element{kml.X(value)}}
+}
+
+// A YElement is a y element.
+type YElement struct {
+	element
+}
+
+func (YElement) isModelScaleChild() {}
+
+// Y returns a new y element.
+func Y(value float64) YElement {
+	return YElement{element{kml.Y(value)}}
+}
+
+// A ZElement is a z element.
+type ZElement struct {
+	element
+}
+
+func (ZElement) isModelScaleChild() {}
+
+// Z returns a new z element.
+func Z(value float64) ZElement {
+	return ZElement{element{kml.Z(value)}}
+}
+
+// A LookAtChild is an element that can be a child of a LookAt element.
+type LookAtChild interface {
+	Child
+	isLookAtChild()
+}
+
+// unwrapLookAtChildren returns the kml.Elements of children.
+func unwrapLookAtChildren(children []LookAtChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// A LookAtElement is a LookAt element.
+type LookAtElement struct {
+	element
+}
+
+func (LookAtElement) isChangeChild()             {}
+func (LookAtElement) isDocumentChild()           {}
+func (LookAtElement) isFolderChild()             {}
+func (LookAtElement) isGroundOverlayChild()      {}
+func (LookAtElement) isGxFlyToChild()            {}
+func (LookAtElement) isGxTourChild()             {}
+func (LookAtElement) isNetworkLinkChild()        {}
+func (LookAtElement) isNetworkLinkControlChild() {}
+func (LookAtElement) isPhotoOverlayChild()       {}
+func (LookAtElement) isPlacemarkChild()          {}
+func (LookAtElement) isScreenOverlayChild()      {}
+
+// LookAt returns a new LookAt element.
+func LookAt(children ...LookAtChild) LookAtElement {
+	return LookAtElement{element{kml.LookAt(unwrapLookAtChildren(children)...)}}
+}
+
+// SharedLookAt returns a new shared LookAt element.
+func SharedLookAt(id string, children ...LookAtChild) LookAtElement {
+	return LookAtElement{element{kml.SharedLookAt(id, unwrapLookAtChildren(children)...)}}
+}
+
+// TargetLookAt returns a new LookAt element with a targetId.
+func TargetLookAt(targetID string, children ...LookAtChild) LookAtElement {
+	return LookAtElement{element{kml.TargetLookAt(targetID, unwrapLookAtChildren(children)...)}}
+}
+
+// A CameraChild is an element that can be a child of a Camera element.
+type CameraChild interface {
+	Child
+	isCameraChild()
+}
+
+// unwrapCameraChildren returns the kml.Elements of children.
+func unwrapCameraChildren(children []CameraChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// A CameraElement is a Camera element.
+type CameraElement struct {
+	element
+}
+
+func (CameraElement) isChangeChild()             {}
+func (CameraElement) isDocumentChild()           {}
+func (CameraElement) isFolderChild()             {}
+func (CameraElement) isGroundOverlayChild()      {}
+func (CameraElement) isGxFlyToChild()            {}
+func (CameraElement) isGxTourChild()             {}
+func (CameraElement) isNetworkLinkChild()        {}
+func (CameraElement) isNetworkLinkControlChild() {}
+func (CameraElement) isPhotoOverlayChild()       {}
+func (CameraElement) isPlacemarkChild()          {}
+func (CameraElement) isScreenOverlayChild()      {}
+
+// Camera returns a new Camera element.
+func Camera(children ...CameraChild) CameraElement {
+	return CameraElement{element{kml.Camera(unwrapCameraChildren(children)...)}}
+}
+
+// SharedCamera returns a new shared Camera element.
+func SharedCamera(id string, children ...CameraChild) CameraElement {
+	return CameraElement{element{kml.SharedCamera(id, unwrapCameraChildren(children)...)}}
+}
+
+// TargetCamera returns a new Camera element with a targetId.
+func TargetCamera(targetID string, children ...CameraChild) CameraElement {
+	return CameraElement{element{kml.TargetCamera(targetID, unwrapCameraChildren(children)...)}}
+}
+
+// A MetadataChild is an element that can be a child of a Metadata element.
+type MetadataChild interface {
+	Child
+	isMetadataChild()
+}
+
+// unwrapMetadataChildren returns the kml.Elements of children.
+func unwrapMetadataChildren(children []MetadataChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// A MetadataElement is a Metadata element.
+type MetadataElement struct {
+	element
+}
+
+func (MetadataElement) isDocumentChild()      {}
+func (MetadataElement) isFolderChild()        {}
+func (MetadataElement) isGroundOverlayChild() {}
+func (MetadataElement) isGxTourChild()        {}
+func (MetadataElement) isNetworkLinkChild()   {}
+func (MetadataElement) isPhotoOverlayChild()  {}
+func (MetadataElement) isPlacemarkChild()     {}
+func (MetadataElement) isScreenOverlayChild() {}
+
+// Metadata returns a new Metadata element.
+func Metadata(children ...MetadataChild) MetadataElement {
+	return MetadataElement{element{kml.Metadata(unwrapMetadataChildren(children)...)}}
+}
+
+// An ExtendedDataChild is an element that can be a child of a ExtendedData element.
+type ExtendedDataChild interface {
+	Child
+	isExtendedDataChild()
+}
+
+// unwrapExtendedDataChildren returns the kml.Elements of children.
+func unwrapExtendedDataChildren(children []ExtendedDataChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// An ExtendedDataElement is a ExtendedData element.
+type ExtendedDataElement struct {
+	element
+}
+
+func (ExtendedDataElement) isDocumentChild()      {}
+func (ExtendedDataElement) isFolderChild()        {}
+func (ExtendedDataElement) isGroundOverlayChild() {}
+func (ExtendedDataElement) isGxTourChild()        {}
+func (ExtendedDataElement) isGxTrackChild()       {}
+func (ExtendedDataElement) isNetworkLinkChild()   {}
+func (ExtendedDataElement) isPhotoOverlayChild()  {}
+func (ExtendedDataElement) isPlacemarkChild()     {}
+func (ExtendedDataElement) isScreenOverlayChild() {}
+
+// ExtendedData returns a new ExtendedData element.
+func ExtendedData(children ...ExtendedDataChild) ExtendedDataElement {
+	return ExtendedDataElement{element{kml.ExtendedData(unwrapExtendedDataChildren(children)...)}}
+}
+
+// A SchemaDataChild is an element that can be a child of a SchemaData element.
+type SchemaDataChild interface {
+	Child
+	isSchemaDataChild()
+}
+
+// unwrapSchemaDataChildren returns the kml.Elements of children.
+func unwrapSchemaDataChildren(children []SchemaDataChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// A SchemaDataElement is a SchemaData element.
+type SchemaDataElement struct {
+	element
+}
+
+func (SchemaDataElement) isChangeChild()       {}
+func (SchemaDataElement) isExtendedDataChild() {}
+
+// SchemaData returns a new SchemaData element.
+func SchemaData(schemaURL string, children ...SchemaDataChild) SchemaDataElement {
+	return SchemaDataElement{element{kml.SchemaData(schemaURL, unwrapSchemaDataChildren(children)...)}}
+}
+
+// SharedSchemaData returns a new shared SchemaData element.
+func SharedSchemaData(id, schemaURL string, children ...SchemaDataChild) SchemaDataElement {
+	return SchemaDataElement{element{kml.SharedSchemaData(id, schemaURL, unwrapSchemaDataChildren(children)...)}}
+}
+
+// TargetSchemaData returns a new SchemaData element with a targetId.
+func TargetSchemaData(targetID, schemaURL string, children ...SchemaDataChild) SchemaDataElement {
+	return SchemaDataElement{element{kml.TargetSchemaData(targetID, schemaURL, unwrapSchemaDataChildren(children)...)}}
+}
+
+// A SimpleDataElement is a SimpleData element.
+type SimpleDataElement struct {
+	element
+}
+
+func (SimpleDataElement) isSchemaDataChild() {}
+
+// SimpleData returns a new SimpleData element.
+func SimpleData(name, value string) SimpleDataElement {
+	return SimpleDataElement{element{kml.SimpleData(name, value)}}
+}
+
+// A DataChild is an element that can be a child of a Data element.
+type DataChild interface {
+	Child
+	isDataChild()
+}
+
+// unwrapDataChildren returns the kml.Elements of children.
+func unwrapDataChildren(children []DataChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// A DataElement is a Data element.
+type DataElement struct {
+	element
+}
+
+func (DataElement) isChangeChild()       {}
+func (DataElement) isExtendedDataChild() {}
+
+// DataWithName returns a new Data element.
+func DataWithName(name string, children ...DataChild) DataElement {
+	return DataElement{element{kml.DataWithName(name, unwrapDataChildren(children)...)}}
+}
+
+// SharedDataWithName returns a new shared Data element.
+func SharedDataWithName(id, name string, children ...DataChild) DataElement {
+	return DataElement{element{kml.SharedDataWithName(id, name, unwrapDataChildren(children)...)}}
+}
+
+// TargetDataWithName returns a new Data element with a targetId.
+func TargetDataWithName(targetID, name string, children ...DataChild) DataElement {
+	return DataElement{element{kml.TargetDataWithName(targetID, name, unwrapDataChildren(children)...)}}
+}
+
+// Data returns a new Data element.
+func Data(children ...DataChild) DataElement {
+	return DataElement{element{kml.Data(unwrapDataChildren(children)...)}}
+}
+
+// SharedData returns a new shared Data element.
+func SharedData(id string, children ...DataChild) DataElement {
+	return DataElement{element{kml.SharedData(id, unwrapDataChildren(children)...)}}
+}
+
+// TargetData returns a new Data element with a targetId.
+func TargetData(targetID string, children ...DataChild) DataElement {
+	return DataElement{element{kml.TargetData(targetID, unwrapDataChildren(children)...)}}
+}
+
+// A KMLChild is an element that can be a child of a kml element.
+type KMLChild interface {
+	Child
+	isKMLChild()
+}
+
+// A KMLElement is a kml element.
+type KMLElement struct {
+	element
+}
+
+// A NetworkLinkControlChild is an element that can be a child of a NetworkLinkControl element.
+type NetworkLinkControlChild interface {
+	Child
+	isNetworkLinkControlChild()
+}
+
+// unwrapNetworkLinkControlChildren returns the kml.Elements of children.
+func unwrapNetworkLinkControlChildren(children []NetworkLinkControlChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// A NetworkLinkControlElement is a NetworkLinkControl element.
+type NetworkLinkControlElement struct {
+	element
+}
+
+func (NetworkLinkControlElement) isKMLChild() {}
+
+// NetworkLinkControl returns a new NetworkLinkControl element.
+func NetworkLinkControl(children ...NetworkLinkControlChild) NetworkLinkControlElement {
+	return NetworkLinkControlElement{element{kml.NetworkLinkControl(unwrapNetworkLinkControlChildren(children)...)}}
+}
+
+// A DocumentChild is an element that can be a child of a Document element.
+type DocumentChild interface {
+	Child
+	isDocumentChild()
+}
+
+// unwrapDocumentChildren returns the kml.Elements of children.
+func unwrapDocumentChildren(children []DocumentChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// A DocumentElement is a Document element.
+type DocumentElement struct {
+	element
+}
+
+func (DocumentElement) isChangeChild()   {}
+func (DocumentElement) isCreateChild()   {}
+func (DocumentElement) isDeleteChild()   {}
+func (DocumentElement) isDocumentChild() {}
+func (DocumentElement) isFolderChild()   {}
+func (DocumentElement) isKMLChild()      {}
+
+// Document returns a new Document element.
+func Document(children ...DocumentChild) DocumentElement {
+	return DocumentElement{element{kml.Document(unwrapDocumentChildren(children)...)}}
+}
+
+// SharedDocument returns a new shared Document element.
+func SharedDocument(id string, children ...DocumentChild) DocumentElement {
+	return DocumentElement{element{kml.SharedDocument(id, unwrapDocumentChildren(children)...)}}
+}
+
+// TargetDocument returns a new Document element with a targetId.
+func TargetDocument(targetID string, children ...DocumentChild) DocumentElement {
+	return DocumentElement{element{kml.TargetDocument(targetID, unwrapDocumentChildren(children)...)}}
+}
+
+// A SchemaChild is an element that can be a child of a Schema element.
+type SchemaChild interface {
+	Child
+	isSchemaChild()
+}
+
+// unwrapSchemaChildren returns the kml.Elements of children.
+func unwrapSchemaChildren(children []SchemaChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// A SchemaElement is a Schema element.
+type SchemaElement struct {
+	element
+}
+
+func (SchemaElement) isDocumentChild() {}
+
+// Schema returns a new Schema element.
+func Schema(id, name string, children ...SchemaChild) SchemaElement {
+	return SchemaElement{element{kml.Schema(id, name, unwrapSchemaChildren(children)...)}}
+}
+
+// A SimpleFieldChild is an element that can be a child of a SimpleField element.
+type SimpleFieldChild interface {
+	Child
+	isSimpleFieldChild()
+}
+
+// unwrapSimpleFieldChildren returns the kml.Elements of children.
+func unwrapSimpleFieldChildren(children []SimpleFieldChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// A SimpleFieldElement is a SimpleField element.
+type SimpleFieldElement struct {
+	element
+}
+
+func (SimpleFieldElement) isSchemaChild() {}
+
+// SimpleField returns a new SimpleField element.
+func SimpleField(name, _type string, children ...SimpleFieldChild) SimpleFieldElement {
+	return SimpleFieldElement{element{kml.SimpleField(name, _type, unwrapSimpleFieldChildren(children)...)}}
+}
+
+// A FolderChild is an element that can be a child of a Folder element.
+type FolderChild interface {
+	Child
+	isFolderChild()
+}
+
+// unwrapFolderChildren returns the kml.Elements of children.
+func unwrapFolderChildren(children []FolderChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// A FolderElement is a Folder element.
+type FolderElement struct {
+	element
+}
+
+func (FolderElement) isChangeChild()   {}
+func (FolderElement) isCreateChild()   {}
+func (FolderElement) isDeleteChild()   {}
+func (FolderElement) isDocumentChild() {}
+func (FolderElement) isFolderChild()   {}
+func (FolderElement) isKMLChild()      {}
+
+// Folder returns a new Folder element.
+func Folder(children ...FolderChild) FolderElement {
+	return FolderElement{element{kml.Folder(unwrapFolderChildren(children)...)}}
+}
+
+// SharedFolder returns a new shared Folder element.
+func SharedFolder(id string, children ...FolderChild) FolderElement {
+	return FolderElement{element{kml.SharedFolder(id, unwrapFolderChildren(children)...)}}
+}
+
+// TargetFolder returns a new Folder element with a targetId.
+func TargetFolder(targetID string, children ...FolderChild) FolderElement {
+	return FolderElement{element{kml.TargetFolder(targetID, unwrapFolderChildren(children)...)}}
+}
+
+// A PlacemarkChild is an element that can be a child of a Placemark element.
+type PlacemarkChild interface {
+	Child
+	isPlacemarkChild()
+}
+
+// unwrapPlacemarkChildren returns the kml.Elements of children.
+func unwrapPlacemarkChildren(children []PlacemarkChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// A PlacemarkElement is a Placemark element.
+type PlacemarkElement struct {
+	element
+}
+
+func (PlacemarkElement) isChangeChild()   {}
+func (PlacemarkElement) isDeleteChild()   {}
+func (PlacemarkElement) isDocumentChild() {}
+func (PlacemarkElement) isFolderChild()   {}
+func (PlacemarkElement) isKMLChild()      {}
+
+// Placemark returns a new Placemark element.
+func Placemark(children ...PlacemarkChild) PlacemarkElement {
+	return PlacemarkElement{element{kml.Placemark(unwrapPlacemarkChildren(children)...)}}
+}
+
+// SharedPlacemark returns a new shared Placemark element.
+func SharedPlacemark(id string, children ...PlacemarkChild) PlacemarkElement {
+	return PlacemarkElement{element{kml.SharedPlacemark(id, unwrapPlacemarkChildren(children)...)}}
+}
+
+// TargetPlacemark returns a new Placemark element with a targetId.
+func TargetPlacemark(targetID string, children ...PlacemarkChild) PlacemarkElement {
+	return PlacemarkElement{element{kml.TargetPlacemark(targetID, unwrapPlacemarkChildren(children)...)}}
+}
+
+// A NetworkLinkChild is an element that can be a child of a NetworkLink element.
+type NetworkLinkChild interface {
+	Child
+	isNetworkLinkChild()
+}
+
+// unwrapNetworkLinkChildren returns the kml.Elements of children.
+func unwrapNetworkLinkChildren(children []NetworkLinkChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// A NetworkLinkElement is a NetworkLink element.
+type NetworkLinkElement struct {
+	element
+}
+
+func (NetworkLinkElement) isChangeChild()   {}
+func (NetworkLinkElement) isDeleteChild()   {}
+func (NetworkLinkElement) isDocumentChild() {}
+func (NetworkLinkElement) isFolderChild()   {}
+func (NetworkLinkElement) isKMLChild()      {}
+
+// NetworkLink returns a new NetworkLink element.
+func NetworkLink(children ...NetworkLinkChild) NetworkLinkElement {
+	return NetworkLinkElement{element{kml.NetworkLink(unwrapNetworkLinkChildren(children)...)}}
+}
+
+// SharedNetworkLink returns a new shared NetworkLink element.
+func SharedNetworkLink(id string, children ...NetworkLinkChild) NetworkLinkElement {
+	return NetworkLinkElement{element{kml.SharedNetworkLink(id, unwrapNetworkLinkChildren(children)...)}}
+}
+
+// TargetNetworkLink returns a new NetworkLink element with a targetId.
+func TargetNetworkLink(targetID string, children ...NetworkLinkChild) NetworkLinkElement {
+	return NetworkLinkElement{element{kml.TargetNetworkLink(targetID, unwrapNetworkLinkChildren(children)...)}}
+}
+
+// A RegionChild is an element that can be a child of a Region element.
+type RegionChild interface {
+	Child
+	isRegionChild()
+}
+
+// unwrapRegionChildren returns the kml.Elements of children.
+func unwrapRegionChildren(children []RegionChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// A RegionElement is a Region element.
+type RegionElement struct {
+	element
+}
+
+func (RegionElement) isChangeChild()        {}
+func (RegionElement) isDocumentChild()      {}
+func (RegionElement) isFolderChild()        {}
+func (RegionElement) isGroundOverlayChild() {}
+func (RegionElement) isGxTourChild()        {}
+func (RegionElement) isNetworkLinkChild()   {}
+func (RegionElement) isPhotoOverlayChild()  {}
+func (RegionElement) isPlacemarkChild()     {}
+func (RegionElement) isScreenOverlayChild() {}
+
+// Region returns a new Region element.
+func Region(children ...RegionChild) RegionElement {
+	return RegionElement{element{kml.Region(unwrapRegionChildren(children)...)}}
+}
+
+// SharedRegion returns a new shared Region element.
+func SharedRegion(id string, children ...RegionChild) RegionElement {
+	return RegionElement{element{kml.SharedRegion(id, unwrapRegionChildren(children)...)}}
+}
+
+// TargetRegion returns a new Region element with a targetId.
+func TargetRegion(targetID string, children ...RegionChild) RegionElement {
+	return RegionElement{element{kml.TargetRegion(targetID, unwrapRegionChildren(children)...)}}
+}
+
+// A LatLonAltBoxChild is an element that can be a child of a LatLonAltBox element.
+type LatLonAltBoxChild interface {
+	Child
+	isLatLonAltBoxChild()
+}
+
+// unwrapLatLonAltBoxChildren returns the kml.Elements of children.
+func unwrapLatLonAltBoxChildren(children []LatLonAltBoxChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// A LatLonAltBoxElement is a LatLonAltBox element.
+type LatLonAltBoxElement struct {
+	element
+}
+
+func (LatLonAltBoxElement) isChangeChild() {}
+func (LatLonAltBoxElement) isRegionChild() {}
+
+// LatLonAltBox returns a new LatLonAltBox element.
+func LatLonAltBox(children ...LatLonAltBoxChild) LatLonAltBoxElement {
+	return LatLonAltBoxElement{element{kml.LatLonAltBox(unwrapLatLonAltBoxChildren(children)...)}}
+}
+
+// SharedLatLonAltBox returns a new shared LatLonAltBox element.
+func SharedLatLonAltBox(id string, children ...LatLonAltBoxChild) LatLonAltBoxElement {
+	return LatLonAltBoxElement{element{kml.SharedLatLonAltBox(id, unwrapLatLonAltBoxChildren(children)...)}}
+}
+
+// TargetLatLonAltBox returns a new LatLonAltBox element with a targetId.
+func TargetLatLonAltBox(targetID string, children ...LatLonAltBoxChild) LatLonAltBoxElement {
+	return LatLonAltBoxElement{element{kml.TargetLatLonAltBox(targetID, unwrapLatLonAltBoxChildren(children)...)}}
+}
+
+// A LODChild is an element that can be a child of a Lod element.
+type LODChild interface {
+	Child
+	isLODChild()
+}
+
+// unwrapLODChildren returns the kml.Elements of children.
+func unwrapLODChildren(children []LODChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// A LODElement is a Lod element.
+type LODElement struct {
+	element
+}
+
+func (LODElement) isChangeChild() {}
+func (LODElement) isRegionChild() {}
+
+// LOD returns a new Lod element.
+func LOD(children ...LODChild) LODElement {
+	return LODElement{element{kml.LOD(unwrapLODChildren(children)...)}}
+}
+
+// SharedLOD returns a new shared Lod element.
+func SharedLOD(id string, children ...LODChild) LODElement {
+	return LODElement{element{kml.SharedLOD(id, unwrapLODChildren(children)...)}}
+}
+
+// TargetLOD returns a new Lod element with a targetId.
+func TargetLOD(targetID string, children ...LODChild) LODElement {
+	return LODElement{element{kml.TargetLOD(targetID, unwrapLODChildren(children)...)}}
+}
+
+// An IconChild is an element that can be a child of a Icon element.
+type IconChild interface {
+	Child
+	isIconChild()
+}
+
+// unwrapIconChildren returns the kml.Elements of children.
+func unwrapIconChildren(children []IconChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// An IconElement is a Icon element.
+type IconElement struct {
+	element
+}
+
+func (IconElement) isChangeChild()        {}
+func (IconElement) isGroundOverlayChild() {}
+func (IconElement) isIconStyleChild()     {}
+func (IconElement) isPhotoOverlayChild()  {}
+func (IconElement) isScreenOverlayChild() {}
+
+// Icon returns a new Icon element.
+func Icon(children ...IconChild) IconElement {
+	return IconElement{element{kml.Icon(unwrapIconChildren(children)...)}}
+}
+
+// SharedIcon returns a new shared Icon element.
+func SharedIcon(id string, children ...IconChild) IconElement {
+	return IconElement{element{kml.SharedIcon(id, unwrapIconChildren(children)...)}}
+}
+
+// TargetIcon returns a new Icon element with a targetId.
+func TargetIcon(targetID string, children ...IconChild) IconElement {
+	return IconElement{element{kml.TargetIcon(targetID, unwrapIconChildren(children)...)}}
+}
+
+// A LinkChild is an element that can be a child of a Link element.
+type LinkChild interface {
+	Child
+	isLinkChild()
+}
+
+// unwrapLinkChildren returns the kml.Elements of children.
+func unwrapLinkChildren(children []LinkChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// A LinkElement is a Link element.
+type LinkElement struct {
+	element
+}
+
+func (LinkElement) isChangeChild()      {}
+func (LinkElement) isModelChild()       {}
+func (LinkElement) isNetworkLinkChild() {}
+
+// Link returns a new Link element.
+func Link(children ...LinkChild) LinkElement {
+	return LinkElement{element{kml.Link(unwrapLinkChildren(children)...)}}
+}
+
+// SharedLink returns a new shared Link element.
+func SharedLink(id string, children ...LinkChild) LinkElement {
+	return LinkElement{element{kml.SharedLink(id, unwrapLinkChildren(children)...)}}
+}
+
+// TargetLink returns a new Link element with a targetId.
+func TargetLink(targetID string, children ...LinkChild) LinkElement {
+	return LinkElement{element{kml.TargetLink(targetID, unwrapLinkChildren(children)...)}}
+}
+
+// An URLChild is an element that can be a child of a Url element.
+type URLChild interface {
+	Child
+	isURLChild()
+}
+
+// unwrapURLChildren returns the kml.Elements of children.
+func unwrapURLChildren(children []URLChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// An URLElement is a Url element.
+type URLElement struct {
+	element
+}
+
+func (URLElement) isChangeChild()      {}
+func (URLElement) isNetworkLinkChild() {}
+
+// URL returns a new Url element.
+func URL(children ...URLChild) URLElement {
+	return URLElement{element{kml.URL(unwrapURLChildren(children)...)}}
+}
+
+// SharedURL returns a new shared Url element.
+func SharedURL(id string, children ...URLChild) URLElement {
+	return URLElement{element{kml.SharedURL(id, unwrapURLChildren(children)...)}}
+}
+
+// TargetURL returns a new Url element with a targetId.
+func TargetURL(targetID string, children ...URLChild) URLElement {
+	return URLElement{element{kml.TargetURL(targetID, unwrapURLChildren(children)...)}}
+}
+
+// A MultiGeometryChild is an element that can be a child of a MultiGeometry element.
+type MultiGeometryChild interface {
+	Child
+	isMultiGeometryChild()
+}
+
+// unwrapMultiGeometryChildren returns the kml.Elements of children.
+func unwrapMultiGeometryChildren(children []MultiGeometryChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// A MultiGeometryElement is a MultiGeometry element.
+type MultiGeometryElement struct {
+	element
+}
+
+func (MultiGeometryElement) isChangeChild()        {}
+func (MultiGeometryElement) isMultiGeometryChild() {}
+func (MultiGeometryElement) isPlacemarkChild()     {}
+
+// MultiGeometry returns a new MultiGeometry element.
+func MultiGeometry(children ...MultiGeometryChild) MultiGeometryElement {
+	return MultiGeometryElement{element{kml.MultiGeometry(unwrapMultiGeometryChildren(children)...)}}
+}
+
+// SharedMultiGeometry returns a new shared MultiGeometry element.
+func SharedMultiGeometry(id string, children ...MultiGeometryChild) MultiGeometryElement {
+	return MultiGeometryElement{element{kml.SharedMultiGeometry(id, unwrapMultiGeometryChildren(children)...)}}
+}
+
+// TargetMultiGeometry returns a new MultiGeometry element with a targetId.
+func TargetMultiGeometry(targetID string, children ...MultiGeometryChild) MultiGeometryElement {
+	return MultiGeometryElement{element{kml.TargetMultiGeometry(targetID, unwrapMultiGeometryChildren(children)...)}}
+}
+
+// A PointChild is an element that can be a child of a Point element.
+type PointChild interface {
+	Child
+	isPointChild()
+}
+
+// unwrapPointChildren returns the kml.Elements of children.
+func unwrapPointChildren(children []PointChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// A PointElement is a Point element.
+type PointElement struct {
+	element
+}
+
+func (PointElement) isChangeChild()        {}
+func (PointElement) isMultiGeometryChild() {}
+func (PointElement) isPhotoOverlayChild()  {}
+func (PointElement) isPlacemarkChild()     {}
+
+// Point returns a new Point element.
+func Point(children ...PointChild) PointElement {
+	return PointElement{element{kml.Point(unwrapPointChildren(children)...)}}
+}
+
+// SharedPoint returns a new shared Point element.
+func SharedPoint(id string, children ...PointChild) PointElement {
+	return PointElement{element{kml.SharedPoint(id, unwrapPointChildren(children)...)}}
+}
+
+// TargetPoint returns a new Point element with a targetId.
+func TargetPoint(targetID string, children ...PointChild) PointElement {
+	return PointElement{element{kml.TargetPoint(targetID, unwrapPointChildren(children)...)}}
+}
+
+// A LineStringChild is an element that can be a child of a LineString element.
+type LineStringChild interface {
+	Child
+	isLineStringChild()
+}
+
+// unwrapLineStringChildren returns the kml.Elements of children.
+func unwrapLineStringChildren(children []LineStringChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// A LineStringElement is a LineString element.
+type LineStringElement struct {
+	element
+}
+
+func (LineStringElement) isChangeChild()        {}
+func (LineStringElement) isMultiGeometryChild() {}
+func (LineStringElement) isPlacemarkChild()     {}
+
+// LineString returns a new LineString element.
+func LineString(children ...LineStringChild) LineStringElement {
+	return LineStringElement{element{kml.LineString(unwrapLineStringChildren(children)...)}}
+}
+
+// SharedLineString returns a new shared LineString element.
+func SharedLineString(id string, children ...LineStringChild) LineStringElement {
+	return LineStringElement{element{kml.SharedLineString(id, unwrapLineStringChildren(children)...)}}
+}
+
+// TargetLineString returns a new LineString element with a targetId.
+func TargetLineString(targetID string, children ...LineStringChild) LineStringElement {
+	return LineStringElement{element{kml.TargetLineString(targetID, unwrapLineStringChildren(children)...)}}
+}
+
+// A LinearRingChild is an element that can be a child of a LinearRing element.
+type LinearRingChild interface {
+	Child
+	isLinearRingChild()
+}
+
+// unwrapLinearRingChildren returns the kml.Elements of children.
+func unwrapLinearRingChildren(children []LinearRingChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// A LinearRingElement is a LinearRing element.
+type LinearRingElement struct {
+	element
+}
+
+func (LinearRingElement) isChangeChild()          {}
+func (LinearRingElement) isInnerBoundaryIsChild() {}
+func (LinearRingElement) isMultiGeometryChild()   {}
+func (LinearRingElement) isOuterBoundaryIsChild() {}
+func (LinearRingElement) isPlacemarkChild()       {}
+
+// LinearRing returns a new LinearRing element.
+func LinearRing(children ...LinearRingChild) LinearRingElement {
+	return LinearRingElement{element{kml.LinearRing(unwrapLinearRingChildren(children)...)}}
+}
+
+// SharedLinearRing returns a new shared LinearRing element.
+func SharedLinearRing(id string, children ...LinearRingChild) LinearRingElement {
+	return LinearRingElement{element{kml.SharedLinearRing(id, unwrapLinearRingChildren(children)...)}}
+}
+
+// TargetLinearRing returns a new LinearRing element with a targetId.
+func TargetLinearRing(targetID string, children ...LinearRingChild) LinearRingElement {
+	return LinearRingElement{element{kml.TargetLinearRing(targetID, unwrapLinearRingChildren(children)...)}}
+}
+
+// A PolygonChild is an element that can be a child of a Polygon element.
+type PolygonChild interface {
+	Child
+	isPolygonChild()
+}
+
+// unwrapPolygonChildren returns the kml.Elements of children.
+func unwrapPolygonChildren(children []PolygonChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// A PolygonElement is a Polygon element.
+type PolygonElement struct {
+	element
+}
+
+func (PolygonElement) isChangeChild()        {}
+func (PolygonElement) isMultiGeometryChild() {}
+func (PolygonElement) isPlacemarkChild()     {}
+
+// Polygon returns a new Polygon element.
+func Polygon(children ...PolygonChild) PolygonElement {
+	return PolygonElement{element{kml.Polygon(unwrapPolygonChildren(children)...)}}
+}
+
+// SharedPolygon returns a new shared Polygon element.
+func SharedPolygon(id string, children ...PolygonChild) PolygonElement {
+	return PolygonElement{element{kml.SharedPolygon(id, unwrapPolygonChildren(children)...)}}
+}
+
+// TargetPolygon returns a new Polygon element with a targetId.
+func TargetPolygon(targetID string, children ...PolygonChild) PolygonElement {
+	return PolygonElement{element{kml.TargetPolygon(targetID, unwrapPolygonChildren(children)...)}}
+}
+
+// An OuterBoundaryIsChild is an element that can be a child of an outerBoundaryIs element.
+type OuterBoundaryIsChild interface {
+	Child
+	isOuterBoundaryIsChild()
+}
+
+// An OuterBoundaryIsElement is an outerBoundaryIs element.
+type OuterBoundaryIsElement struct {
+	element
+}
+
+func (OuterBoundaryIsElement) isPolygonChild() {}
+
+// OuterBoundaryIs returns a new outerBoundaryIs element.
+func OuterBoundaryIs(child OuterBoundaryIsChild) OuterBoundaryIsElement {
+	return OuterBoundaryIsElement{element{kml.OuterBoundaryIs(child.kmlElement())}}
+}
+
+// An InnerBoundaryIsChild is an element that can be a child of an innerBoundaryIs element.
+type InnerBoundaryIsChild interface {
+	Child
+	isInnerBoundaryIsChild()
+}
+
+// An InnerBoundaryIsElement is an innerBoundaryIs element.
+type InnerBoundaryIsElement struct {
+	element
+}
+
+func (InnerBoundaryIsElement) isPolygonChild() {}
+
+// InnerBoundaryIs returns a new innerBoundaryIs element.
+func InnerBoundaryIs(child InnerBoundaryIsChild) InnerBoundaryIsElement {
+	return InnerBoundaryIsElement{element{kml.InnerBoundaryIs(child.kmlElement())}}
+}
+
+// A ModelChild is an element that can be a child of a Model element.
+type ModelChild interface {
+	Child
+	isModelChild()
+}
+
+// unwrapModelChildren returns the kml.Elements of children.
+func unwrapModelChildren(children []ModelChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// A ModelElement is a Model element.
+type ModelElement struct {
+	element
+}
+
+func (ModelElement) isChangeChild()        {}
+func (ModelElement) isGxTrackChild()       {}
+func (ModelElement) isMultiGeometryChild() {}
+func (ModelElement) isPlacemarkChild()     {}
+
+// Model returns a new Model element.
+func Model(children ...ModelChild) ModelElement {
+	return ModelElement{element{kml.Model(unwrapModelChildren(children)...)}}
+}
+
+// SharedModel returns a new shared Model element.
+func SharedModel(id string, children ...ModelChild) ModelElement {
+	return ModelElement{element{kml.SharedModel(id, unwrapModelChildren(children)...)}}
+}
+
+// TargetModel returns a new Model element with a targetId.
+func TargetModel(targetID string, children ...ModelChild) ModelElement {
+	return ModelElement{element{kml.TargetModel(targetID, unwrapModelChildren(children)...)}}
+}
+
+// A LocationChild is an element that can be a child of a Location element.
+type LocationChild interface {
+	Child
+	isLocationChild()
+}
+
+// unwrapLocationChildren returns the kml.Elements of children.
+func unwrapLocationChildren(children []LocationChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// A LocationElement is a Location element.
+type LocationElement struct {
+	element
+}
+
+func (LocationElement) isChangeChild() {}
+func (LocationElement) isModelChild()  {}
+
+// Location returns a new Location element.
+func Location(children ...LocationChild) LocationElement {
+	return LocationElement{element{kml.Location(unwrapLocationChildren(children)...)}}
+}
+
+// SharedLocation returns a new shared Location element.
+func SharedLocation(id string, children ...LocationChild) LocationElement {
+	return LocationElement{element{kml.SharedLocation(id, unwrapLocationChildren(children)...)}}
+}
+
+// TargetLocation returns a new Location element with a targetId.
+func TargetLocation(targetID string, children ...LocationChild) LocationElement {
+	return LocationElement{element{kml.TargetLocation(targetID, unwrapLocationChildren(children)...)}}
+}
+
+// An OrientationChild is an element that can be a child of a Orientation element.
+type OrientationChild interface {
+	Child
+	isOrientationChild()
+}
+
+// unwrapOrientationChildren returns the kml.Elements of children.
+func unwrapOrientationChildren(children []OrientationChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// An OrientationElement is a Orientation element.
+type OrientationElement struct {
+	element
+}
+
+func (OrientationElement) isChangeChild() {}
+func (OrientationElement) isModelChild()  {}
+
+// Orientation returns a new Orientation element.
+func Orientation(children ...OrientationChild) OrientationElement {
+	return OrientationElement{element{kml.Orientation(unwrapOrientationChildren(children)...)}}
+}
+
+// SharedOrientation returns a new shared Orientation element.
+func SharedOrientation(id string, children ...OrientationChild) OrientationElement {
+	return OrientationElement{element{kml.SharedOrientation(id, unwrapOrientationChildren(children)...)}}
+}
+
+// TargetOrientation returns a new Orientation element with a targetId.
+func TargetOrientation(targetID string, children ...OrientationChild) OrientationElement {
+	return OrientationElement{element{kml.TargetOrientation(targetID, unwrapOrientationChildren(children)...)}}
+}
+
+// A ModelScaleChild is an element that can be a child of a Scale element.
+type ModelScaleChild interface {
+	Child
+	isModelScaleChild()
+}
+
+// unwrapModelScaleChildren returns the kml.Elements of children.
+func unwrapModelScaleChildren(children []ModelScaleChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// A ModelScaleElement is a Scale element.
+type ModelScaleElement struct {
+	element
+}
+
+func (ModelScaleElement) isChangeChild() {}
+func (ModelScaleElement) isModelChild()  {}
+
+// ModelScale returns a new Scale element.
+func ModelScale(children ...ModelScaleChild) ModelScaleElement {
+	return ModelScaleElement{element{kml.ModelScale(unwrapModelScaleChildren(children)...)}}
+}
+
+// SharedModelScale returns a new shared Scale element.
+func SharedModelScale(id string, children ...ModelScaleChild) ModelScaleElement {
+	return ModelScaleElement{element{kml.SharedModelScale(id, unwrapModelScaleChildren(children)...)}}
+}
+
+// TargetModelScale returns a new Scale element with a targetId.
+func TargetModelScale(targetID string, children ...ModelScaleChild) ModelScaleElement {
+	return ModelScaleElement{element{kml.TargetModelScale(targetID, unwrapModelScaleChildren(children)...)}}
+}
+
+// A ResourceMapChild is an element that can be a child of a ResourceMap element.
+type ResourceMapChild interface {
+	Child
+	isResourceMapChild()
+}
+
+// unwrapResourceMapChildren returns the kml.Elements of children.
+func unwrapResourceMapChildren(children []ResourceMapChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// A ResourceMapElement is a ResourceMap element.
+type ResourceMapElement struct {
+	element
+}
+
+func (ResourceMapElement) isChangeChild() {}
+func (ResourceMapElement) isModelChild()  {}
+
+// ResourceMap returns a new ResourceMap element.
+func ResourceMap(children ...ResourceMapChild) ResourceMapElement {
+	return ResourceMapElement{element{kml.ResourceMap(unwrapResourceMapChildren(children)...)}}
+}
+
+// SharedResourceMap returns a new shared ResourceMap element.
+func SharedResourceMap(id string, children ...ResourceMapChild) ResourceMapElement {
+	return ResourceMapElement{element{kml.SharedResourceMap(id, unwrapResourceMapChildren(children)...)}}
+}
+
+// TargetResourceMap returns a new ResourceMap element with a targetId.
+func TargetResourceMap(targetID string, children ...ResourceMapChild) ResourceMapElement {
+	return ResourceMapElement{element{kml.TargetResourceMap(targetID, unwrapResourceMapChildren(children)...)}}
+}
+
+// An AliasChild is an element that can be a child of a Alias element.
+type AliasChild interface {
+	Child
+	isAliasChild()
+}
+
+// unwrapAliasChildren returns the kml.Elements of children.
+func unwrapAliasChildren(children []AliasChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// An AliasElement is a Alias element.
+type AliasElement struct {
+	element
+}
+
+func (AliasElement) isChangeChild()      {}
+func (AliasElement) isResourceMapChild() {}
+
+// Alias returns a new Alias element.
+func Alias(children ...AliasChild) AliasElement {
+	return AliasElement{element{kml.Alias(unwrapAliasChildren(children)...)}}
+}
+
+// SharedAlias returns a new shared Alias element.
+func SharedAlias(id string, children ...AliasChild) AliasElement {
+	return AliasElement{element{kml.SharedAlias(id, unwrapAliasChildren(children)...)}}
+}
+
+// TargetAlias returns a new Alias element with a targetId.
+func TargetAlias(targetID string, children ...AliasChild) AliasElement {
+	return AliasElement{element{kml.TargetAlias(targetID, unwrapAliasChildren(children)...)}}
+}
+
+// A GroundOverlayChild is an element that can be a child of a GroundOverlay element.
+type GroundOverlayChild interface {
+	Child
+	isGroundOverlayChild()
+}
+
+// unwrapGroundOverlayChildren returns the kml.Elements of children.
+func unwrapGroundOverlayChildren(children []GroundOverlayChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// A GroundOverlayElement is a GroundOverlay element.
+type GroundOverlayElement struct {
+	element
+}
+
+func (GroundOverlayElement) isChangeChild()   {}
+func (GroundOverlayElement) isDeleteChild()   {}
+func (GroundOverlayElement) isDocumentChild() {}
+func (GroundOverlayElement) isFolderChild()   {}
+func (GroundOverlayElement) isKMLChild()      {}
+
+// GroundOverlay returns a new GroundOverlay element.
+func GroundOverlay(children ...GroundOverlayChild) GroundOverlayElement {
+	return GroundOverlayElement{element{kml.GroundOverlay(unwrapGroundOverlayChildren(children)...)}}
+}
+
+// SharedGroundOverlay returns a new shared GroundOverlay element.
+func SharedGroundOverlay(id string, children ...GroundOverlayChild) GroundOverlayElement {
+	return GroundOverlayElement{element{kml.SharedGroundOverlay(id, unwrapGroundOverlayChildren(children)...)}}
+}
+
+// TargetGroundOverlay returns a new GroundOverlay element with a targetId.
+func TargetGroundOverlay(targetID string, children ...GroundOverlayChild) GroundOverlayElement {
+	return GroundOverlayElement{element{kml.TargetGroundOverlay(targetID, unwrapGroundOverlayChildren(children)...)}}
+}
+
+// A LatLonBoxChild is an element that can be a child of a LatLonBox element.
+type LatLonBoxChild interface {
+	Child
+	isLatLonBoxChild()
+}
+
+// unwrapLatLonBoxChildren returns the kml.Elements of children.
+func unwrapLatLonBoxChildren(children []LatLonBoxChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// A LatLonBoxElement is a LatLonBox element.
+type LatLonBoxElement struct {
+	element
+}
+
+func (LatLonBoxElement) isChangeChild()        {}
+func (LatLonBoxElement) isGroundOverlayChild() {}
+
+// LatLonBox returns a new LatLonBox element.
+func LatLonBox(children ...LatLonBoxChild) LatLonBoxElement {
+	return LatLonBoxElement{element{kml.LatLonBox(unwrapLatLonBoxChildren(children)...)}}
+}
+
+// SharedLatLonBox returns a new shared LatLonBox element.
+func SharedLatLonBox(id string, children ...LatLonBoxChild) LatLonBoxElement {
+	return LatLonBoxElement{element{kml.SharedLatLonBox(id, unwrapLatLonBoxChildren(children)...)}}
+}
+
+// TargetLatLonBox returns a new LatLonBox element with a targetId.
+func TargetLatLonBox(targetID string, children ...LatLonBoxChild) LatLonBoxElement {
+	return LatLonBoxElement{element{kml.TargetLatLonBox(targetID, unwrapLatLonBoxChildren(children)...)}}
+}
+
+// A ScreenOverlayChild is an element that can be a child of a ScreenOverlay element.
+type ScreenOverlayChild interface {
+	Child
+	isScreenOverlayChild()
+}
+
+// unwrapScreenOverlayChildren returns the kml.Elements of children.
+func unwrapScreenOverlayChildren(children []ScreenOverlayChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// A ScreenOverlayElement is a ScreenOverlay element.
+type ScreenOverlayElement struct {
+	element
+}
+
+func (ScreenOverlayElement) isChangeChild()   {}
+func (ScreenOverlayElement) isDeleteChild()   {}
+func (ScreenOverlayElement) isDocumentChild() {}
+func (ScreenOverlayElement) isFolderChild()   {}
+func (ScreenOverlayElement) isKMLChild()      {}
+
+// ScreenOverlay returns a new ScreenOverlay element.
+func ScreenOverlay(children ...ScreenOverlayChild) ScreenOverlayElement {
+	return ScreenOverlayElement{element{kml.ScreenOverlay(unwrapScreenOverlayChildren(children)...)}}
+}
+
+// SharedScreenOverlay returns a new shared ScreenOverlay element.
+func SharedScreenOverlay(id string, children ...ScreenOverlayChild) ScreenOverlayElement {
+	return ScreenOverlayElement{element{kml.SharedScreenOverlay(id, unwrapScreenOverlayChildren(children)...)}}
+}
+
+// TargetScreenOverlay returns a new ScreenOverlay element with a targetId.
+func TargetScreenOverlay(targetID string, children ...ScreenOverlayChild) ScreenOverlayElement {
+	return ScreenOverlayElement{element{kml.TargetScreenOverlay(targetID, unwrapScreenOverlayChildren(children)...)}}
+}
+
+// A PhotoOverlayChild is an element that can be a child of a PhotoOverlay element.
+type PhotoOverlayChild interface {
+	Child
+	isPhotoOverlayChild()
+}
+
+// unwrapPhotoOverlayChildren returns the kml.Elements of children.
+func unwrapPhotoOverlayChildren(children []PhotoOverlayChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// A PhotoOverlayElement is a PhotoOverlay element.
+type PhotoOverlayElement struct {
+	element
+}
+
+func (PhotoOverlayElement) isChangeChild()   {}
+func (PhotoOverlayElement) isDeleteChild()   {}
+func (PhotoOverlayElement) isDocumentChild() {}
+func (PhotoOverlayElement) isFolderChild()   {}
+func (PhotoOverlayElement) isKMLChild()      {}
+
+// PhotoOverlay returns a new PhotoOverlay element.
+func PhotoOverlay(children ...PhotoOverlayChild) PhotoOverlayElement {
+	return PhotoOverlayElement{element{kml.PhotoOverlay(unwrapPhotoOverlayChildren(children)...)}}
+}
+
+// SharedPhotoOverlay returns a new shared PhotoOverlay element.
+func SharedPhotoOverlay(id string, children ...PhotoOverlayChild) PhotoOverlayElement {
+	return PhotoOverlayElement{element{kml.SharedPhotoOverlay(id, unwrapPhotoOverlayChildren(children)...)}}
+}
+
+// TargetPhotoOverlay returns a new PhotoOverlay element with a targetId.
+func TargetPhotoOverlay(targetID string, children ...PhotoOverlayChild) PhotoOverlayElement {
+	return PhotoOverlayElement{element{kml.TargetPhotoOverlay(targetID, unwrapPhotoOverlayChildren(children)...)}}
+}
+
+// A ViewVolumeChild is an element that can be a child of a ViewVolume element.
+type ViewVolumeChild interface {
+	Child
+	isViewVolumeChild()
+}
+
+// unwrapViewVolumeChildren returns the kml.Elements of children.
+func unwrapViewVolumeChildren(children []ViewVolumeChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// A ViewVolumeElement is a ViewVolume element.
+type ViewVolumeElement struct {
+	element
+}
+
+func (ViewVolumeElement) isChangeChild()       {}
+func (ViewVolumeElement) isPhotoOverlayChild() {}
+
+// ViewVolume returns a new ViewVolume element.
+func ViewVolume(children ...ViewVolumeChild) ViewVolumeElement {
+	return ViewVolumeElement{element{kml.ViewVolume(unwrapViewVolumeChildren(children)...)}}
+}
+
+// SharedViewVolume returns a new shared ViewVolume element.
+func SharedViewVolume(id string, children ...ViewVolumeChild) ViewVolumeElement {
+	return ViewVolumeElement{element{kml.SharedViewVolume(id, unwrapViewVolumeChildren(children)...)}}
+}
+
+// TargetViewVolume returns a new ViewVolume element with a targetId.
+func TargetViewVolume(targetID string, children ...ViewVolumeChild) ViewVolumeElement {
+	return ViewVolumeElement{element{kml.TargetViewVolume(targetID, unwrapViewVolumeChildren(children)...)}}
+}
+
+// An ImagePyramidChild is an element that can be a child of a ImagePyramid element.
+type ImagePyramidChild interface {
+	Child
+	isImagePyramidChild()
+}
+
+// unwrapImagePyramidChildren returns the kml.Elements of children.
+func unwrapImagePyramidChildren(children []ImagePyramidChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// An ImagePyramidElement is a ImagePyramid element.
+type ImagePyramidElement struct {
+	element
+}
+
+func (ImagePyramidElement) isChangeChild()       {}
+func (ImagePyramidElement) isPhotoOverlayChild() {}
+
+// ImagePyramid returns a new ImagePyramid element.
+func ImagePyramid(children ...ImagePyramidChild) ImagePyramidElement {
+	return ImagePyramidElement{element{kml.ImagePyramid(unwrapImagePyramidChildren(children)...)}}
+}
+
+// SharedImagePyramid returns a new shared ImagePyramid element.
+func SharedImagePyramid(id string, children ...ImagePyramidChild) ImagePyramidElement {
+	return ImagePyramidElement{element{kml.SharedImagePyramid(id, unwrapImagePyramidChildren(children)...)}}
+}
+
+// TargetImagePyramid returns a new ImagePyramid element with a targetId.
+func TargetImagePyramid(targetID string, children ...ImagePyramidChild) ImagePyramidElement {
+	return ImagePyramidElement{element{kml.TargetImagePyramid(targetID, unwrapImagePyramidChildren(children)...)}}
+}
+
+// A StyleChild is an element that can be a child of a Style element.
+type StyleChild interface {
+	Child
+	isStyleChild()
+}
+
+// unwrapStyleChildren returns the kml.Elements of children.
+func unwrapStyleChildren(children []StyleChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// A StyleElement is a Style element.
+type StyleElement struct {
+	element
+}
+
+func (StyleElement) isChangeChild()        {}
+func (StyleElement) isDocumentChild()      {}
+func (StyleElement) isFolderChild()        {}
+func (StyleElement) isGroundOverlayChild() {}
+func (StyleElement) isGxTourChild()        {}
+func (StyleElement) isNetworkLinkChild()   {}
+func (StyleElement) isPairChild()          {}
+func (StyleElement) isPhotoOverlayChild()  {}
+func (StyleElement) isPlacemarkChild()     {}
+func (StyleElement) isScreenOverlayChild() {}
+
+// Style returns a new Style element.
+func Style(children ...StyleChild) StyleElement {
+	return StyleElement{element{kml.Style(unwrapStyleChildren(children)...)}}
+}
+
+// SharedStyle returns a new shared Style element.
+func SharedStyle(id string, children ...StyleChild) StyleElement {
+	return StyleElement{element{kml.SharedStyle(id, unwrapStyleChildren(children)...)}}
+}
+
+// TargetStyle returns a new Style element with a targetId.
+func TargetStyle(targetID string, children ...StyleChild) StyleElement {
+	return StyleElement{element{kml.TargetStyle(targetID, unwrapStyleChildren(children)...)}}
+}
+
+// A StyleMapChild is an element that can be a child of a StyleMap element.
+type StyleMapChild interface {
+	Child
+	isStyleMapChild()
+}
+
+// unwrapStyleMapChildren returns the kml.Elements of children.
+func unwrapStyleMapChildren(children []StyleMapChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// A StyleMapElement is a StyleMap element.
+type StyleMapElement struct {
+	element
+}
+
+func (StyleMapElement) isChangeChild()        {}
+func (StyleMapElement) isDocumentChild()      {}
+func (StyleMapElement) isFolderChild()        {}
+func (StyleMapElement) isGroundOverlayChild() {}
+func (StyleMapElement) isGxTourChild()        {}
+func (StyleMapElement) isNetworkLinkChild()   {}
+func (StyleMapElement) isPairChild()          {}
+func (StyleMapElement) isPhotoOverlayChild()  {}
+func (StyleMapElement) isPlacemarkChild()     {}
+func (StyleMapElement) isScreenOverlayChild() {}
+
+// StyleMap returns a new StyleMap element.
+func StyleMap(children ...StyleMapChild) StyleMapElement {
+	return StyleMapElement{element{kml.StyleMap(unwrapStyleMapChildren(children)...)}}
+}
+
+// SharedStyleMap returns a new shared StyleMap element.
+func SharedStyleMap(id string, children ...StyleMapChild) StyleMapElement {
+	return StyleMapElement{element{kml.SharedStyleMap(id, unwrapStyleMapChildren(children)...)}}
+}
+
+// TargetStyleMap returns a new StyleMap element with a targetId.
+func TargetStyleMap(targetID string, children ...StyleMapChild) StyleMapElement {
+	return StyleMapElement{element{kml.TargetStyleMap(targetID, unwrapStyleMapChildren(children)...)}}
+}
+
+// A PairChild is an element that can be a child of a Pair element.
+type PairChild interface {
+	Child
+	isPairChild()
+}
+
+// unwrapPairChildren returns the kml.Elements of children.
+func unwrapPairChildren(children []PairChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// A PairElement is a Pair element.
+type PairElement struct {
+	element
+}
+
+func (PairElement) isChangeChild()   {}
+func (PairElement) isStyleMapChild() {}
+
+// Pair returns a new Pair element.
+func Pair(children ...PairChild) PairElement {
+	return PairElement{element{kml.Pair(unwrapPairChildren(children)...)}}
+}
+
+// SharedPair returns a new shared Pair element.
+func SharedPair(id string, children ...PairChild) PairElement {
+	return PairElement{element{kml.SharedPair(id, unwrapPairChildren(children)...)}}
+}
+
+// TargetPair returns a new Pair element with a targetId.
+func TargetPair(targetID string, children ...PairChild) PairElement {
+	return PairElement{element{kml.TargetPair(targetID, unwrapPairChildren(children)...)}}
+}
+
+// An IconStyleChild is an element that can be a child of a IconStyle element.
+type IconStyleChild interface {
+	Child
+	isIconStyleChild()
+}
+
+// unwrapIconStyleChildren returns the kml.Elements of children.
+func unwrapIconStyleChildren(children []IconStyleChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// An IconStyleElement is a IconStyle element.
+type IconStyleElement struct {
+	element
+}
+
+func (IconStyleElement) isChangeChild() {}
+func (IconStyleElement) isStyleChild()  {}
+
+// IconStyle returns a new IconStyle element.
+func IconStyle(children ...IconStyleChild) IconStyleElement {
+	return IconStyleElement{element{kml.IconStyle(unwrapIconStyleChildren(children)...)}}
+}
+
+// SharedIconStyle returns a new shared IconStyle element.
+func SharedIconStyle(id string, children ...IconStyleChild) IconStyleElement {
+	return IconStyleElement{element{kml.SharedIconStyle(id, unwrapIconStyleChildren(children)...)}}
+}
+
+// TargetIconStyle returns a new IconStyle element with a targetId.
+func TargetIconStyle(targetID string, children ...IconStyleChild) IconStyleElement {
+	return IconStyleElement{element{kml.TargetIconStyle(targetID, unwrapIconStyleChildren(children)...)}}
+}
+
+// A LabelStyleChild is an element that can be a child of a LabelStyle element.
+type LabelStyleChild interface {
+	Child
+	isLabelStyleChild()
+}
+
+// unwrapLabelStyleChildren returns the kml.Elements of children.
+func unwrapLabelStyleChildren(children []LabelStyleChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// A LabelStyleElement is a LabelStyle element.
+type LabelStyleElement struct {
+	element
+}
+
+func (LabelStyleElement) isChangeChild() {}
+func (LabelStyleElement) isStyleChild()  {}
+
+// LabelStyle returns a new LabelStyle element.
+func LabelStyle(children ...LabelStyleChild) LabelStyleElement {
+	return LabelStyleElement{element{kml.LabelStyle(unwrapLabelStyleChildren(children)...)}}
+}
+
+// SharedLabelStyle returns a new shared LabelStyle element.
+func SharedLabelStyle(id string, children ...LabelStyleChild) LabelStyleElement {
+	return LabelStyleElement{element{kml.SharedLabelStyle(id, unwrapLabelStyleChildren(children)...)}}
+}
+
+// TargetLabelStyle returns a new LabelStyle element with a targetId.
+func TargetLabelStyle(targetID string, children ...LabelStyleChild) LabelStyleElement {
+	return LabelStyleElement{element{kml.TargetLabelStyle(targetID, unwrapLabelStyleChildren(children)...)}}
+}
+
+// A LineStyleChild is an element that can be a child of a LineStyle element.
+type LineStyleChild interface {
+	Child
+	isLineStyleChild()
+}
+
+// unwrapLineStyleChildren returns the kml.Elements of children.
+func unwrapLineStyleChildren(children []LineStyleChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// A LineStyleElement is a LineStyle element.
+type LineStyleElement struct {
+	element
+}
+
+func (LineStyleElement) isChangeChild() {}
+func (LineStyleElement) isStyleChild()  {}
+
+// LineStyle returns a new LineStyle element.
+func LineStyle(children ...LineStyleChild) LineStyleElement {
+	return LineStyleElement{element{kml.LineStyle(unwrapLineStyleChildren(children)...)}}
+}
+
+// SharedLineStyle returns a new shared LineStyle element.
+func SharedLineStyle(id string, children ...LineStyleChild) LineStyleElement {
+	return LineStyleElement{element{kml.SharedLineStyle(id, unwrapLineStyleChildren(children)...)}}
+}
+
+// TargetLineStyle returns a new LineStyle element with a targetId.
+func TargetLineStyle(targetID string, children ...LineStyleChild) LineStyleElement {
+	return LineStyleElement{element{kml.TargetLineStyle(targetID, unwrapLineStyleChildren(children)...)}}
+}
+
+// A PolyStyleChild is an element that can be a child of a PolyStyle element.
+type PolyStyleChild interface {
+	Child
+	isPolyStyleChild()
+}
+
+// unwrapPolyStyleChildren returns the kml.Elements of children.
+func unwrapPolyStyleChildren(children []PolyStyleChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// A PolyStyleElement is a PolyStyle element.
+type PolyStyleElement struct {
+	element
+}
+
+func (PolyStyleElement) isChangeChild() {}
+func (PolyStyleElement) isStyleChild()  {}
+
+// PolyStyle returns a new PolyStyle element.
+func PolyStyle(children ...PolyStyleChild) PolyStyleElement {
+	return PolyStyleElement{element{kml.PolyStyle(unwrapPolyStyleChildren(children)...)}}
+}
+
+// SharedPolyStyle returns a new shared PolyStyle element.
+func SharedPolyStyle(id string, children ...PolyStyleChild) PolyStyleElement {
+	return PolyStyleElement{element{kml.SharedPolyStyle(id, unwrapPolyStyleChildren(children)...)}}
+}
+
+// TargetPolyStyle returns a new PolyStyle element with a targetId.
+func TargetPolyStyle(targetID string, children ...PolyStyleChild) PolyStyleElement {
+	return PolyStyleElement{element{kml.TargetPolyStyle(targetID, unwrapPolyStyleChildren(children)...)}}
+}
+
+// A BalloonStyleChild is an element that can be a child of a BalloonStyle element.
+type BalloonStyleChild interface {
+	Child
+	isBalloonStyleChild()
+}
+
+// unwrapBalloonStyleChildren returns the kml.Elements of children.
+func unwrapBalloonStyleChildren(children []BalloonStyleChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// A BalloonStyleElement is a BalloonStyle element.
+type BalloonStyleElement struct {
+	element
+}
+
+func (BalloonStyleElement) isChangeChild() {}
+func (BalloonStyleElement) isStyleChild()  {}
+
+// BalloonStyle returns a new BalloonStyle element.
+func BalloonStyle(children ...BalloonStyleChild) BalloonStyleElement {
+	return BalloonStyleElement{element{kml.BalloonStyle(unwrapBalloonStyleChildren(children)...)}}
+}
+
+// SharedBalloonStyle returns a new shared BalloonStyle element.
+func SharedBalloonStyle(id string, children ...BalloonStyleChild) BalloonStyleElement {
+	return BalloonStyleElement{element{kml.SharedBalloonStyle(id, unwrapBalloonStyleChildren(children)...)}}
+}
+
+// TargetBalloonStyle returns a new BalloonStyle element with a targetId.
+func TargetBalloonStyle(targetID string, children ...BalloonStyleChild) BalloonStyleElement {
+	return BalloonStyleElement{element{kml.TargetBalloonStyle(targetID, unwrapBalloonStyleChildren(children)...)}}
+}
+
+// A ListStyleChild is an element that can be a child of a ListStyle element.
+type ListStyleChild interface {
+	Child
+	isListStyleChild()
+}
+
+// unwrapListStyleChildren returns the kml.Elements of children.
+func unwrapListStyleChildren(children []ListStyleChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// A ListStyleElement is a ListStyle element.
+type ListStyleElement struct {
+	element
+}
+
+func (ListStyleElement) isChangeChild() {}
+func (ListStyleElement) isStyleChild()  {}
+
+// ListStyle returns a new ListStyle element.
+func ListStyle(children ...ListStyleChild) ListStyleElement {
+	return ListStyleElement{element{kml.ListStyle(unwrapListStyleChildren(children)...)}}
+}
+
+// SharedListStyle returns a new shared ListStyle element.
+func SharedListStyle(id string, children ...ListStyleChild) ListStyleElement {
+	return ListStyleElement{element{kml.SharedListStyle(id, unwrapListStyleChildren(children)...)}}
+}
+
+// TargetListStyle returns a new ListStyle element with a targetId.
+func TargetListStyle(targetID string, children ...ListStyleChild) ListStyleElement {
+	return ListStyleElement{element{kml.TargetListStyle(targetID, unwrapListStyleChildren(children)...)}}
+}
+
+// An ItemIconChild is an element that can be a child of a ItemIcon element.
+type ItemIconChild interface {
+	Child
+	isItemIconChild()
+}
+
+// unwrapItemIconChildren returns the kml.Elements of children.
+func unwrapItemIconChildren(children []ItemIconChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// An ItemIconElement is a ItemIcon element.
+type ItemIconElement struct {
+	element
+}
+
+func (ItemIconElement) isChangeChild()    {}
+func (ItemIconElement) isListStyleChild() {}
+
+// ItemIcon returns a new ItemIcon element.
+func ItemIcon(children ...ItemIconChild) ItemIconElement {
+	return ItemIconElement{element{kml.ItemIcon(unwrapItemIconChildren(children)...)}}
+}
+
+// SharedItemIcon returns a new shared ItemIcon element.
+func SharedItemIcon(id string, children ...ItemIconChild) ItemIconElement {
+	return ItemIconElement{element{kml.SharedItemIcon(id, unwrapItemIconChildren(children)...)}}
+}
+
+// TargetItemIcon returns a new ItemIcon element with a targetId.
+func TargetItemIcon(targetID string, children ...ItemIconChild) ItemIconElement {
+	return ItemIconElement{element{kml.TargetItemIcon(targetID, unwrapItemIconChildren(children)...)}}
+}
+
+// A TimeStampChild is an element that can be a child of a TimeStamp element.
+type TimeStampChild interface {
+	Child
+	isTimeStampChild()
+}
+
+// unwrapTimeStampChildren returns the kml.Elements of children.
+func unwrapTimeStampChildren(children []TimeStampChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// A TimeStampElement is a TimeStamp element.
+type TimeStampElement struct {
+	element
+}
+
+func (TimeStampElement) isChangeChild()        {}
+func (TimeStampElement) isDocumentChild()      {}
+func (TimeStampElement) isFolderChild()        {}
+func (TimeStampElement) isGroundOverlayChild() {}
+func (TimeStampElement) isGxTourChild()        {}
+func (TimeStampElement) isNetworkLinkChild()   {}
+func (TimeStampElement) isPhotoOverlayChild()  {}
+func (TimeStampElement) isPlacemarkChild()     {}
+func (TimeStampElement) isScreenOverlayChild() {}
+
+// TimeStamp returns a new TimeStamp element.
+func TimeStamp(children ...TimeStampChild) TimeStampElement {
+	return TimeStampElement{element{kml.TimeStamp(unwrapTimeStampChildren(children)...)}}
+}
+
+// SharedTimeStamp returns a new shared TimeStamp element.
+func SharedTimeStamp(id string, children ...TimeStampChild) TimeStampElement {
+	return TimeStampElement{element{kml.SharedTimeStamp(id, unwrapTimeStampChildren(children)...)}}
+}
+
+// TargetTimeStamp returns a new TimeStamp element with a targetId.
+func TargetTimeStamp(targetID string, children ...TimeStampChild) TimeStampElement {
+	return TimeStampElement{element{kml.TargetTimeStamp(targetID, unwrapTimeStampChildren(children)...)}}
+}
+
+// A TimeSpanChild is an element that can be a child of a TimeSpan element.
+type TimeSpanChild interface {
+	Child
+	isTimeSpanChild()
+}
+
+// unwrapTimeSpanChildren returns the kml.Elements of children.
+func unwrapTimeSpanChildren(children []TimeSpanChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// A TimeSpanElement is a TimeSpan element.
+type TimeSpanElement struct {
+	element
+}
+
+func (TimeSpanElement) isChangeChild()        {}
+func (TimeSpanElement) isDocumentChild()      {}
+func (TimeSpanElement) isFolderChild()        {}
+func (TimeSpanElement) isGroundOverlayChild() {}
+func (TimeSpanElement) isGxTourChild()        {}
+func (TimeSpanElement) isNetworkLinkChild()   {}
+func (TimeSpanElement) isPhotoOverlayChild()  {}
+func (TimeSpanElement) isPlacemarkChild()     {}
+func (TimeSpanElement) isScreenOverlayChild() {}
+
+// TimeSpan returns a new TimeSpan element.
+func TimeSpan(children ...TimeSpanChild) TimeSpanElement {
+	return TimeSpanElement{element{kml.TimeSpan(unwrapTimeSpanChildren(children)...)}}
+}
+
+// SharedTimeSpan returns a new shared TimeSpan element.
+func SharedTimeSpan(id string, children ...TimeSpanChild) TimeSpanElement {
+	return TimeSpanElement{element{kml.SharedTimeSpan(id, unwrapTimeSpanChildren(children)...)}}
+}
+
+// TargetTimeSpan returns a new TimeSpan element with a targetId.
+func TargetTimeSpan(targetID string, children ...TimeSpanChild) TimeSpanElement {
+	return TimeSpanElement{element{kml.TargetTimeSpan(targetID, unwrapTimeSpanChildren(children)...)}}
+}
+
+// An UpdateChild is an element that can be a child of a Update element.
+type UpdateChild interface {
+	Child
+	isUpdateChild()
+}
+
+// unwrapUpdateChildren returns the kml.Elements of children.
+func unwrapUpdateChildren(children []UpdateChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// An UpdateElement is a Update element.
+type UpdateElement struct {
+	element
+}
+
+func (UpdateElement) isGxAnimatedUpdateChild()   {}
+func (UpdateElement) isNetworkLinkControlChild() {}
+
+// Update returns a new Update element.
+func Update(children ...UpdateChild) UpdateElement {
+	return UpdateElement{element{kml.Update(unwrapUpdateChildren(children)...)}}
+}
+
+// A CreateChild is an element that can be a child of a Create element.
+type CreateChild interface {
+	Child
+	isCreateChild()
+}
+
+// unwrapCreateChildren returns the kml.Elements of children.
+func unwrapCreateChildren(children []CreateChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// A CreateElement is a Create element.
+type CreateElement struct {
+	element
+}
+
+func (CreateElement) isUpdateChild() {}
+
+// Create returns a new Create element.
+func Create(children ...CreateChild) CreateElement {
+	return CreateElement{element{kml.Create(unwrapCreateChildren(children)...)}}
+}
+
+// A DeleteChild is an element that can be a child of a Delete element.
+type DeleteChild interface {
+	Child
+	isDeleteChild()
+}
+
+// unwrapDeleteChildren returns the kml.Elements of children.
+func unwrapDeleteChildren(children []DeleteChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// A DeleteElement is a Delete element.
+type DeleteElement struct {
+	element
+}
+
+func (DeleteElement) isUpdateChild() {}
+
+// Delete returns a new Delete element.
+func Delete(children ...DeleteChild) DeleteElement {
+	return DeleteElement{element{kml.Delete(unwrapDeleteChildren(children)...)}}
+}
+
+// A ChangeChild is an element that can be a child of a Change element.
+type ChangeChild interface {
+	Child
+	isChangeChild()
+}
+
+// unwrapChangeChildren returns the kml.Elements of children.
+func unwrapChangeChildren(children []ChangeChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// A ChangeElement is a Change element.
+type ChangeElement struct {
+	element
+}
+
+func (ChangeElement) isUpdateChild() {}
+
+// Change returns a new Change element.
+func Change(children ...ChangeChild) ChangeElement {
+	return ChangeElement{element{kml.Change(unwrapChangeChildren(children)...)}}
+}
+
+// A GxAltitudeModeElement is a gx:altitudeMode element.
+type GxAltitudeModeElement struct {
+	element
+}
+
+func (GxAltitudeModeElement) isCameraChild()        {}
+func (GxAltitudeModeElement) isGroundOverlayChild() {}
+func (GxAltitudeModeElement) isGxMultiTrackChild()  {}
+func (GxAltitudeModeElement) isGxTrackChild()       {}
+func (GxAltitudeModeElement) isLatLonAltBoxChild()  {}
+func (GxAltitudeModeElement) isLineStringChild()    {}
+func (GxAltitudeModeElement) isLinearRingChild()    {}
+func (GxAltitudeModeElement) isLookAtChild()        {}
+func (GxAltitudeModeElement) isModelChild()         {}
+func (GxAltitudeModeElement) isPointChild()         {}
+func (GxAltitudeModeElement) isPolygonChild()       {}
+
+// GxAltitudeMode returns a new gx:altitudeMode element.
+func GxAltitudeMode(value kml.GxAltitudeModeEnum) GxAltitudeModeElement {
+	return GxAltitudeModeElement{element{kml.GxAltitudeMode(value)}}
+}
+
+// A GxAltitudeOffsetElement is a gx:altitudeOffset element.
+type GxAltitudeOffsetElement struct {
+	element
+}
+
+func (GxAltitudeOffsetElement) isGxMultiTrackChild()  {}
+func (GxAltitudeOffsetElement) isGxTrackChild()       {}
+func (GxAltitudeOffsetElement) isLineStringChild()    {}
+func (GxAltitudeOffsetElement) isLinearRingChild()    {}
+func (GxAltitudeOffsetElement) isModelChild()         {}
+func (GxAltitudeOffsetElement) isMultiGeometryChild() {}
+func (GxAltitudeOffsetElement) isPointChild()         {}
+func (GxAltitudeOffsetElement) isPolygonChild()       {}
+
+// GxAltitudeOffset returns a new gx:altitudeOffset element.
+func GxAltitudeOffset(value float64) GxAltitudeOffsetElement {
+	return GxAltitudeOffsetElement{element{kml.GxAltitudeOffset(value)}}
+}
+
+// A GxAnglesElement is a gx:angles element.
+type GxAnglesElement struct {
+	element
+}
+
+func (GxAnglesElement) isGxTrackChild() {}
+
+// A GxBalloonVisibilityElement is a gx:balloonVisibility element.
+type GxBalloonVisibilityElement struct {
+	element
+}
+
+func (GxBalloonVisibilityElement) isDocumentChild()      {}
+func (GxBalloonVisibilityElement) isFolderChild()        {}
+func (GxBalloonVisibilityElement) isGroundOverlayChild() {}
+func (GxBalloonVisibilityElement) isGxTourChild()        {}
+func (GxBalloonVisibilityElement) isNetworkLinkChild()   {}
+func (GxBalloonVisibilityElement) isPhotoOverlayChild()  {}
+func (GxBalloonVisibilityElement) isPlacemarkChild()     {}
+func (GxBalloonVisibilityElement) isScreenOverlayChild() {}
+
+// GxBalloonVisibility returns a new gx:balloonVisibility element.
+func GxBalloonVisibility(value bool) GxBalloonVisibilityElement {
+	return GxBalloonVisibilityElement{element{kml.GxBalloonVisibility(value)}}
+}
+
+// A GxCoordElement is a gx:coord element.
+type GxCoordElement struct {
+	element
+}
+
+func (GxCoordElement) isGxTrackChild() {}
+
+// A GxDelayedStartElement is a gx:delayedStart element.
+type GxDelayedStartElement struct {
+	element
+}
+
+func (GxDelayedStartElement) isGxAnimatedUpdateChild() {}
+func (GxDelayedStartElement) isGxSoundCueChild()       {}
+
+// GxDelayedStart returns a new gx:delayedStart element.
+func GxDelayedStart(value float64) GxDelayedStartElement {
+	return GxDelayedStartElement{element{kml.GxDelayedStart(value)}}
+}
+
+// A GxDrawOrderElement is a gx:drawOrder element.
+type GxDrawOrderElement struct {
+	element
+}
+
+func (GxDrawOrderElement) isGxMultiTrackChild()  {}
+func (GxDrawOrderElement) isGxTrackChild()       {}
+func (GxDrawOrderElement) isLineStringChild()    {}
+func (GxDrawOrderElement) isLinearRingChild()    {}
+func (GxDrawOrderElement) isModelChild()         {}
+func (GxDrawOrderElement) isMultiGeometryChild() {}
+func (GxDrawOrderElement) isPointChild()         {}
+func (GxDrawOrderElement) isPolygonChild()       {}
+
+// GxDrawOrder returns a new gx:drawOrder element.
+func GxDrawOrder(value int) GxDrawOrderElement {
+	return GxDrawOrderElement{element{kml.GxDrawOrder(value)}}
+}
+
+// A GxDurationElement is a gx:duration element.
+type GxDurationElement struct {
+	element
+}
+
+func (GxDurationElement) isGxAnimatedUpdateChild() {}
+func (GxDurationElement) isGxFlyToChild()          {}
+func (GxDurationElement) isGxWaitChild()           {}
+
+// GxDuration returns a new gx:duration element.
+func GxDuration(value float64) GxDurationElement {
+	return GxDurationElement{element{kml.GxDuration(value)}}
+}
+
+// A GxFlyToModeElement is a gx:flyToMode element.
+type GxFlyToModeElement struct {
+	element
+}
+
+func (GxFlyToModeElement) isGxFlyToChild() {}
+
+// GxFlyToMode returns a new gx:flyToMode element.
+func GxFlyToMode(value kml.GxFlyToModeEnum) GxFlyToModeElement {
+	return GxFlyToModeElement{element{kml.GxFlyToMode(value)}}
+}
+
+// A GxHorizFOVElement is a gx:horizFov element.
+type GxHorizFOVElement struct {
+	element
+}
+
+func (GxHorizFOVElement) isCameraChild() {}
+func (GxHorizFOVElement) isLookAtChild() {}
+
+// GxHorizFOV returns a new gx:horizFov element.
+func GxHorizFOV(value float64) GxHorizFOVElement {
+	return GxHorizFOVElement{element{kml.GxHorizFOV(value)}}
+}
+
+// A GxInterpolateElement is a gx:interpolate element.
+type GxInterpolateElement struct {
+	element
+}
+
+func (GxInterpolateElement) isGxMultiTrackChild() {}
+
+// GxInterpolate returns a new gx:interpolate element.
+func GxInterpolate(value bool) GxInterpolateElement {
+	return GxInterpolateElement{element{kml.GxInterpolate(value)}}
+}
+
+// A GxLabelVisibilityElement is a gx:labelVisibility element.
+type GxLabelVisibilityElement struct {
+	element
+}
+
+func (GxLabelVisibilityElement) isIconStyleChild()  {}
+func (GxLabelVisibilityElement) isLabelStyleChild() {}
+func (GxLabelVisibilityElement) isLineStyleChild()  {}
+func (GxLabelVisibilityElement) isPolyStyleChild()  {}
+
+// GxLabelVisibility returns a new gx:labelVisibility element.
+func GxLabelVisibility(value bool) GxLabelVisibilityElement {
+	return GxLabelVisibilityElement{element{kml.GxLabelVisibility(value)}}
+}
+
+// A GxOuterColorElement is a gx:outerColor element.
+type GxOuterColorElement struct {
+	element
+}
+
+func (GxOuterColorElement) isLineStyleChild() {}
+
+// GxOuterColor returns a new gx:outerColor element.
+func GxOuterColor(value color.Color) GxOuterColorElement {
+	return GxOuterColorElement{element{kml.GxOuterColor(value)}}
+}
+
+// A GxOuterWidthElement is a gx:outerWidth element.
+type GxOuterWidthElement struct {
+	element
+}
+
+func (GxOuterWidthElement) isLineStyleChild() {}
+
+// GxOuterWidth returns a new gx:outerWidth element.
+func GxOuterWidth(value float64) GxOuterWidthElement {
+	return GxOuterWidthElement{element{kml.GxOuterWidth(value)}}
+}
+
+// A GxPhysicalWidthElement is a gx:physicalWidth element.
+type GxPhysicalWidthElement struct {
+	element
+}
+
+func (GxPhysicalWidthElement) isLineStyleChild() {}
+
+// GxPhysicalWidth returns a new gx:physicalWidth element.
+func GxPhysicalWidth(value float64) GxPhysicalWidthElement {
+	return GxPhysicalWidthElement{element{kml.GxPhysicalWidth(value)}}
+}
+
+// A GxPlayModeElement is a gx:playMode element.
+type GxPlayModeElement struct {
+	element
+}
+
+func (GxPlayModeElement) isGxTourControlChild() {}
+
+// GxPlayMode returns a new gx:playMode element.
+func GxPlayMode(value kml.GxPlayModeEnum) GxPlayModeElement {
+	return GxPlayModeElement{element{kml.GxPlayMode(value)}}
+}
+
+// A GxRankElement is a gx:rank element.
+type GxRankElement struct {
+	element
+}
+
+func (GxRankElement) isDocumentChild()      {}
+func (GxRankElement) isFolderChild()        {}
+func (GxRankElement) isGroundOverlayChild() {}
+func (GxRankElement) isGxTourChild()        {}
+func (GxRankElement) isNetworkLinkChild()   {}
+func (GxRankElement) isPhotoOverlayChild()  {}
+func (GxRankElement) isPlacemarkChild()     {}
+func (GxRankElement) isScreenOverlayChild() {}
+
+// GxRank returns a new gx:rank element.
+func GxRank(value float64) GxRankElement {
+	return GxRankElement{element{kml.GxRank(value)}}
+}
+
+// A GxValueElement is a gx:value element.
+type GxValueElement struct {
+	element
+}
+
+func (GxValueElement) isGxSimpleArrayDataChild() {}
+
+// GxValue returns a new gx:value element.
+func GxValue(value string) GxValueElement {
+	return GxValueElement{element{kml.GxValue(value)}}
+}
+
+// A GxXElement is a gx:x element.
+type GxXElement struct {
+	element
+}
+
+func (GxXElement) isIconChild() {}
+func (GxXElement) isLinkChild() {}
+func (GxXElement) isURLChild()  {}
+
+// GxX returns a new gx:x element.
+func GxX(value int) GxXElement {
+	return GxXElement{element{kml.GxX(value)}}
+}
+
+// A GxYElement is a gx:y element.
+type GxYElement struct {
+	element
+}
+
+func (GxYElement) isIconChild() {}
+func (GxYElement) isLinkChild() {}
+func (GxYElement) isURLChild()  {}
+
+// GxY returns a new gx:y element.
+func GxY(value int) GxYElement {
+	return GxYElement{element{kml.GxY(value)}}
+}
+
+// A GxWElement is a gx:w element.
+type GxWElement struct {
+	element
+}
+
+func (GxWElement) isIconChild() {}
+func (GxWElement) isLinkChild() {}
+func (GxWElement) isURLChild()  {}
+
+// GxW returns a new gx:w element.
+func GxW(value int) GxWElement {
+	return GxWElement{element{kml.GxW(value)}}
+}
+
+// A GxHElement is a gx:h element.
+type GxHElement struct {
+	element
+}
+
+func (GxHElement) isIconChild() {}
+func (GxHElement) isLinkChild() {}
+func (GxHElement) isURLChild()  {}
+
+// GxH returns a new gx:h element.
+func GxH(value int) GxHElement {
+	return GxHElement{element{kml.GxH(value)}}
+}
+
+// A GxAnimatedUpdateChild is an element that can be a child of a gx:AnimatedUpdate element.
+type GxAnimatedUpdateChild interface {
+	Child
+	isGxAnimatedUpdateChild()
+}
+
+// unwrapGxAnimatedUpdateChildren returns the kml.Elements of children.
+func unwrapGxAnimatedUpdateChildren(children []GxAnimatedUpdateChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// A GxAnimatedUpdateElement is a gx:AnimatedUpdate element.
+type GxAnimatedUpdateElement struct {
+	element
+}
+
+func (GxAnimatedUpdateElement) isChangeChild()     {}
+func (GxAnimatedUpdateElement) isGxPlaylistChild() {}
+
+// GxAnimatedUpdate returns a new gx:AnimatedUpdate element.
+func GxAnimatedUpdate(children ...GxAnimatedUpdateChild) GxAnimatedUpdateElement {
+	return GxAnimatedUpdateElement{element{kml.GxAnimatedUpdate(unwrapGxAnimatedUpdateChildren(children)...)}}
+}
+
+// SharedGxAnimatedUpdate returns a new shared gx:AnimatedUpdate element.
+func SharedGxAnimatedUpdate(id string, children ...GxAnimatedUpdateChild) GxAnimatedUpdateElement {
+	return GxAnimatedUpdateElement{element{kml.SharedGxAnimatedUpdate(id, unwrapGxAnimatedUpdateChildren(children)...)}}
+}
+
+// TargetGxAnimatedUpdate returns a new gx:AnimatedUpdate element with a targetId.
+func TargetGxAnimatedUpdate(targetID string, children ...GxAnimatedUpdateChild) GxAnimatedUpdateElement {
+	return GxAnimatedUpdateElement{element{kml.TargetGxAnimatedUpdate(targetID, unwrapGxAnimatedUpdateChildren(children)...)}}
+}
+
+// A GxFlyToChild is an element that can be a child of a gx:FlyTo element.
+type GxFlyToChild interface {
+	Child
+	isGxFlyToChild()
+}
+
+// unwrapGxFlyToChildren returns the kml.Elements of children.
+func unwrapGxFlyToChildren(children []GxFlyToChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// A GxFlyToElement is a gx:FlyTo element.
+type GxFlyToElement struct {
+	element
+}
+
+func (GxFlyToElement) isChangeChild()     {}
+func (GxFlyToElement) isGxPlaylistChild() {}
+
+// GxFlyTo returns a new gx:FlyTo element.
+func GxFlyTo(children ...GxFlyToChild) GxFlyToElement {
+	return GxFlyToElement{element{kml.GxFlyTo(unwrapGxFlyToChildren(children)...)}}
+}
+
+// SharedGxFlyTo returns a new shared gx:FlyTo element.
+func SharedGxFlyTo(id string, children ...GxFlyToChild) GxFlyToElement {
+	return GxFlyToElement{element{kml.SharedGxFlyTo(id, unwrapGxFlyToChildren(children)...)}}
+}
+
+// TargetGxFlyTo returns a new gx:FlyTo element with a targetId.
+func TargetGxFlyTo(targetID string, children ...GxFlyToChild) GxFlyToElement {
+	return GxFlyToElement{element{kml.TargetGxFlyTo(targetID, unwrapGxFlyToChildren(children)...)}}
+}
+
+// A GxPlaylistChild is an element that can be a child of a gx:Playlist element.
+type GxPlaylistChild interface {
+	Child
+	isGxPlaylistChild()
+}
+
+// unwrapGxPlaylistChildren returns the kml.Elements of children.
+func unwrapGxPlaylistChildren(children []GxPlaylistChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// A GxPlaylistElement is a gx:Playlist element.
+type GxPlaylistElement struct {
+	element
+}
+
+func (GxPlaylistElement) isChangeChild() {}
+func (GxPlaylistElement) isGxTourChild() {}
+
+// GxPlaylist returns a new gx:Playlist element.
+func GxPlaylist(children ...GxPlaylistChild) GxPlaylistElement {
+	return GxPlaylistElement{element{kml.GxPlaylist(unwrapGxPlaylistChildren(children)...)}}
+}
+
+// SharedGxPlaylist returns a new shared gx:Playlist element.
+func SharedGxPlaylist(id string, children ...GxPlaylistChild) GxPlaylistElement {
+	return GxPlaylistElement{element{kml.SharedGxPlaylist(id, unwrapGxPlaylistChildren(children)...)}}
+}
+
+// TargetGxPlaylist returns a new gx:Playlist element with a targetId.
+func TargetGxPlaylist(targetID string, children ...GxPlaylistChild) GxPlaylistElement {
+	return GxPlaylistElement{element{kml.TargetGxPlaylist(targetID, unwrapGxPlaylistChildren(children)...)}}
+}
+
+// A GxSoundCueChild is an element that can be a child of a gx:SoundCue element.
+type GxSoundCueChild interface {
+	Child
+	isGxSoundCueChild()
+}
+
+// unwrapGxSoundCueChildren returns the kml.Elements of children.
+func unwrapGxSoundCueChildren(children []GxSoundCueChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// A GxSoundCueElement is a gx:SoundCue element.
+type GxSoundCueElement struct {
+	element
+}
+
+func (GxSoundCueElement) isChangeChild()     {}
+func (GxSoundCueElement) isGxPlaylistChild() {}
+
+// GxSoundCue returns a new gx:SoundCue element.
+func GxSoundCue(children ...GxSoundCueChild) GxSoundCueElement {
+	return GxSoundCueElement{element{kml.GxSoundCue(unwrapGxSoundCueChildren(children)...)}}
+}
+
+// SharedGxSoundCue returns a new shared gx:SoundCue element.
+func SharedGxSoundCue(id string, children ...GxSoundCueChild) GxSoundCueElement {
+	return GxSoundCueElement{element{kml.SharedGxSoundCue(id, unwrapGxSoundCueChildren(children)...)}}
+}
+
+// TargetGxSoundCue returns a new gx:SoundCue element with a targetId.
+func TargetGxSoundCue(targetID string, children ...GxSoundCueChild) GxSoundCueElement {
+	return GxSoundCueElement{element{kml.TargetGxSoundCue(targetID, unwrapGxSoundCueChildren(children)...)}}
+}
+
+// A GxTourChild is an element that can be a child of a gx:Tour element.
+type GxTourChild interface {
+	Child
+	isGxTourChild()
+}
+
+// unwrapGxTourChildren returns the kml.Elements of children.
+func unwrapGxTourChildren(children []GxTourChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// A GxTourElement is a gx:Tour element.
+type GxTourElement struct {
+	element
+}
+
+func (GxTourElement) isChangeChild()   {}
+func (GxTourElement) isDeleteChild()   {}
+func (GxTourElement) isDocumentChild() {}
+func (GxTourElement) isFolderChild()   {}
+func (GxTourElement) isKMLChild()      {}
+
+// GxTour returns a new gx:Tour element.
+func GxTour(children ...GxTourChild) GxTourElement {
+	return GxTourElement{element{kml.GxTour(unwrapGxTourChildren(children)...)}}
+}
+
+// SharedGxTour returns a new shared gx:Tour element.
+func SharedGxTour(id string, children ...GxTourChild) GxTourElement {
+	return GxTourElement{element{kml.SharedGxTour(id, unwrapGxTourChildren(children)...)}}
+}
+
+// TargetGxTour returns a new gx:Tour element with a targetId.
+func TargetGxTour(targetID string, children ...GxTourChild) GxTourElement {
+	return GxTourElement{element{kml.TargetGxTour(targetID, unwrapGxTourChildren(children)...)}}
+}
+
+// A GxTimeStampChild is an element that can be a child of a gx:TimeStamp element.
+type GxTimeStampChild interface {
+	Child
+	isGxTimeStampChild()
+}
+
+// unwrapGxTimeStampChildren returns the kml.Elements of children.
+func unwrapGxTimeStampChildren(children []GxTimeStampChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// A GxTimeStampElement is a gx:TimeStamp element.
+type GxTimeStampElement struct {
+	element
+}
+
+func (GxTimeStampElement) isCameraChild() {}
+func (GxTimeStampElement) isChangeChild() {}
+func (GxTimeStampElement) isLookAtChild() {}
+
+// GxTimeStamp returns a new gx:TimeStamp element.
+func GxTimeStamp(children ...GxTimeStampChild) GxTimeStampElement {
+	return GxTimeStampElement{element{kml.GxTimeStamp(unwrapGxTimeStampChildren(children)...)}}
+}
+
+// SharedGxTimeStamp returns a new shared gx:TimeStamp element.
+func SharedGxTimeStamp(id string, children ...GxTimeStampChild) GxTimeStampElement {
+	return GxTimeStampElement{element{kml.SharedGxTimeStamp(id, unwrapGxTimeStampChildren(children)...)}}
+}
+
+// TargetGxTimeStamp returns a new gx:TimeStamp element with a targetId.
+func TargetGxTimeStamp(targetID string, children ...GxTimeStampChild) GxTimeStampElement {
+	return GxTimeStampElement{element{kml.TargetGxTimeStamp(targetID, unwrapGxTimeStampChildren(children)...)}}
+}
+
+// A GxTimeSpanChild is an element that can be a child of a gx:TimeSpan element.
+type GxTimeSpanChild interface {
+	Child
+	isGxTimeSpanChild()
+}
+
+// unwrapGxTimeSpanChildren returns the kml.Elements of children.
+func unwrapGxTimeSpanChildren(children []GxTimeSpanChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// A GxTimeSpanElement is a gx:TimeSpan element.
+type GxTimeSpanElement struct {
+	element
+}
+
+func (GxTimeSpanElement) isCameraChild() {}
+func (GxTimeSpanElement) isChangeChild() {}
+func (GxTimeSpanElement) isLookAtChild() {}
+
+// GxTimeSpan returns a new gx:TimeSpan element.
+func GxTimeSpan(children ...GxTimeSpanChild) GxTimeSpanElement {
+	return GxTimeSpanElement{element{kml.GxTimeSpan(unwrapGxTimeSpanChildren(children)...)}}
+}
+
+// SharedGxTimeSpan returns a new shared gx:TimeSpan element.
+func SharedGxTimeSpan(id string, children ...GxTimeSpanChild) GxTimeSpanElement {
+	return GxTimeSpanElement{element{kml.SharedGxTimeSpan(id, unwrapGxTimeSpanChildren(children)...)}}
+}
+
+// TargetGxTimeSpan returns a new gx:TimeSpan element with a targetId.
+func TargetGxTimeSpan(targetID string, children ...GxTimeSpanChild) GxTimeSpanElement {
+	return GxTimeSpanElement{element{kml.TargetGxTimeSpan(targetID, unwrapGxTimeSpanChildren(children)...)}}
+}
+
+// A GxTourControlChild is an element that can be a child of a gx:TourControl element.
+type GxTourControlChild interface {
+	Child
+	isGxTourControlChild()
+}
+
+// unwrapGxTourControlChildren returns the kml.Elements of children.
+func unwrapGxTourControlChildren(children []GxTourControlChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// A GxTourControlElement is a gx:TourControl element.
+type GxTourControlElement struct {
+	element
+}
+
+func (GxTourControlElement) isChangeChild()     {}
+func (GxTourControlElement) isGxPlaylistChild() {}
+
+// GxTourControl returns a new gx:TourControl element.
+func GxTourControl(children ...GxTourControlChild) GxTourControlElement {
+	return GxTourControlElement{element{kml.GxTourControl(unwrapGxTourControlChildren(children)...)}}
+}
+
+// SharedGxTourControl returns a new shared gx:TourControl element.
+func SharedGxTourControl(id string, children ...GxTourControlChild) GxTourControlElement {
+	return GxTourControlElement{element{kml.SharedGxTourControl(id, unwrapGxTourControlChildren(children)...)}}
+}
+
+// TargetGxTourControl returns a new gx:TourControl element with a targetId.
+func TargetGxTourControl(targetID string, children ...GxTourControlChild) GxTourControlElement {
+	return GxTourControlElement{element{kml.TargetGxTourControl(targetID, unwrapGxTourControlChildren(children)...)}}
+}
+
+// A GxWaitChild is an element that can be a child of a gx:Wait element.
+type GxWaitChild interface {
+	Child
+	isGxWaitChild()
+}
+
+// unwrapGxWaitChildren returns the kml.Elements of children.
+func unwrapGxWaitChildren(children []GxWaitChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// A GxWaitElement is a gx:Wait element.
+type GxWaitElement struct {
+	element
+}
+
+func (GxWaitElement) isChangeChild()     {}
+func (GxWaitElement) isGxPlaylistChild() {}
+
+// GxWait returns a new gx:Wait element.
+func GxWait(children ...GxWaitChild) GxWaitElement {
+	return GxWaitElement{element{kml.GxWait(unwrapGxWaitChildren(children)...)}}
+}
+
+// SharedGxWait returns a new shared gx:Wait element.
+func SharedGxWait(id string, children ...GxWaitChild) GxWaitElement {
+	return GxWaitElement{element{kml.SharedGxWait(id, unwrapGxWaitChildren(children)...)}}
+}
+
+// TargetGxWait returns a new gx:Wait element with a targetId.
+func TargetGxWait(targetID string, children ...GxWaitChild) GxWaitElement {
+	return GxWaitElement{element{kml.TargetGxWait(targetID, unwrapGxWaitChildren(children)...)}}
+}
+
+// A GxLatLonQuadChild is an element that can be a child of a gx:LatLonQuad element.
+type GxLatLonQuadChild interface {
+	Child
+	isGxLatLonQuadChild()
+}
+
+// unwrapGxLatLonQuadChildren returns the kml.Elements of children.
+func unwrapGxLatLonQuadChildren(children []GxLatLonQuadChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// A GxLatLonQuadElement is a gx:LatLonQuad element.
+type GxLatLonQuadElement struct {
+	element
+}
+
+func (GxLatLonQuadElement) isChangeChild()        {}
+func (GxLatLonQuadElement) isGroundOverlayChild() {}
+
+// GxLatLonQuad returns a new gx:LatLonQuad element.
+func GxLatLonQuad(children ...GxLatLonQuadChild) GxLatLonQuadElement {
+	return GxLatLonQuadElement{element{kml.GxLatLonQuad(unwrapGxLatLonQuadChildren(children)...)}}
+}
+
+// SharedGxLatLonQuad returns a new shared gx:LatLonQuad element.
+func SharedGxLatLonQuad(id string, children ...GxLatLonQuadChild) GxLatLonQuadElement {
+	return GxLatLonQuadElement{element{kml.SharedGxLatLonQuad(id, unwrapGxLatLonQuadChildren(children)...)}}
+}
+
+// TargetGxLatLonQuad returns a new gx:LatLonQuad element with a targetId.
+func TargetGxLatLonQuad(targetID string, children ...GxLatLonQuadChild) GxLatLonQuadElement {
+	return GxLatLonQuadElement{element{kml.TargetGxLatLonQuad(targetID, unwrapGxLatLonQuadChildren(children)...)}}
+}
+
+// A GxTrackChild is an element that can be a child of a gx:Track element.
+type GxTrackChild interface {
+	Child
+	isGxTrackChild()
+}
+
+// unwrapGxTrackChildren returns the kml.Elements of children.
+func unwrapGxTrackChildren(children []GxTrackChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// A GxTrackElement is a gx:Track element.
+type GxTrackElement struct {
+	element
+}
+
+func (GxTrackElement) isChangeChild()        {}
+func (GxTrackElement) isGxMultiTrackChild()  {}
+func (GxTrackElement) isMultiGeometryChild() {}
+func (GxTrackElement) isPlacemarkChild()     {}
+
+// GxTrack returns a new gx:Track element.
+func GxTrack(children ...GxTrackChild) GxTrackElement {
+	return GxTrackElement{element{kml.GxTrack(unwrapGxTrackChildren(children)...)}}
+}
+
+// SharedGxTrack returns a new shared gx:Track element.
+func SharedGxTrack(id string, children ...GxTrackChild) GxTrackElement {
+	return GxTrackElement{element{kml.SharedGxTrack(id, unwrapGxTrackChildren(children)...)}}
+}
+
+// TargetGxTrack returns a new gx:Track element with a targetId.
+func TargetGxTrack(targetID string, children ...GxTrackChild) GxTrackElement {
+	return GxTrackElement{element{kml.TargetGxTrack(targetID, unwrapGxTrackChildren(children)...)}}
+}
+
+// A GxMultiTrackChild is an element that can be a child of a gx:MultiTrack element.
+type GxMultiTrackChild interface {
+	Child
+	isGxMultiTrackChild()
+}
+
+// unwrapGxMultiTrackChildren returns the kml.Elements of children.
+func unwrapGxMultiTrackChildren(children []GxMultiTrackChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// A GxMultiTrackElement is a gx:MultiTrack element.
+type GxMultiTrackElement struct {
+	element
+}
+
+func (GxMultiTrackElement) isChangeChild()        {}
+func (GxMultiTrackElement) isMultiGeometryChild() {}
+func (GxMultiTrackElement) isPlacemarkChild()     {}
+
+// GxMultiTrack returns a new gx:MultiTrack element.
+func GxMultiTrack(children ...GxMultiTrackChild) GxMultiTrackElement {
+	return GxMultiTrackElement{element{kml.GxMultiTrack(unwrapGxMultiTrackChildren(children)...)}}
+}
+
+// SharedGxMultiTrack returns a new shared gx:MultiTrack element.
+func SharedGxMultiTrack(id string, children ...GxMultiTrackChild) GxMultiTrackElement {
+	return GxMultiTrackElement{element{kml.SharedGxMultiTrack(id, unwrapGxMultiTrackChildren(children)...)}}
+}
+
+// TargetGxMultiTrack returns a new gx:MultiTrack element with a targetId.
+func TargetGxMultiTrack(targetID string, children ...GxMultiTrackChild) GxMultiTrackElement {
+	return GxMultiTrackElement{element{kml.TargetGxMultiTrack(targetID, unwrapGxMultiTrackChildren(children)...)}}
+}
+
+// A GxSimpleArrayFieldChild is an element that can be a child of a gx:SimpleArrayField element.
+type GxSimpleArrayFieldChild interface {
+	Child
+	isGxSimpleArrayFieldChild()
+}
+
+// unwrapGxSimpleArrayFieldChildren returns the kml.Elements of children.
+func unwrapGxSimpleArrayFieldChildren(children []GxSimpleArrayFieldChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// A GxSimpleArrayFieldElement is a gx:SimpleArrayField element.
+type GxSimpleArrayFieldElement struct {
+	element
+}
+
+func (GxSimpleArrayFieldElement) isSchemaChild() {}
+
+// GxSimpleArrayField returns a new gx:SimpleArrayField element.
+func GxSimpleArrayField(name, _type string, children ...GxSimpleArrayFieldChild) GxSimpleArrayFieldElement {
+	return GxSimpleArrayFieldElement{element{kml.GxSimpleArrayField(name, _type, unwrapGxSimpleArrayFieldChildren(children)...)}}
+}
+
+// A GxSimpleArrayDataChild is an element that can be a child of a gx:SimpleArrayData element.
+type GxSimpleArrayDataChild interface {
+	Child
+	isGxSimpleArrayDataChild()
+}
+
+// unwrapGxSimpleArrayDataChildren returns the kml.Elements of children.
+func unwrapGxSimpleArrayDataChildren(children []GxSimpleArrayDataChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// A GxSimpleArrayDataElement is a gx:SimpleArrayData element.
+type GxSimpleArrayDataElement struct {
+	element
+}
+
+func (GxSimpleArrayDataElement) isSchemaDataChild() {}
+
+// GxSimpleArrayDataWithName returns a new gx:SimpleArrayData element.
+func GxSimpleArrayDataWithName(name string, children ...GxSimpleArrayDataChild) GxSimpleArrayDataElement {
+	return GxSimpleArrayDataElement{element{kml.GxSimpleArrayDataWithName(name, unwrapGxSimpleArrayDataChildren(children)...)}}
+}
+
+// SharedGxSimpleArrayDataWithName returns a new shared gx:SimpleArrayData element.
+func SharedGxSimpleArrayDataWithName(id, name string, children ...GxSimpleArrayDataChild) GxSimpleArrayDataElement {
+	return GxSimpleArrayDataElement{element{kml.SharedGxSimpleArrayDataWithName(id, name, unwrapGxSimpleArrayDataChildren(children)...)}}
+}
+
+// TargetGxSimpleArrayDataWithName returns a new gx:SimpleArrayData element with a targetId.
+func TargetGxSimpleArrayDataWithName(targetID, name string, children ...GxSimpleArrayDataChild) GxSimpleArrayDataElement {
+	return GxSimpleArrayDataElement{element{kml.TargetGxSimpleArrayDataWithName(targetID, name, unwrapGxSimpleArrayDataChildren(children)...)}}
+}
+
+// GxSimpleArrayData returns a new gx:SimpleArrayData element.
+func GxSimpleArrayData(children ...GxSimpleArrayDataChild) GxSimpleArrayDataElement {
+	return GxSimpleArrayDataElement{element{kml.GxSimpleArrayData(unwrapGxSimpleArrayDataChildren(children)...)}}
+}
+
+// SharedGxSimpleArrayData returns a new shared gx:SimpleArrayData element.
+func SharedGxSimpleArrayData(id string, children ...GxSimpleArrayDataChild) GxSimpleArrayDataElement {
+	return GxSimpleArrayDataElement{element{kml.SharedGxSimpleArrayData(id, unwrapGxSimpleArrayDataChildren(children)...)}}
+}
+
+// TargetGxSimpleArrayData returns a new gx:SimpleArrayData element with a targetId.
+func TargetGxSimpleArrayData(targetID string, children ...GxSimpleArrayDataChild) GxSimpleArrayDataElement {
+	return GxSimpleArrayDataElement{element{kml.TargetGxSimpleArrayData(targetID, unwrapGxSimpleArrayDataChildren(children)...)}}
+}
+
+// A GxViewerOptionsChild is an element that can be a child of a gx:ViewerOptions element.
+type GxViewerOptionsChild interface {
+	Child
+	isGxViewerOptionsChild()
+}
+
+// unwrapGxViewerOptionsChildren returns the kml.Elements of children.
+func unwrapGxViewerOptionsChildren(children []GxViewerOptionsChild) []kml.Element {
+	elements := make([]kml.Element, 0, len(children))
+	for _, child := range children {
+		elements = append(elements, child.kmlElement())
+	}
+	return elements
+}
+
+// A GxViewerOptionsElement is a gx:ViewerOptions element.
+type GxViewerOptionsElement struct {
+	element
+}
+
+func (GxViewerOptionsElement) isCameraChild() {}
+func (GxViewerOptionsElement) isChangeChild() {}
+func (GxViewerOptionsElement) isLookAtChild() {}
+
+// GxViewerOptions returns a new gx:ViewerOptions element.
+func GxViewerOptions(children ...GxViewerOptionsChild) GxViewerOptionsElement {
+	return GxViewerOptionsElement{element{kml.GxViewerOptions(unwrapGxViewerOptionsChildren(children)...)}}
+}
+
+// SharedGxViewerOptions returns a new shared gx:ViewerOptions element.
+func SharedGxViewerOptions(id string, children ...GxViewerOptionsChild) GxViewerOptionsElement {
+	return GxViewerOptionsElement{element{kml.SharedGxViewerOptions(id, unwrapGxViewerOptionsChildren(children)...)}}
+}
+
+// TargetGxViewerOptions returns a new gx:ViewerOptions element with a targetId.
+func TargetGxViewerOptions(targetID string, children ...GxViewerOptionsChild) GxViewerOptionsElement {
+	return GxViewerOptionsElement{element{kml.TargetGxViewerOptions(targetID, unwrapGxViewerOptionsChildren(children)...)}}
+}
+
+// A GxOptionElement is a gx:option element.
+type GxOptionElement struct {
+	element
+}
+
+func (GxOptionElement) isGxViewerOptionsChild() {}
+
+// GxOption returns a new gx:option element.
+func GxOption(name kml.GxOptionName, enabled bool) GxOptionElement {
+	return GxOptionElement{element{kml.GxOption(name, enabled)}}
+}
+
+func (RawElement) isAliasChild()              {}
+func (RawElement) isBalloonStyleChild()       {}
+func (RawElement) isCameraChild()             {}
+func (RawElement) isChangeChild()             {}
+func (RawElement) isCreateChild()             {}
+func (RawElement) isDataChild()               {}
+func (RawElement) isDeleteChild()             {}
+func (RawElement) isDocumentChild()           {}
+func (RawElement) isExtendedDataChild()       {}
+func (RawElement) isFolderChild()             {}
+func (RawElement) isGroundOverlayChild()      {}
+func (RawElement) isGxAnimatedUpdateChild()   {}
+func (RawElement) isGxFlyToChild()            {}
+func (RawElement) isGxLatLonQuadChild()       {}
+func (RawElement) isGxMultiTrackChild()       {}
+func (RawElement) isGxPlaylistChild()         {}
+func (RawElement) isGxSimpleArrayDataChild()  {}
+func (RawElement) isGxSimpleArrayFieldChild() {}
+func (RawElement) isGxSoundCueChild()         {}
+func (RawElement) isGxTimeSpanChild()         {}
+func (RawElement) isGxTimeStampChild()        {}
+func (RawElement) isGxTourChild()             {}
+func (RawElement) isGxTourControlChild()      {}
+func (RawElement) isGxTrackChild()            {}
+func (RawElement) isGxViewerOptionsChild()    {}
+func (RawElement) isGxWaitChild()             {}
+func (RawElement) isIconChild()               {}
+func (RawElement) isIconStyleChild()          {}
+func (RawElement) isImagePyramidChild()       {}
+func (RawElement) isInnerBoundaryIsChild()    {}
+func (RawElement) isItemIconChild()           {}
+func (RawElement) isKMLChild()                {}
+func (RawElement) isLODChild()                {}
+func (RawElement) isLabelStyleChild()         {}
+func (RawElement) isLatLonAltBoxChild()       {}
+func (RawElement) isLatLonBoxChild()          {}
+func (RawElement) isLineStringChild()         {}
+func (RawElement) isLineStyleChild()          {}
+func (RawElement) isLinearRingChild()         {}
+func (RawElement) isLinkChild()               {}
+func (RawElement) isListStyleChild()          {}
+func (RawElement) isLocationChild()           {}
+func (RawElement) isLookAtChild()             {}
+func (RawElement) isMetadataChild()           {}
+func (RawElement) isModelChild()              {}
+func (RawElement) isModelScaleChild()         {}
+func (RawElement) isMultiGeometryChild()      {}
+func (RawElement) isNetworkLinkChild()        {}
+func (RawElement) isNetworkLinkControlChild() {}
+func (RawElement) isOrientationChild()        {}
+func (RawElement) isOuterBoundaryIsChild()    {}
+func (RawElement) isPairChild()               {}
+func (RawElement) isPhotoOverlayChild()       {}
+func (RawElement) isPlacemarkChild()          {}
+func (RawElement) isPointChild()              {}
+func (RawElement) isPolyStyleChild()          {}
+func (RawElement) isPolygonChild()            {}
+func (RawElement) isRegionChild()             {}
+func (RawElement) isResourceMapChild()        {}
+func (RawElement) isSchemaChild()             {}
+func (RawElement) isSchemaDataChild()         {}
+func (RawElement) isScreenOverlayChild()      {}
+func (RawElement) isSimpleFieldChild()        {}
+func (RawElement) isStyleChild()              {}
+func (RawElement) isStyleMapChild()           {}
+func (RawElement) isTimeSpanChild()           {}
+func (RawElement) isTimeStampChild()          {}
+func (RawElement) isURLChild()                {}
+func (RawElement) isUpdateChild()             {}
+func (RawElement) isViewVolumeChild()         {}
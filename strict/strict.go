@@ -0,0 +1,91 @@
+// Package strict provides constructors for KML elements that only accept
+// valid children, so that invalid nesting, for example a Folder inside a
+// Point, is a compile-time error.
+//
+// The valid children of each element are generated from the KML schema.
+// Elements constructed by this package are kml.Elements, and the underlying
+// kml.Element is available as their Element field. Raw converts any
+// kml.Element into a valid child of any element.
+package strict
+
+import (
+	"github.com/twpayne/go-kml"
+)
+
+// A Child is an element that can be a child of another element.
+type Child interface {
+	kml.Element
+	kmlElement() kml.Element
+}
+
+// An element wraps a kml.Element.
+type element struct {
+	kml.Element
+}
+
+// A RawElement is an element that can be a child of any element.
+type RawElement struct {
+	element
+}
+
+func (e element) kmlElement() kml.Element {
+	return e.Element
+}
+
+// Raw returns e as an element that can be a child of any element. It is an
+// escape hatch for elements that this package does not construct, or that
+// the KML schema does not allow.
+func Raw(e kml.Element) RawElement {
+	if child, ok := e.(Child); ok {
+		e = child.kmlElement()
+	}
+	return RawElement{element{e}}
+}
+
+// Coordinates returns a new coordinates element.
+func Coordinates(value ...kml.Coordinate) CoordinatesElement {
+	return CoordinatesElement{element{kml.Coordinates(value...)}}
+}
+
+// CoordinatesArray returns a new coordinates element from an array of
+// coordinates.
+func CoordinatesArray(value ...[]float64) CoordinatesElement {
+	return CoordinatesElement{element{kml.CoordinatesArray(value...)}}
+}
+
+// CoordinatesFlat returns a new coordinates element from flat coordinates.
+func CoordinatesFlat(flatCoords []float64, offset, end, stride, dim int) CoordinatesElement {
+	return CoordinatesElement{element{kml.CoordinatesFlat(flatCoords, offset, end, stride, dim)}}
+}
+
+// DescriptionCDATA returns a new description element whose value is written
+// as a CDATA section.
+func DescriptionCDATA(value string) DescriptionElement {
+	return DescriptionElement{element{kml.DescriptionCDATA(value)}}
+}
+
+// TextCDATA returns a new text element whose value is written as a CDATA
+// section.
+func TextCDATA(value string) TextElement {
+	return TextElement{element{kml.TextCDATA(value)}}
+}
+
+// KML returns a new kml element.
+func KML(child KMLChild) KMLElement {
+	return KMLElement{element{kml.KML(child.kmlElement())}}
+}
+
+// GxAngles returns a new gx:angles element.
+func GxAngles(value kml.GxAngle) GxAnglesElement {
+	return GxAnglesElement{element{kml.GxAngles(value)}}
+}
+
+// GxCoord returns a new gx:coord element.
+func GxCoord(value kml.Coordinate) GxCoordElement {
+	return GxCoordElement{element{kml.GxCoord(value)}}
+}
+
+// GxKML returns a new kml element with the gx namespace.
+func GxKML(child KMLChild) KMLElement {
+	return KMLElement{element{kml.GxKML(child.kmlElement())}}
+}
@@ -0,0 +1,134 @@
+package strict
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/twpayne/go-kml"
+)
+
+func TestStrict(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		strict   Child
+		expected kml.Element
+	}{
+		{
+			name: "placemark",
+			strict: KML(
+				Document(
+					SharedStyle("red",
+						LineStyle(
+							Width(2),
+							GxLabelVisibility(true),
+						),
+					),
+					Placemark(
+						Name("track"),
+						GxBalloonVisibility(false),
+						StyleURL("#red"),
+						LineString(
+							Tessellate(true),
+							AltitudeMode(kml.AltitudeModeClampToGround),
+							Coordinates(kml.Coordinate{Lon: 1, Lat: 2}, kml.Coordinate{Lon: 3, Lat: 4}),
+						),
+					),
+				),
+			),
+			expected: kml.KML(
+				kml.Document(
+					kml.SharedStyle("red",
+						kml.LineStyle(
+							kml.Width(2),
+							kml.GxLabelVisibility(true),
+						),
+					),
+					kml.Placemark(
+						kml.Name("track"),
+						kml.GxBalloonVisibility(false),
+						kml.StyleURL("#red"),
+						kml.LineString(
+							kml.Tessellate(true),
+							kml.AltitudeMode(kml.AltitudeModeClampToGround),
+							kml.Coordinates(kml.Coordinate{Lon: 1, Lat: 2}, kml.Coordinate{Lon: 3, Lat: 4}),
+						),
+					),
+				),
+			),
+		},
+		{
+			name: "polygon",
+			strict: Polygon(
+				OuterBoundaryIs(
+					LinearRing(
+						CoordinatesArray([]float64{0, 0}, []float64{1, 0}, []float64{0, 1}, []float64{0, 0}),
+					),
+				),
+			),
+			expected: kml.Polygon(
+				kml.OuterBoundaryIs(
+					kml.LinearRing(
+						kml.CoordinatesArray([]float64{0, 0}, []float64{1, 0}, []float64{0, 1}, []float64{0, 0}),
+					),
+				),
+			),
+		},
+		{
+			name: "update",
+			strict: NetworkLinkControl(
+				Update(
+					TargetHref("doc.kml"),
+					Change(
+						TargetPlacemark("p1",
+							Name("changed"),
+						),
+					),
+				),
+			),
+			expected: kml.NetworkLinkControl(
+				kml.Update(
+					kml.TargetHref("doc.kml"),
+					kml.Change(
+						kml.TargetPlacemark("p1",
+							kml.Name("changed"),
+						),
+					),
+				),
+			),
+		},
+		{
+			name: "raw",
+			strict: Placemark(
+				Raw(kml.Name("raw")),
+				Raw(Name("double raw")),
+			),
+			expected: kml.Placemark(
+				kml.Name("raw"),
+				kml.Name("double raw"),
+			),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			actualElement := tc.strict.kmlElement()
+			assert.True(t, kml.Equal(tc.expected, actualElement), "%v", kml.Diff(tc.expected, actualElement))
+
+			expected := &bytes.Buffer{}
+			require.NoError(t, tc.expected.Write(expected))
+			actual := &bytes.Buffer{}
+			require.NoError(t, tc.strict.Write(actual))
+			assert.Equal(t, expected.String(), actual.String())
+		})
+	}
+}
+
+func TestElement(t *testing.T) {
+	placemark := Placemark(Name("a"))
+	_, ok := placemark.Element.(*kml.CompoundElement)
+	assert.True(t, ok)
+
+	raw := Raw(placemark)
+	assert.Equal(t, placemark.Element, raw.Element)
+}
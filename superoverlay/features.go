@@ -0,0 +1,200 @@
+package superoverlay
+
+import (
+	"io"
+	"strconv"
+
+	"github.com/twpayne/go-kml"
+)
+
+// Default feature superoverlay options.
+const (
+	DefaultMaxFeatures  = 256
+	DefaultMaxDepth     = 16
+	DefaultMinLODPixels = 128
+	DefaultMaxLODPixels = -1
+)
+
+// degeneratePadding is the padding, in degrees, added to zero-sized tile
+// bounds, for example those of coincident points.
+const degeneratePadding = 0.001
+
+// Options control how features are partitioned. Zero values are replaced by
+// the corresponding defaults.
+type Options struct {
+	// MaxFeatures is the maximum number of features in a single tile. The
+	// root tile exceeds it only if more than MaxFeatures features have no
+	// coordinates.
+	MaxFeatures int
+	// MaxDepth is the maximum depth of the quadtree. Tiles at MaxDepth
+	// contain all their remaining features.
+	MaxDepth int
+	// MinLODPixels and MaxLODPixels control when each tile is displayed.
+	MinLODPixels float64
+	MaxLODPixels float64
+}
+
+// A Tile is a node in a feature superoverlay's quadtree.
+type Tile struct {
+	// Key is the tile's quadkey. The root tile's quadkey is empty.
+	Key      string
+	Bounds   kml.Bounds
+	Features []kml.Element
+	Children []*Tile
+}
+
+// A Superoverlay is a quadtree of features.
+type Superoverlay struct {
+	Root    *Tile
+	options Options
+}
+
+// New returns a new Superoverlay containing features. Each feature is
+// assigned to a tile by the center of its Bounds, and each tile's Bounds
+// contain the full Bounds of all the features in it and its descendants.
+// Tiles closer to the root hold the earliest features in features, so more
+// important features should come first. Features without coordinates are
+// always placed in the root tile, after its other features, and count against
+// its MaxFeatures.
+func New(features []kml.Element, options Options) *Superoverlay {
+	if options.MaxFeatures == 0 {
+		options.MaxFeatures = DefaultMaxFeatures
+	}
+	if options.MaxDepth == 0 {
+		options.MaxDepth = DefaultMaxDepth
+	}
+	if options.MinLODPixels == 0 {
+		options.MinLODPixels = DefaultMinLODPixels
+	}
+	if options.MaxLODPixels == 0 {
+		options.MaxLODPixels = DefaultMaxLODPixels
+	}
+
+	bounds := kml.NewBounds()
+	located := make([]kml.Element, 0, len(features))
+	featureBounds := make([]kml.Bounds, 0, len(features))
+	var unlocated []kml.Element
+	for _, feature := range features {
+		b := kml.BoundsOf(feature)
+		if b.IsEmpty() {
+			unlocated = append(unlocated, feature)
+			continue
+		}
+		located = append(located, feature)
+		featureBounds = append(featureBounds, b)
+		bounds = bounds.Union(b)
+	}
+	if !bounds.IsEmpty() {
+		bounds = pad(bounds)
+	}
+
+	s := &Superoverlay{
+		options: options,
+	}
+	rootMaxFeatures := options.MaxFeatures - len(unlocated)
+	if rootMaxFeatures < 0 {
+		rootMaxFeatures = 0
+	}
+	s.Root = s.newTile("", bounds, located, featureBounds, rootMaxFeatures)
+	s.Root.Features = append(s.Root.Features[:len(s.Root.Features):len(s.Root.Features)], unlocated...)
+	return s
+}
+
+// newTile returns a new tile with quadkey key covering bounds containing at
+// most maxFeatures of features, recursively creating children for the
+// features that do not fit. The tile's Bounds are extended to include
+// featureBounds, the Bounds of features.
+func (s *Superoverlay) newTile(key string, bounds kml.Bounds, features []kml.Element, featureBounds []kml.Bounds, maxFeatures int) *Tile {
+	t := &Tile{
+		Key:    key,
+		Bounds: bounds,
+	}
+	for _, b := range featureBounds {
+		t.Bounds = t.Bounds.Union(b)
+	}
+	if len(features) <= maxFeatures || len(key) >= s.options.MaxDepth {
+		t.Features = features
+		return t
+	}
+	t.Features = features[:maxFeatures]
+	var childFeatures [4][]kml.Element
+	var childFeatureBounds [4][]kml.Bounds
+	for i := maxFeatures; i < len(features); i++ {
+		q := quadrant(bounds, featureBounds[i].Center())
+		childFeatures[q] = append(childFeatures[q], features[i])
+		childFeatureBounds[q] = append(childFeatureBounds[q], featureBounds[i])
+	}
+	for q, childBounds := range quadrants(bounds) {
+		if len(childFeatures[q]) == 0 {
+			continue
+		}
+		child := s.newTile(key+strconv.Itoa(q), childBounds, childFeatures[q], childFeatureBounds[q], s.options.MaxFeatures)
+		t.Children = append(t.Children, child)
+	}
+	return t
+}
+
+// pad returns b with each zero-sized dimension extended by degeneratePadding
+// in both directions, so that Regions built from it can become active.
+func pad(b kml.Bounds) kml.Bounds {
+	if b.West == b.East {
+		b.West -= degeneratePadding
+		b.East += degeneratePadding
+	}
+	if b.South == b.North {
+		b.South -= degeneratePadding
+		b.North += degeneratePadding
+	}
+	return b
+}
+
+// Tiles returns all tiles in s in depth-first order, starting with the root.
+func (s *Superoverlay) Tiles() []*Tile {
+	var tiles []*Tile
+	var visit func(*Tile)
+	visit = func(t *Tile) {
+		tiles = append(tiles, t)
+		for _, child := range t.Children {
+			visit(child)
+		}
+	}
+	visit(s.Root)
+	return tiles
+}
+
+// Filename returns t's filename. The root tile's filename is doc.kml.
+func (t *Tile) Filename() string {
+	return tileFilename(t.Key)
+}
+
+// TileKML returns the KML document for t.
+func (s *Superoverlay) TileKML(t *Tile) kml.Element {
+	children := make([]kml.Element, 0, 1+len(t.Features)+len(t.Children))
+	if !t.Bounds.IsEmpty() {
+		children = append(children, region(t.Bounds, s.options.MinLODPixels, s.options.MaxLODPixels))
+	}
+	children = append(children, t.Features...)
+	for _, child := range t.Children {
+		children = append(children, networkLink(child.Bounds, s.options.MinLODPixels, s.options.MaxLODPixels, child.Filename()))
+	}
+	return kml.KML(kml.Document(children...))
+}
+
+// WriteDir writes every tile in s to dir.
+func (s *Superoverlay) WriteDir(dir string) error {
+	return writeDir(dir, s.write)
+}
+
+// WriteKMZ writes every tile in s to w as a KMZ archive.
+func (s *Superoverlay) WriteKMZ(w io.Writer) error {
+	return writeKMZ(w, s.write)
+}
+
+func (s *Superoverlay) write(fw fileWriter) error {
+	for _, t := range s.Tiles() {
+		if err := fw.WriteKML(t.Filename(), s.TileKML(t)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,174 @@
+package superoverlay
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/twpayne/go-kml"
+)
+
+func newPoint(lon, lat float64) kml.Element {
+	return kml.Placemark(
+		kml.Point(
+			kml.Coordinates(kml.Coordinate{Lon: lon, Lat: lat}),
+		),
+	)
+}
+
+func TestNew(t *testing.T) {
+	features := []kml.Element{
+		newPoint(0, 0),
+		newPoint(-1, 1),
+		newPoint(1, 1),
+		newPoint(-1, -1),
+		newPoint(1, -1),
+		newPoint(-0.9, 0.9),
+	}
+	s := New(features, Options{MaxFeatures: 2})
+
+	var keys []string
+	for _, tile := range s.Tiles() {
+		keys = append(keys, tile.Key)
+	}
+	assert.Equal(t, []string{"", "0", "1", "2", "3"}, keys)
+	assert.Equal(t, features[:2], s.Root.Features)
+	assert.Equal(t, []kml.Element{features[2]}, s.Root.Children[1].Features)
+	assert.Equal(t, kml.Bounds{West: -1, South: 0, East: 0, North: 1}, s.Root.Children[0].Bounds)
+	assert.Equal(t, "doc.kml", s.Root.Filename())
+	assert.Equal(t, "0.kml", s.Root.Children[0].Filename())
+}
+
+func TestMaxDepth(t *testing.T) {
+	features := []kml.Element{
+		newPoint(0, 0),
+		newPoint(0, 0),
+		newPoint(0, 0),
+	}
+	s := New(features, Options{MaxFeatures: 1, MaxDepth: 2})
+	tiles := s.Tiles()
+	require.Len(t, tiles, 3)
+	assert.Equal(t, "12", tiles[2].Key)
+	assert.Len(t, tiles[2].Features, 1)
+}
+
+func TestNewNoGeometry(t *testing.T) {
+	noGeometry := kml.Placemark(kml.Name("no geometry"))
+	features := []kml.Element{
+		noGeometry,
+		newPoint(0, 0),
+		newPoint(1, 1),
+	}
+	s := New(features, Options{MaxFeatures: 1})
+	assert.Equal(t, kml.Bounds{West: 0, South: 0, East: 1, North: 1}, s.Root.Bounds)
+	assert.Equal(t, []kml.Element{noGeometry}, s.Root.Features)
+	assert.Len(t, s.Root.Children, 2)
+	for _, tile := range s.Tiles() {
+		assert.True(t, len(tile.Features) <= 1)
+		sb := &strings.Builder{}
+		require.NoError(t, xml.NewEncoder(sb).Encode(s.TileKML(tile)))
+		assert.NotContains(t, sb.String(), "NaN")
+	}
+
+	s = New([]kml.Element{noGeometry}, Options{})
+	assert.True(t, s.Root.Bounds.IsEmpty())
+	assert.Equal(t, []kml.Element{noGeometry}, s.Root.Features)
+}
+
+func TestNewCoincidentPoints(t *testing.T) {
+	s := New([]kml.Element{
+		newPoint(0, 0),
+		newPoint(0, 0),
+	}, Options{MaxFeatures: 1})
+	assert.Equal(t, kml.Bounds{West: -0.001, South: -0.001, East: 0.001, North: 0.001}, s.Root.Bounds)
+	require.Len(t, s.Root.Children, 1)
+	assert.Equal(t, kml.Bounds{West: 0, South: 0, East: 0.001, North: 0.001}, s.Root.Children[0].Bounds)
+}
+
+func TestNewExtents(t *testing.T) {
+	line := kml.Placemark(
+		kml.LineString(
+			kml.Coordinates(kml.Coordinate{Lon: 2, Lat: 3}, kml.Coordinate{Lon: 6, Lat: 1}),
+		),
+	)
+	polygon := kml.Placemark(
+		kml.Polygon(
+			kml.OuterBoundaryIs(
+				kml.LinearRing(
+					kml.Coordinates(
+						kml.Coordinate{Lon: 1, Lat: 0},
+						kml.Coordinate{Lon: 4, Lat: 0},
+						kml.Coordinate{Lon: 4, Lat: 1},
+						kml.Coordinate{Lon: 1, Lat: 1},
+						kml.Coordinate{Lon: 1, Lat: 0},
+					),
+				),
+			),
+		),
+	)
+	s := New([]kml.Element{
+		newPoint(0, 0),
+		newPoint(4, 4),
+		line,
+		polygon,
+	}, Options{MaxFeatures: 1})
+	assert.Equal(t, kml.Bounds{West: 0, South: 0, East: 6, North: 4}, s.Root.Bounds)
+	require.Len(t, s.Root.Children, 2)
+	assert.Equal(t, "1", s.Root.Children[0].Key)
+	assert.Equal(t, kml.Bounds{West: 2, South: 1, East: 6, North: 4}, s.Root.Children[0].Bounds)
+	assert.Equal(t, "2", s.Root.Children[1].Key)
+	assert.Equal(t, []kml.Element{polygon}, s.Root.Children[1].Features)
+	assert.Equal(t, kml.Bounds{West: 0, South: 0, East: 4, North: 2}, s.Root.Children[1].Bounds)
+}
+
+func TestTileKML(t *testing.T) {
+	s := New([]kml.Element{
+		newPoint(0, 0),
+		newPoint(1, 1),
+	}, Options{MaxFeatures: 1})
+	sb := &strings.Builder{}
+	require.NoError(t, xml.NewEncoder(sb).Encode(s.TileKML(s.Root)))
+	assert.Equal(t, `<kml xmlns="http://www.opengis.net/kml/2.2">`+
+		`<Document>`+
+		`<Region>`+
+		`<LatLonAltBox><north>1</north><south>0</south><east>1</east><west>0</west></LatLonAltBox>`+
+		`<Lod><minLodPixels>128</minLodPixels><maxLodPixels>-1</maxLodPixels></Lod>`+
+		`</Region>`+
+		`<Placemark><Point><coordinates>0,0</coordinates></Point></Placemark>`+
+		`<NetworkLink>`+
+		`<Region>`+
+		`<LatLonAltBox><north>1</north><south>0.5</south><east>1</east><west>0.5</west></LatLonAltBox>`+
+		`<Lod><minLodPixels>128</minLodPixels><maxLodPixels>-1</maxLodPixels></Lod>`+
+		`</Region>`+
+		`<Link><href>1.kml</href><viewRefreshMode>onRegion</viewRefreshMode></Link>`+
+		`</NetworkLink>`+
+		`</Document>`+
+		`</kml>`, sb.String())
+}
+
+func TestWrite(t *testing.T) {
+	s := New([]kml.Element{
+		newPoint(0, 0),
+		newPoint(1, 1),
+	}, Options{MaxFeatures: 1})
+
+	dir, err := ioutil.TempDir("", "superoverlay")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	require.NoError(t, s.WriteDir(dir))
+	for _, filename := range []string{"doc.kml", "1.kml"} {
+		_, err := os.Stat(filepath.Join(dir, filename))
+		assert.NoError(t, err)
+	}
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, s.WriteKMZ(buf))
+	assert.True(t, bytes.HasPrefix(buf.Bytes(), []byte("PK")))
+}
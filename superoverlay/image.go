@@ -0,0 +1,278 @@
+package superoverlay
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"strconv"
+
+	"github.com/twpayne/go-kml"
+)
+
+// Default image superoverlay options.
+const (
+	DefaultTileSize          = 256
+	DefaultImageMinLODPixels = 128
+	DefaultImageMaxLODPixels = 1024
+)
+
+// An ImageFormat is an image tile format.
+type ImageFormat string
+
+// ImageFormats.
+const (
+	ImageFormatPNG  ImageFormat = "png"
+	ImageFormatJPEG ImageFormat = "jpeg"
+)
+
+// ImageOptions control how an image is sliced into tiles. Zero values are
+// replaced by the corresponding defaults.
+type ImageOptions struct {
+	// TileSize is the maximum width and height of each tile, in pixels.
+	TileSize int
+	// MinLODPixels and MaxLODPixels control when each tile is displayed.
+	// Tiles without children are always displayed once loaded.
+	MinLODPixels float64
+	MaxLODPixels float64
+	// Format is the tile image format. The default is ImageFormatPNG.
+	Format ImageFormat
+	// JPEGQuality is the quality used when Format is ImageFormatJPEG. The
+	// default is jpeg.DefaultQuality.
+	JPEGQuality int
+}
+
+// An ImageTile is a node in an image superoverlay's tile pyramid.
+type ImageTile struct {
+	// Key is the tile's quadkey. The root tile's quadkey is empty.
+	Key string
+	// Level is the tile's level in the pyramid, starting at zero.
+	Level int
+	// Rect is the region of the source image covered by the tile.
+	Rect     image.Rectangle
+	Bounds   kml.Bounds
+	Children []*ImageTile
+}
+
+// An ImagePyramid is a tile pyramid generated from a single georeferenced
+// image.
+type ImagePyramid struct {
+	Root    *ImageTile
+	image   image.Image
+	bounds  kml.Bounds
+	options ImageOptions
+}
+
+// NewImagePyramid returns a new ImagePyramid for m, whose north-up extent is
+// bounds. Tiles are subdivided until each covers at most TileSize pixels of m
+// in each dimension.
+func NewImagePyramid(m image.Image, bounds kml.Bounds, options ImageOptions) *ImagePyramid {
+	if options.TileSize == 0 {
+		options.TileSize = DefaultTileSize
+	}
+	if options.MinLODPixels == 0 {
+		options.MinLODPixels = DefaultImageMinLODPixels
+	}
+	if options.MaxLODPixels == 0 {
+		options.MaxLODPixels = DefaultImageMaxLODPixels
+	}
+	if options.Format == "" {
+		options.Format = ImageFormatPNG
+	}
+	if options.JPEGQuality == 0 {
+		options.JPEGQuality = jpeg.DefaultQuality
+	}
+	p := &ImagePyramid{
+		image:   m,
+		bounds:  bounds,
+		options: options,
+	}
+	p.Root = p.newTile("", m.Bounds())
+	return p
+}
+
+// newTile returns a new tile with quadkey key covering rect, recursively
+// creating children until rect fits in a single tile.
+func (p *ImagePyramid) newTile(key string, rect image.Rectangle) *ImageTile {
+	t := &ImageTile{
+		Key:    key,
+		Level:  len(key),
+		Rect:   rect,
+		Bounds: p.rectBounds(rect),
+	}
+	if rect.Dx() <= p.options.TileSize && rect.Dy() <= p.options.TileSize {
+		return t
+	}
+	mid := image.Pt((rect.Min.X+rect.Max.X)/2, (rect.Min.Y+rect.Max.Y)/2)
+	for q, childRect := range [4]image.Rectangle{
+		image.Rect(rect.Min.X, rect.Min.Y, mid.X, mid.Y),
+		image.Rect(mid.X, rect.Min.Y, rect.Max.X, mid.Y),
+		image.Rect(rect.Min.X, mid.Y, mid.X, rect.Max.Y),
+		image.Rect(mid.X, mid.Y, rect.Max.X, rect.Max.Y),
+	} {
+		if childRect.Empty() {
+			continue
+		}
+		t.Children = append(t.Children, p.newTile(key+strconv.Itoa(q), childRect))
+	}
+	return t
+}
+
+// rectBounds returns the geographical bounds of rect.
+func (p *ImagePyramid) rectBounds(rect image.Rectangle) kml.Bounds {
+	mb := p.image.Bounds()
+	dLon := (p.bounds.East - p.bounds.West) / float64(mb.Dx())
+	dLat := (p.bounds.North - p.bounds.South) / float64(mb.Dy())
+	return kml.Bounds{
+		West:   p.bounds.West + float64(rect.Min.X-mb.Min.X)*dLon,
+		South:  p.bounds.North - float64(rect.Max.Y-mb.Min.Y)*dLat,
+		East:   p.bounds.West + float64(rect.Max.X-mb.Min.X)*dLon,
+		North:  p.bounds.North - float64(rect.Min.Y-mb.Min.Y)*dLat,
+		MinAlt: p.bounds.MinAlt,
+		MaxAlt: p.bounds.MaxAlt,
+	}
+}
+
+// Tiles returns all tiles in p in depth-first order, starting with the root.
+func (p *ImagePyramid) Tiles() []*ImageTile {
+	var tiles []*ImageTile
+	var visit func(*ImageTile)
+	visit = func(t *ImageTile) {
+		tiles = append(tiles, t)
+		for _, child := range t.Children {
+			visit(child)
+		}
+	}
+	visit(p.Root)
+	return tiles
+}
+
+// Filename returns t's filename. The root tile's filename is doc.kml.
+func (t *ImageTile) Filename() string {
+	return tileFilename(t.Key)
+}
+
+// ImageFilename returns the filename of t's image.
+func (p *ImagePyramid) ImageFilename(t *ImageTile) string {
+	name := t.Key
+	if name == "" {
+		name = "root"
+	}
+	extension := ".png"
+	if p.options.Format == ImageFormatJPEG {
+		extension = ".jpg"
+	}
+	return "images/" + name + extension
+}
+
+// TileKML returns the KML document for t.
+func (p *ImagePyramid) TileKML(t *ImageTile) kml.Element {
+	maxLODPixels := p.options.MaxLODPixels
+	if len(t.Children) == 0 {
+		maxLODPixels = -1
+	}
+	children := []kml.Element{
+		kml.GroundOverlay(
+			region(t.Bounds, p.options.MinLODPixels, maxLODPixels),
+			kml.DrawOrder(t.Level),
+			kml.Icon(
+				kml.Href(p.ImageFilename(t)),
+			),
+			t.Bounds.LatLonBox(),
+		),
+	}
+	for _, child := range t.Children {
+		children = append(children, networkLink(child.Bounds, p.options.MinLODPixels, -1, child.Filename()))
+	}
+	return kml.KML(kml.Document(children...))
+}
+
+// TileImage returns t's image, downsampled to fit in a single tile.
+func (p *ImagePyramid) TileImage(t *ImageTile) image.Image {
+	w, h := t.Rect.Dx(), t.Rect.Dy()
+	if w > p.options.TileSize || h > p.options.TileSize {
+		if w >= h {
+			w, h = p.options.TileSize, maxInt(1, h*p.options.TileSize/w)
+		} else {
+			w, h = maxInt(1, w*p.options.TileSize/h), p.options.TileSize
+		}
+	}
+	return downsample(p.image, t.Rect, w, h)
+}
+
+// WriteDir writes every tile in p and its image to dir.
+func (p *ImagePyramid) WriteDir(dir string) error {
+	return writeDir(dir, p.write)
+}
+
+// WriteKMZ writes every tile in p and its image to w as a KMZ archive.
+func (p *ImagePyramid) WriteKMZ(w io.Writer) error {
+	return writeKMZ(w, p.write)
+}
+
+func (p *ImagePyramid) write(fw fileWriter) error {
+	tiles := p.Tiles()
+	for _, t := range tiles {
+		if err := fw.WriteKML(t.Filename(), p.TileKML(t)); err != nil {
+			return err
+		}
+	}
+	for _, t := range tiles {
+		buf := &bytes.Buffer{}
+		if err := p.encode(buf, p.TileImage(t)); err != nil {
+			return err
+		}
+		if err := fw.WriteFile(p.ImageFilename(t), buf.Bytes()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *ImagePyramid) encode(w io.Writer, m image.Image) error {
+	if p.options.Format == ImageFormatJPEG {
+		return jpeg.Encode(w, m, &jpeg.Options{Quality: p.options.JPEGQuality})
+	}
+	return png.Encode(w, m)
+}
+
+// downsample returns the region rect of m scaled to w by h pixels by
+// averaging the source pixels that cover each destination pixel.
+func downsample(m image.Image, rect image.Rectangle, w, h int) *image.NRGBA {
+	dst := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		y0 := rect.Min.Y + y*rect.Dy()/h
+		y1 := maxInt(y0+1, rect.Min.Y+(y+1)*rect.Dy()/h)
+		for x := 0; x < w; x++ {
+			x0 := rect.Min.X + x*rect.Dx()/w
+			x1 := maxInt(x0+1, rect.Min.X+(x+1)*rect.Dx()/w)
+			var r, g, b, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					sr, sg, sb, sa := m.At(sx, sy).RGBA()
+					r += uint64(sr)
+					g += uint64(sg)
+					b += uint64(sb)
+					a += uint64(sa)
+					n++
+				}
+			}
+			dst.Set(x, y, color.RGBA64{
+				R: uint16(r / n),
+				G: uint16(g / n),
+				B: uint16(b / n),
+				A: uint16(a / n),
+			})
+		}
+	}
+	return dst
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
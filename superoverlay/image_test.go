@@ -0,0 +1,103 @@
+package superoverlay
+
+import (
+	"bytes"
+	"encoding/xml"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/twpayne/go-kml"
+)
+
+func TestNewImagePyramid(t *testing.T) {
+	m := image.NewNRGBA(image.Rect(0, 0, 4, 2))
+	p := NewImagePyramid(m, kml.Bounds{West: 0, South: 0, East: 4, North: 2}, ImageOptions{TileSize: 2})
+
+	tiles := p.Tiles()
+	var keys []string
+	for _, tile := range tiles {
+		keys = append(keys, tile.Key)
+	}
+	assert.Equal(t, []string{"", "0", "1", "2", "3"}, keys)
+	assert.Equal(t, kml.Bounds{West: 2, South: 1, East: 4, North: 2}, tiles[2].Bounds)
+	assert.Equal(t, image.Rect(2, 0, 4, 1), tiles[2].Rect)
+	assert.Equal(t, "images/root.png", p.ImageFilename(p.Root))
+	assert.Equal(t, "images/1.png", p.ImageFilename(tiles[2]))
+	assert.Equal(t, image.Rect(0, 0, 2, 1), p.TileImage(p.Root).Bounds())
+}
+
+func TestImageTileKML(t *testing.T) {
+	m := image.NewNRGBA(image.Rect(0, 0, 2, 1))
+	p := NewImagePyramid(m, kml.Bounds{West: 0, South: 0, East: 2, North: 1}, ImageOptions{TileSize: 1})
+	sb := &strings.Builder{}
+	require.NoError(t, xml.NewEncoder(sb).Encode(p.TileKML(p.Root.Children[1])))
+	assert.Equal(t, `<kml xmlns="http://www.opengis.net/kml/2.2">`+
+		`<Document>`+
+		`<GroundOverlay>`+
+		`<Region>`+
+		`<LatLonAltBox><north>1</north><south>0</south><east>2</east><west>1</west></LatLonAltBox>`+
+		`<Lod><minLodPixels>128</minLodPixels><maxLodPixels>-1</maxLodPixels></Lod>`+
+		`</Region>`+
+		`<drawOrder>1</drawOrder>`+
+		`<Icon><href>images/3.png</href></Icon>`+
+		`<LatLonBox><north>1</north><south>0</south><east>2</east><west>1</west></LatLonBox>`+
+		`</GroundOverlay>`+
+		`</Document>`+
+		`</kml>`, sb.String())
+}
+
+func TestDownsample(t *testing.T) {
+	m := image.NewNRGBA(image.Rect(0, 0, 2, 2))
+	m.Set(0, 0, color.White)
+	m.Set(1, 0, color.Black)
+	m.Set(0, 1, color.White)
+	m.Set(1, 1, color.Black)
+	assert.Equal(t, color.NRGBA{R: 0x7f, G: 0x7f, B: 0x7f, A: 0xff}, downsample(m, m.Bounds(), 1, 1).At(0, 0))
+}
+
+func TestImagePyramidWriteKMZ(t *testing.T) {
+	m := image.NewNRGBA(image.Rect(0, 0, 4, 4))
+	p := NewImagePyramid(m, kml.Bounds{West: 0, South: 0, East: 1, North: 1}, ImageOptions{TileSize: 2, Format: ImageFormatJPEG})
+	assert.Equal(t, "images/0.jpg", p.ImageFilename(p.Root.Children[0]))
+	buf := &bytes.Buffer{}
+	require.NoError(t, p.WriteKMZ(buf))
+	assert.True(t, bytes.HasPrefix(buf.Bytes(), []byte("PK")))
+}
+
+func TestImagePyramidJPEGQuality(t *testing.T) {
+	m := image.NewNRGBA(image.Rect(0, 0, 64, 64))
+	for y := 0; y < 64; y++ {
+		for x := 0; x < 64; x++ {
+			m.Set(x, y, color.NRGBA{R: uint8(4 * x), G: uint8(4 * y), B: 128, A: 0xff})
+		}
+	}
+	p := NewImagePyramid(m, kml.Bounds{West: 0, South: 0, East: 1, North: 1}, ImageOptions{Format: ImageFormatJPEG})
+	buf := &bytes.Buffer{}
+	require.NoError(t, p.encode(buf, p.TileImage(p.Root)))
+	decoded, err := jpeg.Decode(buf)
+	require.NoError(t, err)
+
+	var sum, n int
+	for y := 0; y < 64; y++ {
+		for x := 0; x < 64; x++ {
+			r0, g0, b0, _ := m.At(x, y).RGBA()
+			r1, g1, b1, _ := decoded.At(x, y).RGBA()
+			sum += absInt(int(r0>>8)-int(r1>>8)) + absInt(int(g0>>8)-int(g1>>8)) + absInt(int(b0>>8)-int(b1>>8))
+			n += 3
+		}
+	}
+	assert.Less(t, float64(sum)/float64(n), 4.0)
+}
+
+func absInt(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,126 @@
+// Package superoverlay generates Region-based superoverlays, trees of KML
+// documents linked with NetworkLinks that Google Earth loads progressively as
+// the user zooms in.
+//
+// See https://developers.google.com/kml/documentation/regions.
+package superoverlay
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/twpayne/go-kml"
+	"github.com/twpayne/go-kml/kmz"
+)
+
+// A fileWriter writes the files of a superoverlay.
+type fileWriter interface {
+	WriteKML(filename string, e kml.Element) error
+	WriteFile(filename string, data []byte) error
+}
+
+// A dirWriter writes files into a directory.
+type dirWriter string
+
+// WriteKML writes e to filename in dw.
+func (dw dirWriter) WriteKML(filename string, e kml.Element) error {
+	path := filepath.Join(string(dw), filepath.FromSlash(filename))
+	if err := os.MkdirAll(filepath.Dir(path), 0o777); err != nil {
+		return err
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := e.Write(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// WriteFile writes data to filename in dw.
+func (dw dirWriter) WriteFile(filename string, data []byte) error {
+	path := filepath.Join(string(dw), filepath.FromSlash(filename))
+	if err := os.MkdirAll(filepath.Dir(path), 0o777); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, data, 0o666)
+}
+
+// writeDir calls write with a fileWriter that writes into dir.
+func writeDir(dir string, write func(fileWriter) error) error {
+	return write(dirWriter(dir))
+}
+
+// writeKMZ calls write with a fileWriter that writes a KMZ archive to w.
+func writeKMZ(w io.Writer, write func(fileWriter) error) error {
+	kmzw := kmz.NewWriter(w)
+	if err := write(kmzw); err != nil {
+		return err
+	}
+	return kmzw.Close()
+}
+
+// region returns a new Region element for b.
+func region(b kml.Bounds, minLODPixels, maxLODPixels float64) kml.Element {
+	return kml.Region(
+		kml.LatLonAltBox(
+			kml.North(b.North),
+			kml.South(b.South),
+			kml.East(b.East),
+			kml.West(b.West),
+		),
+		kml.LOD(
+			kml.MinLODPixels(minLODPixels),
+			kml.MaxLODPixels(maxLODPixels),
+		),
+	)
+}
+
+// networkLink returns a new NetworkLink element that loads href when b is
+// active.
+func networkLink(b kml.Bounds, minLODPixels, maxLODPixels float64, href string) kml.Element {
+	return kml.NetworkLink(
+		region(b, minLODPixels, maxLODPixels),
+		kml.Link(
+			kml.Href(href),
+			kml.ViewRefreshMode(kml.ViewRefreshModeOnRegion),
+		),
+	)
+}
+
+// quadrants returns the four quadrants of b in quadkey order: north west,
+// north east, south west, and south east.
+func quadrants(b kml.Bounds) [4]kml.Bounds {
+	center := b.Center()
+	return [4]kml.Bounds{
+		{West: b.West, South: center.Lat, East: center.Lon, North: b.North, MinAlt: b.MinAlt, MaxAlt: b.MaxAlt},
+		{West: center.Lon, South: center.Lat, East: b.East, North: b.North, MinAlt: b.MinAlt, MaxAlt: b.MaxAlt},
+		{West: b.West, South: b.South, East: center.Lon, North: center.Lat, MinAlt: b.MinAlt, MaxAlt: b.MaxAlt},
+		{West: center.Lon, South: b.South, East: b.East, North: center.Lat, MinAlt: b.MinAlt, MaxAlt: b.MaxAlt},
+	}
+}
+
+// quadrant returns the index of the quadrant of b that contains c.
+func quadrant(b kml.Bounds, c kml.Coordinate) int {
+	center := b.Center()
+	i := 0
+	if c.Lon >= center.Lon {
+		i |= 1
+	}
+	if c.Lat < center.Lat {
+		i |= 2
+	}
+	return i
+}
+
+// tileFilename returns the filename of the tile with quadkey key.
+func tileFilename(key string) string {
+	if key == "" {
+		return kmz.DefaultFilename
+	}
+	return key + ".kml"
+}
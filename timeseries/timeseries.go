@@ -0,0 +1,172 @@
+// Package timeseries builds time-animated KML documents from snapshots of
+// features.
+//
+// See https://developers.google.com/kml/documentation/time.
+package timeseries
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/twpayne/go-kml"
+	"github.com/twpayne/go-kml/sphere"
+)
+
+// DefaultFOV is the default field of view used to frame documents, in
+// degrees.
+const DefaultFOV = 60
+
+// ErrNotCompound is returned when a snapshot's feature cannot have children
+// added.
+var ErrNotCompound = errors.New("timeseries: feature is not a compound element")
+
+// A Snapshot is the state of a feature at a time. Snapshots with the same Key
+// are successive states of the same object.
+type Snapshot struct {
+	Key     string
+	Time    time.Time
+	Feature kml.Element
+}
+
+// Options control how snapshots are animated.
+type Options struct {
+	// End is the end of the last snapshot of each key. If zero, the last
+	// snapshots remain visible indefinitely.
+	End time.Time
+	// Fade extends each snapshot past the start of the next snapshot of the
+	// same key, so that successive states overlap and leave a trail.
+	Fade time.Duration
+	// Sphere is used to frame the document. The default is sphere.FAI.
+	Sphere sphere.T
+	// FOV is the field of view used to frame the document, in degrees. The
+	// default is DefaultFOV.
+	FOV float64
+}
+
+// A feature is a feature whose children can be replaced.
+type feature interface {
+	Children() []kml.Element
+	SetChildren(children ...kml.Element) *kml.CompoundElement
+}
+
+// beforeTimePrimitive are the names of the children of features that come
+// before the TimePrimitive.
+var beforeTimePrimitive = map[string]bool{
+	"name":               true,
+	"visibility":         true,
+	"open":               true,
+	"atom:author":        true,
+	"atom:link":          true,
+	"address":            true,
+	"xal:AddressDetails": true,
+	"phoneNumber":        true,
+	"Snippet":            true,
+	"description":        true,
+	"Camera":             true,
+	"LookAt":             true,
+}
+
+// timePrimitives are the names of TimePrimitives.
+var timePrimitives = map[string]bool{
+	"TimeSpan":     true,
+	"TimeStamp":    true,
+	"gx:TimeSpan":  true,
+	"gx:TimeStamp": true,
+}
+
+// Document returns a new Document element containing children, a LookAt that
+// frames all snapshots with a gx:TimeSpan covering their times, and copies of
+// the features of snapshots. A TimeSpan that begins at each snapshot's time
+// and ends at the next snapshot with the same key, extended by Fade, replaces
+// any TimePrimitive of each snapshot's feature. snapshots are not modified.
+//
+// The LookAt uses the gx namespace, so the Document should be wrapped in a
+// kml.GxKML element.
+func Document(snapshots []Snapshot, options Options, children ...kml.Element) (*kml.CompoundElement, error) {
+	if options.Sphere.R == 0 {
+		options.Sphere = sphere.FAI
+	}
+	if options.FOV == 0 {
+		options.FOV = DefaultFOV
+	}
+
+	for _, snapshot := range snapshots {
+		if _, ok := snapshot.Feature.(feature); !ok {
+			return nil, ErrNotCompound
+		}
+	}
+
+	sorted := make([]Snapshot, len(snapshots))
+	copy(sorted, snapshots)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Time.Before(sorted[j].Time)
+	})
+
+	spans := make([]kml.Element, len(sorted))
+	nextIndex := make(map[string]int)
+	for i := len(sorted) - 1; i >= 0; i-- {
+		if j, ok := nextIndex[sorted[i].Key]; ok {
+			spans[i] = timeSpan(sorted[i].Time, sorted[j].Time.Add(options.Fade))
+		} else {
+			spans[i] = timeSpan(sorted[i].Time, options.End)
+		}
+		nextIndex[sorted[i].Key] = i
+	}
+
+	bounds := kml.NewBounds()
+	features := make([]kml.Element, 0, len(sorted))
+	for i, snapshot := range sorted {
+		f := kml.Clone(snapshot.Feature)
+		withTimePrimitive(f.(feature), spans[i])
+		bounds = bounds.Union(kml.BoundsOf(f))
+		features = append(features, f)
+	}
+
+	if len(sorted) != 0 {
+		end := sorted[len(sorted)-1].Time
+		if !options.End.IsZero() {
+			end = options.End
+		}
+		gxTimeSpan := kml.GxTimeSpan(
+			kml.Begin(sorted[0].Time),
+			kml.End(end),
+		)
+		lookAt := options.Sphere.LookAt(bounds, options.FOV)
+		lookAt.SetChildren(append([]kml.Element{gxTimeSpan}, lookAt.Children()...)...)
+		children = append(children, lookAt)
+	}
+	return kml.Document(append(children, features...)...), nil
+}
+
+// timeSpan returns a new TimeSpan element from begin to end, which is omitted
+// if zero.
+func timeSpan(begin, end time.Time) kml.Element {
+	if end.IsZero() {
+		return kml.TimeSpan(kml.Begin(begin))
+	}
+	return kml.TimeSpan(kml.Begin(begin), kml.End(end))
+}
+
+// withTimePrimitive replaces any TimePrimitive of f with timePrimitive, at
+// its position in the schema.
+func withTimePrimitive(f feature, timePrimitive kml.Element) {
+	children := make([]kml.Element, 0, len(f.Children())+1)
+	inserted := false
+	for _, child := range f.Children() {
+		start, _ := kml.StartElementOf(child)
+		name := start.Name.Local
+		switch {
+		case timePrimitives[name]:
+			continue
+		case !inserted && !beforeTimePrimitive[name]:
+			children = append(children, timePrimitive)
+			inserted = true
+		}
+		children = append(children, child)
+	}
+	if !inserted {
+		children = append(children, timePrimitive)
+	}
+	f.SetChildren(children...)
+}
@@ -0,0 +1,148 @@
+package timeseries
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/twpayne/go-kml"
+	"github.com/twpayne/go-kml/sphere"
+)
+
+func TestDocument(t *testing.T) {
+	t0 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	placemark := func(name string, lon float64) *kml.CompoundElement {
+		return kml.Placemark(
+			kml.Name(name),
+			kml.Point(kml.Coordinates(kml.Coordinate{Lon: lon, Lat: 0})),
+		)
+	}
+
+	rangeX := sphere.FAI.FramingDistance(kml.Bounds{West: 0, East: 1}, DefaultFOV)
+
+	for _, tc := range []struct {
+		name      string
+		snapshots []Snapshot
+		options   Options
+		expected  *kml.CompoundElement
+	}{
+		{
+			name: "empty",
+			expected: kml.Document(
+				kml.Name("empty"),
+			),
+		},
+		{
+			name: "single_series",
+			snapshots: []Snapshot{
+				{Time: t0.Add(time.Hour), Feature: placemark("b", 1)},
+				{Time: t0, Feature: placemark("a", 0)},
+			},
+			expected: kml.Document(
+				kml.Name("single_series"),
+				kml.LookAt(
+					kml.GxTimeSpan(kml.Begin(t0), kml.End(t0.Add(time.Hour))),
+					kml.Longitude(0.5),
+					kml.Latitude(0),
+					kml.Heading(0),
+					kml.Tilt(0),
+					kml.Range(rangeX),
+				),
+				kml.Placemark(
+					kml.Name("a"),
+					kml.TimeSpan(kml.Begin(t0), kml.End(t0.Add(time.Hour))),
+					kml.Point(kml.Coordinates(kml.Coordinate{Lon: 0, Lat: 0})),
+				),
+				kml.Placemark(
+					kml.Name("b"),
+					kml.TimeSpan(kml.Begin(t0.Add(time.Hour))),
+					kml.Point(kml.Coordinates(kml.Coordinate{Lon: 1, Lat: 0})),
+				),
+			),
+		},
+		{
+			name: "keys_end_and_fade",
+			snapshots: []Snapshot{
+				{Key: "x", Time: t0, Feature: placemark("x0", 0)},
+				{Key: "y", Time: t0.Add(time.Minute), Feature: placemark("y0", 1)},
+				{Key: "x", Time: t0.Add(time.Hour), Feature: placemark("x1", 0)},
+			},
+			options: Options{
+				End:  t0.Add(2 * time.Hour),
+				Fade: time.Minute,
+			},
+			expected: kml.Document(
+				kml.Name("keys_end_and_fade"),
+				kml.LookAt(
+					kml.GxTimeSpan(kml.Begin(t0), kml.End(t0.Add(2*time.Hour))),
+					kml.Longitude(0.5),
+					kml.Latitude(0),
+					kml.Heading(0),
+					kml.Tilt(0),
+					kml.Range(rangeX),
+				),
+				kml.Placemark(
+					kml.Name("x0"),
+					kml.TimeSpan(kml.Begin(t0), kml.End(t0.Add(time.Hour+time.Minute))),
+					kml.Point(kml.Coordinates(kml.Coordinate{Lon: 0, Lat: 0})),
+				),
+				kml.Placemark(
+					kml.Name("y0"),
+					kml.TimeSpan(kml.Begin(t0.Add(time.Minute)), kml.End(t0.Add(2*time.Hour))),
+					kml.Point(kml.Coordinates(kml.Coordinate{Lon: 1, Lat: 0})),
+				),
+				kml.Placemark(
+					kml.Name("x1"),
+					kml.TimeSpan(kml.Begin(t0.Add(time.Hour)), kml.End(t0.Add(2*time.Hour))),
+					kml.Point(kml.Coordinates(kml.Coordinate{Lon: 0, Lat: 0})),
+				),
+			),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := Document(tc.snapshots, tc.options, kml.Name(tc.name))
+			require.NoError(t, err)
+			assert.True(t, kml.Equal(tc.expected, actual), "%v", kml.DiffTolerance(tc.expected, actual, 1e-3))
+			assert.Empty(t, kml.Validate(kml.GxKML(actual)))
+		})
+	}
+}
+
+func TestDocumentTimePrimitivePosition(t *testing.T) {
+	t0 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	feature := kml.SharedPlacemark("p",
+		kml.Name("p"),
+		kml.Description("d"),
+		kml.TimeStamp(kml.When(t0)),
+		kml.StyleURL("#s"),
+		kml.Point(kml.Coordinates(kml.Coordinate{Lon: 1, Lat: 2})),
+	)
+	snapshots := []Snapshot{
+		{Time: t0, Feature: feature},
+	}
+	original := kml.Clone(feature)
+
+	actual, err := Document(snapshots, Options{})
+	require.NoError(t, err)
+	require.Len(t, actual.Children(), 2)
+	expected := kml.SharedPlacemark("p",
+		kml.Name("p"),
+		kml.Description("d"),
+		kml.TimeSpan(kml.Begin(t0)),
+		kml.StyleURL("#s"),
+		kml.Point(kml.Coordinates(kml.Coordinate{Lon: 1, Lat: 2})),
+	)
+	assert.True(t, kml.Equal(expected, actual.Children()[1]), "%v", kml.Diff(expected, actual.Children()[1]))
+	assert.Equal(t, "p", actual.Children()[1].(*kml.SharedElement).ID())
+	assert.True(t, kml.Equal(original, feature))
+}
+
+func TestDocumentNotCompound(t *testing.T) {
+	_, err := Document([]Snapshot{
+		{Time: time.Now(), Feature: kml.Placemark()},
+		{Time: time.Now(), Feature: kml.Name("not a feature")},
+	}, Options{})
+	assert.Equal(t, ErrNotCompound, err)
+}
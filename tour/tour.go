@@ -0,0 +1,205 @@
+// Package tour provides a builder for gx:Tour elements that fly the camera
+// along a path.
+//
+// See https://developers.google.com/kml/documentation/touring.
+package tour
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	"github.com/twpayne/go-kml"
+	"github.com/twpayne/go-kml/sphere"
+)
+
+// Default options.
+const (
+	DefaultAltitude        = 500
+	DefaultTilt            = 60
+	DefaultLeadDistance    = 1000
+	DefaultSpeed           = 100
+	DefaultInitialDuration = 5 * time.Second
+)
+
+// ErrLengthMismatch is returned when a track's times and coordinates have
+// different lengths.
+var ErrLengthMismatch = errors.New("tour: times and coordinates have different lengths")
+
+// Options control how the camera follows a path. Zero and nil values are
+// replaced by the corresponding defaults.
+type Options struct {
+	// Sphere is used for distance and bearing calculations. The default is
+	// sphere.FAI.
+	Sphere sphere.T
+	// Altitude is the camera's altitude above the ground, in meters. Use
+	// Float64 to set it.
+	Altitude *float64
+	// Tilt is the camera's tilt, in degrees. Use Float64 to set it.
+	Tilt *float64
+	// LeadDistance is the horizontal distance between the camera and the
+	// point that it follows, in meters.
+	LeadDistance float64
+	// Speed is the speed at which the camera moves along paths without
+	// times, in meters per second.
+	Speed float64
+	// TimeScale is the number of seconds that a second of a track's time
+	// takes in the tour. The default is 1, playing tracks in real time.
+	TimeScale float64
+	// InitialDuration is the duration of the first flight to the start of
+	// each path.
+	InitialDuration time.Duration
+}
+
+// A Builder builds a gx:Tour.
+type Builder struct {
+	options  Options
+	altitude float64
+	tilt     float64
+	children []kml.Element
+}
+
+// Float64 returns a pointer to f, for use in Options.
+func Float64(f float64) *float64 {
+	return &f
+}
+
+// New returns a new Builder with options.
+func New(options Options) *Builder {
+	if options.Sphere.R == 0 {
+		options.Sphere = sphere.FAI
+	}
+	altitude := float64(DefaultAltitude)
+	if options.Altitude != nil {
+		altitude = *options.Altitude
+	}
+	tilt := float64(DefaultTilt)
+	if options.Tilt != nil {
+		tilt = *options.Tilt
+	}
+	if options.LeadDistance == 0 {
+		options.LeadDistance = DefaultLeadDistance
+	}
+	if options.Speed == 0 {
+		options.Speed = DefaultSpeed
+	}
+	if options.TimeScale == 0 {
+		options.TimeScale = 1
+	}
+	if options.InitialDuration == 0 {
+		options.InitialDuration = DefaultInitialDuration
+	}
+	return &Builder{
+		options:  options,
+		altitude: altitude,
+		tilt:     tilt,
+	}
+}
+
+// FlyAlong appends gx:FlyTo steps that follow cs at the configured speed.
+func (b *Builder) FlyAlong(cs []kml.Coordinate) *Builder {
+	durations := make([]time.Duration, len(cs))
+	for i := 1; i < len(cs); i++ {
+		distance := b.options.Sphere.HaversineDistance(cs[i-1], cs[i])
+		durations[i] = time.Duration(distance / b.options.Speed * float64(time.Second))
+	}
+	return b.flyAlong(cs, durations)
+}
+
+// FlyAlongTrack appends gx:FlyTo steps that follow the track with times ts
+// and coordinates cs, scaled by the configured time scale. It returns
+// ErrLengthMismatch, and appends nothing, if ts and cs have different
+// lengths.
+func (b *Builder) FlyAlongTrack(ts []time.Time, cs []kml.Coordinate) (*Builder, error) {
+	if len(ts) != len(cs) {
+		return b, ErrLengthMismatch
+	}
+	durations := make([]time.Duration, len(cs))
+	for i := 1; i < len(cs); i++ {
+		durations[i] = time.Duration(float64(ts[i].Sub(ts[i-1])) * b.options.TimeScale)
+	}
+	return b.flyAlong(cs, durations), nil
+}
+
+// Wait appends a gx:Wait of duration d.
+func (b *Builder) Wait(d time.Duration) *Builder {
+	b.children = append(b.children, kml.GxWait(
+		kml.GxDuration(d.Seconds()),
+	))
+	return b
+}
+
+// ShowBalloon appends a gx:AnimatedUpdate that opens the balloon of the
+// feature with id.
+func (b *Builder) ShowBalloon(id string) *Builder {
+	return b.balloonVisibility(id, true)
+}
+
+// HideBalloon appends a gx:AnimatedUpdate that closes the balloon of the
+// feature with id.
+func (b *Builder) HideBalloon(id string) *Builder {
+	return b.balloonVisibility(id, false)
+}
+
+// Add appends arbitrary tour primitives.
+func (b *Builder) Add(children ...kml.Element) *Builder {
+	b.children = append(b.children, children...)
+	return b
+}
+
+// Tour returns a new gx:Tour element with children followed by a
+// gx:Playlist of the steps added to b.
+func (b *Builder) Tour(children ...kml.Element) *kml.CompoundElement {
+	return kml.GxTour(append(children, kml.GxPlaylist(b.children...))...)
+}
+
+func (b *Builder) flyAlong(cs []kml.Coordinate, durations []time.Duration) *Builder {
+	for i, c := range cs {
+		var heading float64
+		switch {
+		case i+1 < len(cs):
+			heading = b.options.Sphere.InitialBearingTo(c, cs[i+1])
+		case i > 0:
+			heading = b.options.Sphere.InitialBearingTo(cs[i-1], c)
+		}
+		heading = math.Mod(heading+360, 360)
+		duration := durations[i]
+		if i == 0 {
+			duration = b.options.InitialDuration
+		}
+		b.children = append(b.children, kml.GxFlyTo(
+			kml.GxDuration(duration.Seconds()),
+			kml.GxFlyToMode(kml.GxFlyToModeSmooth),
+			b.camera(c, heading),
+		))
+	}
+	return b
+}
+
+// camera returns a Camera element following c with heading.
+func (b *Builder) camera(c kml.Coordinate, heading float64) kml.Element {
+	position := b.options.Sphere.Offset(c, b.options.LeadDistance, heading+180)
+	return kml.Camera(
+		kml.Longitude(position.Lon),
+		kml.Latitude(position.Lat),
+		kml.Altitude(b.altitude),
+		kml.Heading(heading),
+		kml.Tilt(b.tilt),
+		kml.AltitudeMode(kml.AltitudeModeRelativeToGround),
+	)
+}
+
+func (b *Builder) balloonVisibility(id string, visibility bool) *Builder {
+	b.children = append(b.children, kml.GxAnimatedUpdate(
+		kml.GxDuration(0),
+		kml.Update(
+			kml.TargetHref(""),
+			kml.Change(
+				kml.TargetPlacemark(id,
+					kml.GxBalloonVisibility(visibility),
+				),
+			),
+		),
+	))
+	return b
+}
@@ -0,0 +1,112 @@
+package tour
+
+import (
+	"encoding/xml"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/twpayne/go-kml"
+	"github.com/twpayne/go-kml/sphere"
+)
+
+func encode(t *testing.T, e kml.Element) string {
+	t.Helper()
+	sb := &strings.Builder{}
+	require.NoError(t, xml.NewEncoder(sb).Encode(e))
+	return sb.String()
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+func TestFlyAlong(t *testing.T) {
+	cs := []kml.Coordinate{{Lon: 0, Lat: 0}, {Lon: 0, Lat: 1}}
+	distance := sphere.FAI.HaversineDistance(cs[0], cs[1])
+	camera0 := sphere.FAI.Offset(cs[0], 1000, 180)
+	camera1 := sphere.FAI.Offset(cs[1], 1000, 180)
+	actual := encode(t, New(Options{Speed: 10}).FlyAlong(cs).Tour(kml.Name("north")))
+	assert.Equal(t, `<gx:Tour>`+
+		`<name>north</name>`+
+		`<gx:Playlist>`+
+		`<gx:FlyTo>`+
+		`<gx:duration>5</gx:duration>`+
+		`<gx:flyToMode>smooth</gx:flyToMode>`+
+		`<Camera>`+
+		`<longitude>`+formatFloat(camera0.Lon)+`</longitude>`+
+		`<latitude>`+formatFloat(camera0.Lat)+`</latitude>`+
+		`<altitude>500</altitude>`+
+		`<heading>0</heading>`+
+		`<tilt>60</tilt>`+
+		`<altitudeMode>relativeToGround</altitudeMode>`+
+		`</Camera>`+
+		`</gx:FlyTo>`+
+		`<gx:FlyTo>`+
+		`<gx:duration>`+formatFloat(time.Duration(distance/10*float64(time.Second)).Seconds())+`</gx:duration>`+
+		`<gx:flyToMode>smooth</gx:flyToMode>`+
+		`<Camera>`+
+		`<longitude>`+formatFloat(camera1.Lon)+`</longitude>`+
+		`<latitude>`+formatFloat(camera1.Lat)+`</latitude>`+
+		`<altitude>500</altitude>`+
+		`<heading>0</heading>`+
+		`<tilt>60</tilt>`+
+		`<altitudeMode>relativeToGround</altitudeMode>`+
+		`</Camera>`+
+		`</gx:FlyTo>`+
+		`</gx:Playlist>`+
+		`</gx:Tour>`, actual)
+}
+
+func TestFlyAlongTrack(t *testing.T) {
+	t0 := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ts := []time.Time{t0, t0.Add(time.Minute)}
+	cs := []kml.Coordinate{{Lon: 0, Lat: 0}, {Lon: 1, Lat: 0}}
+	b, err := New(Options{TimeScale: 0.5}).FlyAlongTrack(ts, cs)
+	require.NoError(t, err)
+	actual := encode(t, b.Tour())
+	assert.Contains(t, actual, `<gx:duration>30</gx:duration>`)
+	assert.Contains(t, actual, `<heading>90</heading>`)
+}
+
+func TestFlyAlongTrackLengthMismatch(t *testing.T) {
+	t0 := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	b := New(Options{})
+	_, err := b.FlyAlongTrack([]time.Time{t0}, []kml.Coordinate{{Lon: 0, Lat: 0}, {Lon: 1, Lat: 0}})
+	assert.Equal(t, ErrLengthMismatch, err)
+	assert.Equal(t, `<gx:Tour><gx:Playlist></gx:Playlist></gx:Tour>`, encode(t, b.Tour()))
+}
+
+func TestZeroAltitudeAndTilt(t *testing.T) {
+	cs := []kml.Coordinate{{Lon: 0, Lat: 0}}
+	actual := encode(t, New(Options{Altitude: Float64(0), Tilt: Float64(0)}).FlyAlong(cs).Tour())
+	assert.Contains(t, actual, `<altitude>0</altitude>`)
+	assert.Contains(t, actual, `<tilt>0</tilt>`)
+}
+
+func TestWaitAndBalloons(t *testing.T) {
+	actual := encode(t, New(Options{}).ShowBalloon("pm1").Wait(2*time.Second).HideBalloon("pm1").Tour())
+	assert.Equal(t, `<gx:Tour>`+
+		`<gx:Playlist>`+
+		`<gx:AnimatedUpdate>`+
+		`<gx:duration>0</gx:duration>`+
+		`<Update>`+
+		`<targetHref></targetHref>`+
+		`<Change><Placemark targetId="pm1"><gx:balloonVisibility>1</gx:balloonVisibility></Placemark></Change>`+
+		`</Update>`+
+		`</gx:AnimatedUpdate>`+
+		`<gx:Wait><gx:duration>2</gx:duration></gx:Wait>`+
+		`<gx:AnimatedUpdate>`+
+		`<gx:duration>0</gx:duration>`+
+		`<Update>`+
+		`<targetHref></targetHref>`+
+		`<Change><Placemark targetId="pm1"><gx:balloonVisibility>0</gx:balloonVisibility></Placemark></Change>`+
+		`</Update>`+
+		`</gx:AnimatedUpdate>`+
+		`</gx:Playlist>`+
+		`</gx:Tour>`, actual)
+}
@@ -0,0 +1,260 @@
+package kml
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"time"
+)
+
+// ErrNoParentID is returned when an object cannot be created by an Update
+// because its parent does not have an id.
+var ErrNoParentID = errors.New("kml: parent has no id")
+
+// An UpdateBuilder builds Update and NetworkLinkControl elements that
+// transform one version of a document into another. Objects are matched by
+// their id attribute. Objects without ids are compared as part of their
+// nearest ancestor with an id.
+type UpdateBuilder struct {
+	// TargetHref is the URL of the document to update.
+	TargetHref string
+	// MinRefreshPeriod is the minimum refresh period in seconds. It is
+	// omitted if zero.
+	MinRefreshPeriod float64
+	// Cookie is appended to the NetworkLink's URL on the next refresh. It is
+	// omitted if empty.
+	Cookie string
+	// Expires is when the data expires. It is omitted if zero.
+	Expires time.Time
+}
+
+// An updateObject is an object with an id in a document.
+type updateObject struct {
+	element     *CompoundElement
+	parent      *CompoundElement
+	ancestorIDs []string
+}
+
+// NetworkLinkControl returns a new NetworkLinkControl element containing an
+// Update that transforms oldRoot into newRoot.
+func (ub *UpdateBuilder) NetworkLinkControl(oldRoot, newRoot Element) (*CompoundElement, error) {
+	update, err := ub.Update(oldRoot, newRoot)
+	if err != nil {
+		return nil, err
+	}
+	var children []Element
+	if ub.MinRefreshPeriod != 0 {
+		children = append(children, MinRefreshPeriod(ub.MinRefreshPeriod))
+	}
+	if ub.Cookie != "" {
+		children = append(children, Cookie(ub.Cookie))
+	}
+	if !ub.Expires.IsZero() {
+		children = append(children, Expires(ub.Expires))
+	}
+	children = append(children, update)
+	return NetworkLinkControl(children...), nil
+}
+
+// Update returns a new Update element that transforms oldRoot into newRoot.
+// Objects that only differ in their simple children are changed, objects
+// that differ otherwise or that move to a different parent are deleted and
+// recreated.
+func (ub *UpdateBuilder) Update(oldRoot, newRoot Element) (*CompoundElement, error) {
+	oldIDs, oldObjects := collectUpdateObjects(oldRoot)
+	newIDs, newObjects := collectUpdateObjects(newRoot)
+
+	deleted := make(map[string]bool)
+	created := make(map[string]bool)
+	changes := make(map[string][]Element)
+	for _, id := range oldIDs {
+		oldObject := oldObjects[id]
+		newObject, ok := newObjects[id]
+		switch {
+		case !ok:
+			deleted[id] = true
+		case elementID(oldObject.parent) != elementID(newObject.parent) || oldObject.element.Name != newObject.element.Name:
+			deleted[id] = true
+			created[id] = true
+		default:
+			if simpleChanges, ok := diffSimpleChildren(oldObject.element, newObject.element); !ok {
+				deleted[id] = true
+				created[id] = true
+			} else if len(simpleChanges) != 0 {
+				changes[id] = simpleChanges
+			}
+		}
+	}
+	for _, id := range newIDs {
+		if _, ok := oldObjects[id]; !ok {
+			created[id] = true
+		}
+	}
+
+	var deletes, changeElements, creates []Element
+	for _, id := range oldIDs {
+		if deleted[id] && !anyAncestor(oldObjects[id], deleted) {
+			deletes = append(deletes, newTargetE(oldObjects[id].element.Name.Local, id, nil))
+		}
+	}
+	for _, id := range newIDs {
+		object := newObjects[id]
+		if anyAncestor(object, created) {
+			continue
+		}
+		if simpleChanges, ok := changes[id]; ok {
+			changeElements = append(changeElements, newTargetE(object.element.Name.Local, id, simpleChanges))
+		}
+		if created[id] {
+			parentID := elementID(object.parent)
+			if parentID == "" {
+				return nil, ErrNoParentID
+			}
+			creates = append(creates, newTargetE(object.parent.Name.Local, parentID, []Element{object.element}))
+		}
+	}
+
+	children := []Element{TargetHref(ub.TargetHref)}
+	if len(deletes) != 0 {
+		children = append(children, Delete(deletes...))
+	}
+	if len(changeElements) != 0 {
+		children = append(children, Change(changeElements...))
+	}
+	if len(creates) != 0 {
+		children = append(children, Create(creates...))
+	}
+	return Update(children...), nil
+}
+
+// anyAncestor returns true if any of object's ancestors is in ids.
+func anyAncestor(object *updateObject, ids map[string]bool) bool {
+	for _, id := range object.ancestorIDs {
+		if ids[id] {
+			return true
+		}
+	}
+	return false
+}
+
+// collectUpdateObjects returns the ids of all objects in root in document
+// order and a map of ids to objects. Each id is returned once; objects with
+// the same id as an earlier object are treated as if they had no id.
+func collectUpdateObjects(root Element) ([]string, map[string]*updateObject) {
+	var ids []string
+	objects := make(map[string]*updateObject)
+	var visit func(*CompoundElement, *CompoundElement, []string)
+	visit = func(ce, parent *CompoundElement, ancestorIDs []string) {
+		if id := elementID(ce); id != "" && objects[id] == nil {
+			ids = append(ids, id)
+			objects[id] = &updateObject{
+				element:     ce,
+				parent:      parent,
+				ancestorIDs: ancestorIDs,
+			}
+			ancestorIDs = append(ancestorIDs[:len(ancestorIDs):len(ancestorIDs)], id)
+		}
+		for _, child := range ce.children {
+			if childCE := compoundElement(child); childCE != nil {
+				visit(childCE, ce, ancestorIDs)
+			}
+		}
+	}
+	if ce := compoundElement(root); ce != nil {
+		visit(ce, nil, nil)
+	}
+	return ids, objects
+}
+
+// diffSimpleChildren returns the simple children of newCE that differ from
+// those of oldCE, ignoring children with ids. It returns false if the
+// differences cannot be expressed by changing simple children.
+func diffSimpleChildren(oldCE, newCE *CompoundElement) ([]Element, bool) {
+	if !equalAttrs(oldCE.Attr, newCE.Attr) {
+		return nil, false
+	}
+	oldChildren := childrenWithoutIDs(oldCE)
+	newChildren := childrenWithoutIDs(newCE)
+	if len(oldChildren) != len(newChildren) {
+		return nil, false
+	}
+	var changes []Element
+	for i, newChild := range newChildren {
+		oldChild := oldChildren[i]
+		if marshalString(oldChild) == marshalString(newChild) {
+			continue
+		}
+		oldSE, oldOK := oldChild.(*SimpleElement)
+		newSE, newOK := newChild.(*SimpleElement)
+		if !oldOK || !newOK || oldSE.Name != newSE.Name {
+			return nil, false
+		}
+		changes = append(changes, newChild)
+	}
+	return changes, true
+}
+
+// childrenWithoutIDs returns ce's children that do not have ids.
+func childrenWithoutIDs(ce *CompoundElement) []Element {
+	children := make([]Element, 0, len(ce.children))
+	for _, child := range ce.children {
+		if childCE := compoundElement(child); childCE != nil && elementID(childCE) != "" {
+			continue
+		}
+		children = append(children, child)
+	}
+	return children
+}
+
+// compoundElement returns the CompoundElement underlying e, or nil if e is
+// not compound.
+func compoundElement(e Element) *CompoundElement {
+	switch e := e.(type) {
+	case *CompoundElement:
+		return e
+	case *SharedElement:
+		return &e.CompoundElement
+	default:
+		return nil
+	}
+}
+
+// elementID returns the value of ce's id attribute.
+func elementID(ce *CompoundElement) string {
+	if ce == nil {
+		return ""
+	}
+	return attrValue(ce.StartElement, "id")
+}
+
+// attrValue returns the value of se's attribute name.
+func attrValue(se xml.StartElement, name string) string {
+	for _, attr := range se.Attr {
+		if attr.Name.Space == "" && attr.Name.Local == name {
+			return attr.Value
+		}
+	}
+	return ""
+}
+
+func equalAttrs(attrs1, attrs2 []xml.Attr) bool {
+	if len(attrs1) != len(attrs2) {
+		return false
+	}
+	for i, attr := range attrs1 {
+		if attr != attrs2[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// marshalString returns the XML encoding of e, or an empty string if e
+// cannot be encoded.
+func marshalString(e Element) string {
+	buf := &bytes.Buffer{}
+	if err := xml.NewEncoder(buf).Encode(e); err != nil {
+		return ""
+	}
+	return buf.String()
+}
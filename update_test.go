@@ -0,0 +1,113 @@
+package kml
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateBuilder(t *testing.T) {
+	oldRoot := KML(
+		SharedDocument("doc",
+			SharedPlacemark("pm1",
+				Name("moving"),
+				Point(Coordinates(Coordinate{Lon: 1, Lat: 2})),
+			),
+			SharedPlacemark("pm2",
+				Name("renamed"),
+			),
+			SharedFolder("f1",
+				SharedPlacemark("pm3"),
+			),
+			SharedPlacemark("pm4",
+				Name("unchanged"),
+			),
+		),
+	)
+	newRoot := KML(
+		SharedDocument("doc",
+			SharedPlacemark("pm1",
+				Name("moving"),
+				Point(Coordinates(Coordinate{Lon: 3, Lat: 4})),
+			),
+			SharedPlacemark("pm2",
+				Name("renamed again"),
+			),
+			SharedPlacemark("pm4",
+				Name("unchanged"),
+			),
+			SharedPlacemark("pm5",
+				Name("new"),
+			),
+		),
+	)
+
+	ub := &UpdateBuilder{
+		TargetHref:       "http://example.com/doc.kml",
+		MinRefreshPeriod: 10,
+		Cookie:           "v=2",
+		Expires:          time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	nlc, err := ub.NetworkLinkControl(oldRoot, newRoot)
+	require.NoError(t, err)
+	sb := &strings.Builder{}
+	require.NoError(t, xml.NewEncoder(sb).Encode(nlc))
+	assert.Equal(t, `<NetworkLinkControl>`+
+		`<minRefreshPeriod>10</minRefreshPeriod>`+
+		`<cookie>v=2</cookie>`+
+		`<expires>2020-01-01T00:00:00Z</expires>`+
+		`<Update>`+
+		`<targetHref>http://example.com/doc.kml</targetHref>`+
+		`<Delete>`+
+		`<Placemark targetId="pm1"></Placemark>`+
+		`<Folder targetId="f1"></Folder>`+
+		`</Delete>`+
+		`<Change>`+
+		`<Placemark targetId="pm2"><name>renamed again</name></Placemark>`+
+		`</Change>`+
+		`<Create>`+
+		`<Document targetId="doc"><Placemark id="pm1"><name>moving</name><Point><coordinates>3,4</coordinates></Point></Placemark></Document>`+
+		`<Document targetId="doc"><Placemark id="pm5"><name>new</name></Placemark></Document>`+
+		`</Create>`+
+		`</Update>`+
+		`</NetworkLinkControl>`, sb.String())
+}
+
+func TestUpdateBuilderNoParentID(t *testing.T) {
+	oldRoot := KML(Document())
+	newRoot := KML(Document(SharedPlacemark("pm1")))
+	_, err := (&UpdateBuilder{}).Update(oldRoot, newRoot)
+	assert.Equal(t, ErrNoParentID, err)
+}
+
+func TestUpdateBuilderDuplicateIDs(t *testing.T) {
+	oldRoot := KML(
+		SharedDocument("doc",
+			SharedPlacemark("dup", Name("a")),
+			SharedPlacemark("dup", Name("b")),
+		),
+	)
+	newRoot := KML(
+		SharedDocument("doc",
+			SharedPlacemark("new", Name("c")),
+			SharedPlacemark("new", Name("d")),
+		),
+	)
+	update, err := (&UpdateBuilder{}).Update(oldRoot, newRoot)
+	require.NoError(t, err)
+	sb := &strings.Builder{}
+	require.NoError(t, xml.NewEncoder(sb).Encode(update))
+	assert.Equal(t, `<Update>`+
+		`<targetHref></targetHref>`+
+		`<Delete>`+
+		`<Placemark targetId="dup"></Placemark>`+
+		`</Delete>`+
+		`<Create>`+
+		`<Document targetId="doc"><Placemark id="new"><name>c</name></Placemark></Document>`+
+		`</Create>`+
+		`</Update>`, sb.String())
+}
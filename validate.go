@@ -0,0 +1,161 @@
+package kml
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Errors returned by Validate.
+var (
+	ErrUnknownElement    = errors.New("kml: unknown element")
+	ErrUnexpectedElement = errors.New("kml: unexpected element")
+	ErrInvalidValue      = errors.New("kml: invalid value")
+)
+
+// A schemaElement describes an element in the KML schema.
+type schemaElement struct {
+	compound bool
+	children []string
+	defaults map[string]string
+	valid    func(string) bool
+}
+
+// Validate checks e and its descendants against the KML schema and returns
+// the problems found. It checks that every element is known, that every child
+// is allowed in its parent, and that simple values have the correct type. It
+// does not check the order or number of children. Elements in namespaces
+// other than the KML and gx namespaces are not checked.
+func Validate(e Element) []error {
+	v := &validator{}
+	v.validate(e, "")
+	return v.errs
+}
+
+type validator struct {
+	errs []error
+}
+
+// validate validates e, whose parent's path is parentPath.
+func (v *validator) validate(e Element, parentPath string) {
+	name, ok := schemaName(e)
+	if !ok || isForeign(name) {
+		return
+	}
+	path := name
+	if parentPath != "" {
+		path = parentPath + "/" + name
+	}
+	se, ok := schemaElements[name]
+	if !ok {
+		v.errs = append(v.errs, fmt.Errorf("%w: %s", ErrUnknownElement, path))
+		return
+	}
+	switch e := e.(type) {
+	case *SimpleElement:
+		if !validValue(name, e.value, se) {
+			v.errs = append(v.errs, fmt.Errorf("%w: %s: %q", ErrInvalidValue, path, e.value))
+		}
+	case *CompoundElement:
+		v.validateChildren(e, path, se)
+	case *SharedElement:
+		v.validateChildren(&e.CompoundElement, path, se)
+	}
+}
+
+// validateChildren validates the children of ce, whose path is path.
+func (v *validator) validateChildren(ce *CompoundElement, path string, se schemaElement) {
+	for _, child := range ce.children {
+		childName, ok := schemaName(child)
+		if !ok || isForeign(childName) {
+			continue
+		}
+		if _, ok := schemaElements[childName]; ok && !contains(se.children, childName) {
+			v.errs = append(v.errs, fmt.Errorf("%w: %s/%s", ErrUnexpectedElement, path, childName))
+			continue
+		}
+		v.validate(child, path)
+	}
+}
+
+// schemaName returns the name of e and true if e is in the KML namespace.
+func schemaName(e Element) (string, bool) {
+	if _, ok := coordinatesOf(e); ok {
+		return "coordinates", true
+	}
+	start, ok := StartElementOf(e)
+	if !ok {
+		return "", false
+	}
+	return start.Name.Local, start.Name.Space == "" || start.Name.Space == Namespace
+}
+
+// isForeign returns true if name is in a namespace other than the KML and gx
+// namespaces.
+func isForeign(name string) bool {
+	i := strings.IndexByte(name, ':')
+	return i >= 0 && name[:i] != "gx"
+}
+
+// validValue returns true if value is a valid value for the simple element
+// name described by se.
+func validValue(name, value string, se schemaElement) bool {
+	if se.compound {
+		return strings.TrimSpace(value) == ""
+	}
+	if se.valid != nil {
+		return se.valid(strings.TrimSpace(value))
+	}
+	if name == "coordinates" {
+		_, ok := parseCoordinates(value)
+		return ok
+	}
+	t, ok := lookupSimpleElementType(name)
+	if !ok {
+		return true
+	}
+	trimmed := strings.TrimSpace(value)
+	switch t.kind {
+	case simpleKindBool:
+		switch trimmed {
+		case "0", "1", "false", "true":
+			return true
+		}
+		return false
+	case simpleKindInt:
+		_, err := strconv.Atoi(trimmed)
+		return err == nil
+	case simpleKindFloat:
+		_, err := strconv.ParseFloat(trimmed, 64)
+		return err == nil
+	case simpleKindColor:
+		if len(trimmed) != 8 {
+			return false
+		}
+		_, err := strconv.ParseUint(trimmed, 16, 32)
+		return err == nil
+	default:
+		return true
+	}
+}
+
+// validList returns true if every whitespace-separated value in s is valid.
+func validList(s string, valid func(string) bool) bool {
+	for _, field := range strings.Fields(s) {
+		if !valid(field) {
+			return false
+		}
+	}
+	return true
+}
+
+// contains returns true if ss contains s.
+func contains(ss []string, s string) bool {
+	for _, t := range ss {
+		if t == s {
+			return true
+		}
+	}
+	return false
+}
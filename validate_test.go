@@ -0,0 +1,91 @@
+package kml
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		element     Element
+		expectedErr []error
+		expected    []string
+	}{
+		{
+			name: "valid",
+			element: GxKML(
+				Document(
+					SharedStyle("s", LineStyle(Width(2)), ListStyle(ItemIcon(newSEString("state", "open error")))),
+					Placemark(
+						Name("p"),
+						GxBalloonVisibility(true),
+						Point(AltitudeMode(AltitudeModeAbsolute), Coordinates(Coordinate{Lon: 1, Lat: 2})),
+						ExtendedData(DataWithName("speed", Value("12"))),
+					),
+				),
+			),
+		},
+		{
+			name:        "unknown_element",
+			element:     Placemark(newSEString("colour", "ff0000ff")),
+			expectedErr: []error{ErrUnknownElement},
+			expected:    []string{"kml: unknown element: Placemark/colour"},
+		},
+		{
+			name:        "unexpected_element",
+			element:     Document(Point(), Placemark(Width(2))),
+			expectedErr: []error{ErrUnexpectedElement, ErrUnexpectedElement},
+			expected: []string{
+				"kml: unexpected element: Document/Point",
+				"kml: unexpected element: Document/Placemark/width",
+			},
+		},
+		{
+			name: "invalid_values",
+			element: Placemark(
+				newSEString("visibility", "yes"),
+				LineString(
+					newSEString("altitudeMode", "clampedToGround"),
+					newSEString("coordinates", "1,2 3"),
+				),
+			),
+			expectedErr: []error{ErrInvalidValue, ErrInvalidValue, ErrInvalidValue},
+			expected: []string{
+				`kml: invalid value: Placemark/visibility: "yes"`,
+				`kml: invalid value: Placemark/LineString/altitudeMode: "clampedToGround"`,
+				`kml: invalid value: Placemark/LineString/coordinates: "1,2 3"`,
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := Validate(tc.element)
+			require.Equal(t, len(tc.expected), len(errs))
+			for i, err := range errs {
+				assert.True(t, errors.Is(err, tc.expectedErr[i]))
+				assert.Equal(t, tc.expected[i], err.Error())
+			}
+		})
+	}
+}
+
+func TestValidateParsed(t *testing.T) {
+	e, err := Parse(strings.NewReader(`<kml xmlns="http://www.opengis.net/kml/2.2" xmlns:atom="http://www.w3.org/2005/Atom">
+  <Document>
+    <atom:link href="https://example.com/"/>
+    <Placemark>
+      <ExtendedData xmlns:x="https://example.com/x"><x:speed>12</x:speed></ExtendedData>
+      <Point><coordinates>1,2</coordinates></Point>
+      <Style><LineStyle><color>ff00ff</color></LineStyle></Style>
+    </Placemark>
+  </Document>
+</kml>`))
+	require.NoError(t, err)
+	errs := Validate(e)
+	require.Len(t, errs, 1)
+	assert.Equal(t, `kml: invalid value: kml/Document/Placemark/Style/LineStyle/color: "ff00ff"`, errs[0].Error())
+}
@@ -0,0 +1,133 @@
+package kml
+
+import (
+	"encoding/xml"
+	"errors"
+)
+
+// SkipChildren is returned by a WalkFunc to skip the children of the element
+// that it was called with. It is not returned as an error by Walk.
+var SkipChildren = errors.New("kml: skip children")
+
+// A WalkFunc is called by Walk for every element. path contains the
+// ancestors of e, root first.
+type WalkFunc func(path []Element, e Element) error
+
+// Walk calls f for e and each of its descendants in document order. If f
+// returns SkipChildren then the children of the current element are skipped.
+// If f returns any other error then Walk stops and returns that error.
+func Walk(e Element, f WalkFunc) error {
+	err := walk(nil, e, f)
+	if errors.Is(err, SkipChildren) {
+		return nil
+	}
+	return err
+}
+
+// walk calls f for e, whose ancestors are path, and its descendants.
+func walk(path []Element, e Element, f WalkFunc) error {
+	switch err := f(path, e); {
+	case errors.Is(err, SkipChildren):
+		return nil
+	case err != nil:
+		return err
+	}
+	ce := compoundElement(e)
+	if ce == nil {
+		return nil
+	}
+	path = append(path, e)
+	for _, child := range ce.children {
+		if err := walk(path[:len(path):len(path)], child, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Rewrite returns a transformed deep copy of e. f is called for every element
+// in the copy, children before their parents, and its return value replaces
+// the element. f may modify the element that it is called with. If f returns
+// nil then the element is removed.
+func Rewrite(e Element, f func(Element) Element) Element {
+	return rewrite(Clone(e), f)
+}
+
+// rewrite rewrites e, which must already be a copy, in place.
+func rewrite(e Element, f func(Element) Element) Element {
+	if e == nil {
+		return nil
+	}
+	if ce := compoundElement(e); ce != nil {
+		children := ce.children[:0]
+		for _, child := range ce.children {
+			if rewritten := rewrite(child, f); rewritten != nil {
+				children = append(children, rewritten)
+			}
+		}
+		ce.children = children
+	}
+	return f(e)
+}
+
+// Clone returns a deep copy of e. Elements of types not defined by this
+// package are not copied.
+func Clone(e Element) Element {
+	switch e := e.(type) {
+	case *SimpleElement:
+		return &SimpleElement{
+			StartElement: copyStartElement(e.StartElement),
+			value:        e.value,
+			cdata:        e.cdata,
+		}
+	case *CompoundElement:
+		return cloneCompound(e)
+	case *SharedElement:
+		return &SharedElement{
+			CompoundElement: *cloneCompound(&e.CompoundElement),
+			id:              e.id,
+		}
+	case *CoordinatesElement:
+		return &CoordinatesElement{
+			coordinates: append([]Coordinate(nil), e.coordinates...),
+		}
+	case *CoordinatesArrayElement:
+		coordinates := make([][]float64, 0, len(e.coordinates))
+		for _, c := range e.coordinates {
+			coordinates = append(coordinates, append([]float64(nil), c...))
+		}
+		return &CoordinatesArrayElement{
+			coordinates: coordinates,
+		}
+	case *CoordinatesFlatElement:
+		return &CoordinatesFlatElement{
+			flatCoords: append([]float64(nil), e.flatCoords...),
+			offset:     e.offset,
+			end:        e.end,
+			stride:     e.stride,
+			dim:        e.dim,
+		}
+	default:
+		return e
+	}
+}
+
+// cloneCompound returns a deep copy of ce.
+func cloneCompound(ce *CompoundElement) *CompoundElement {
+	children := make([]Element, 0, len(ce.children))
+	for _, child := range ce.children {
+		children = append(children, Clone(child))
+	}
+	return &CompoundElement{
+		StartElement: copyStartElement(ce.StartElement),
+		children:     children,
+	}
+}
+
+// copyStartElement returns a copy of se.
+func copyStartElement(se xml.StartElement) xml.StartElement {
+	return xml.StartElement{
+		Name: se.Name,
+		Attr: append([]xml.Attr(nil), se.Attr...),
+	}
+}
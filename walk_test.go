@@ -0,0 +1,187 @@
+package kml
+
+import (
+	"errors"
+	"image/color"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWalk(t *testing.T) {
+	root := KML(
+		Document(
+			SharedStyle("s", LineStyle(Width(2))),
+			Placemark(
+				Name("p"),
+				Point(Coordinates(Coordinate{Lon: 1, Lat: 2})),
+			),
+		),
+	)
+	var visited []string
+	require.NoError(t, Walk(root, func(path []Element, e Element) error {
+		names := make([]string, 0, len(path)+1)
+		for _, ancestor := range path {
+			names = append(names, elementName(ancestor))
+		}
+		names = append(names, elementName(e))
+		visited = append(visited, strings.Join(names, "/"))
+		if elementName(e) == "Style" {
+			return SkipChildren
+		}
+		return nil
+	}))
+	assert.Equal(t, []string{
+		"kml",
+		"kml/Document",
+		"kml/Document/Style",
+		"kml/Document/Placemark",
+		"kml/Document/Placemark/name",
+		"kml/Document/Placemark/Point",
+		"kml/Document/Placemark/Point/coordinates",
+	}, visited)
+
+	errStop := errors.New("stop")
+	count := 0
+	assert.Equal(t, errStop, Walk(root, func(path []Element, e Element) error {
+		count++
+		if elementName(e) == "Placemark" {
+			return errStop
+		}
+		return nil
+	}))
+	assert.Equal(t, 6, count)
+
+	assert.NoError(t, Walk(root, func(path []Element, e Element) error {
+		return SkipChildren
+	}))
+}
+
+func TestRewrite(t *testing.T) {
+	when := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	for _, tc := range []struct {
+		name     string
+		element  Element
+		f        func(Element) Element
+		expected Element
+	}{
+		{
+			name: "strip_descriptions",
+			element: Document(
+				Description("d"),
+				Placemark(Name("p"), Description("d")),
+			),
+			f: func(e Element) Element {
+				if elementName(e) == "description" {
+					return nil
+				}
+				return e
+			},
+			expected: Document(
+				Placemark(Name("p")),
+			),
+		},
+		{
+			name: "recolor",
+			element: SharedStyle("s",
+				LineStyle(Color(color.White), Width(2)),
+				PolyStyle(Color(color.White)),
+			),
+			f: func(e Element) Element {
+				if elementName(e) == "color" {
+					return Color(color.Black)
+				}
+				return e
+			},
+			expected: SharedStyle("s",
+				LineStyle(Color(color.Black), Width(2)),
+				PolyStyle(Color(color.Black)),
+			),
+		},
+		{
+			name: "add_timestamps",
+			element: Folder(
+				Placemark(Name("a")),
+				Placemark(Name("b")),
+			),
+			f: func(e Element) Element {
+				if ce, ok := e.(*CompoundElement); ok && ce.Name.Local == "Placemark" {
+					return ce.Add(TimeStamp(When(when)))
+				}
+				return e
+			},
+			expected: Folder(
+				Placemark(Name("a"), TimeStamp(When(when))),
+				Placemark(Name("b"), TimeStamp(When(when))),
+			),
+		},
+		{
+			name:    "remove_root",
+			element: Placemark(),
+			f: func(e Element) Element {
+				return nil
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			before := marshalString(tc.element)
+			actual := Rewrite(tc.element, tc.f)
+			if tc.expected == nil {
+				assert.Nil(t, actual)
+			} else {
+				assert.Equal(t, marshalString(tc.expected), marshalString(actual))
+			}
+			assert.Equal(t, before, marshalString(tc.element))
+		})
+	}
+}
+
+func TestClone(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		element Element
+	}{
+		{
+			name:    "simple",
+			element: Name("n"),
+		},
+		{
+			name:    "cdata",
+			element: DescriptionCDATA("<b>d</b>"),
+		},
+		{
+			name:    "shared",
+			element: SharedStyle("s", LineStyle(Width(2))),
+		},
+		{
+			name:    "coordinates",
+			element: LineString(Coordinates(Coordinate{Lon: 1, Lat: 2}, Coordinate{Lon: 3, Lat: 4, Alt: 5})),
+		},
+		{
+			name:    "coordinates_array",
+			element: LineString(CoordinatesArray([]float64{1, 2}, []float64{3, 4, 5})),
+		},
+		{
+			name:    "coordinates_flat",
+			element: LineString(CoordinatesFlat([]float64{0, 1, 2, 3, 4, 5}, 0, 6, 3, 3)),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			clone := Clone(tc.element)
+			assert.NotSame(t, tc.element, clone)
+			assert.Equal(t, tc.element, clone)
+			assert.Equal(t, marshalString(tc.element), marshalString(clone))
+		})
+	}
+
+	original := SharedPlacemark("p", Name("a"), Point(Coordinates(Coordinate{Lon: 1, Lat: 2})))
+	clone := Clone(original).(*SharedElement)
+	assert.Equal(t, "#p", clone.URL())
+	clone.Add(Description("d"))
+	clone.Attr[0].Value = "q"
+	clone.children[1].(*CompoundElement).children[0].(*CoordinatesElement).coordinates[0].Lon = 3
+	assert.Equal(t, `<Placemark id="p"><name>a</name><Point><coordinates>1,2</coordinates></Point></Placemark>`, marshalString(original))
+}